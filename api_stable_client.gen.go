@@ -202,6 +202,24 @@ type BalanceSheetStatementTTM struct {
 	TreasuryStock                           float32            `json:"treasuryStock"`
 }
 
+// CIKSearchResult defines model for CIKSearchResult.
+type CIKSearchResult struct {
+	Cik              string `json:"cik"`
+	CompanyName      string `json:"companyName"`
+	Currency         string `json:"currency"`
+	Exchange         string `json:"exchange"`
+	ExchangeFullName string `json:"exchangeFullName"`
+	Symbol           string `json:"symbol"`
+}
+
+// CUSIPSearchResult defines model for CUSIPSearchResult.
+type CUSIPSearchResult struct {
+	CompanyName string  `json:"companyName"`
+	Cusip       string  `json:"cusip"`
+	MarketCap   float64 `json:"marketCap"`
+	Symbol      string  `json:"symbol"`
+}
+
 // CashFlowStatement defines model for CashFlowStatement.
 type CashFlowStatement struct {
 	AcceptedDate                           string             `json:"acceptedDate"`
@@ -360,6 +378,25 @@ type CompanyProfile struct {
 	Zip               string  `json:"zip"`
 }
 
+// CompanyScreenerResult defines model for CompanyScreenerResult.
+type CompanyScreenerResult struct {
+	Beta               float64 `json:"beta"`
+	CompanyName        string  `json:"companyName"`
+	Country            string  `json:"country"`
+	Exchange           string  `json:"exchange"`
+	ExchangeShortName  string  `json:"exchangeShortName"`
+	Industry           string  `json:"industry"`
+	IsActivelyTrading  bool    `json:"isActivelyTrading"`
+	IsEtf              bool    `json:"isEtf"`
+	IsFund             bool    `json:"isFund"`
+	LastAnnualDividend float64 `json:"lastAnnualDividend"`
+	MarketCap          float64 `json:"marketCap"`
+	Price              float64 `json:"price"`
+	Sector             string  `json:"sector"`
+	Symbol             string  `json:"symbol"`
+	Volume             float64 `json:"volume"`
+}
+
 // CompanySharesFloat defines model for CompanySharesFloat.
 type CompanySharesFloat struct {
 	Date              string  `json:"date"`
@@ -375,6 +412,95 @@ type CompanySymbol struct {
 	Symbol      string `json:"symbol"`
 }
 
+// CustomDiscountedCashFlow defines model for CustomDiscountedCashFlow.
+type CustomDiscountedCashFlow struct {
+	AfterTaxCostOfDebt           float64 `json:"afterTaxCostOfDebt"`
+	Beta                         float64 `json:"beta"`
+	CapitalExpenditure           float64 `json:"capitalExpenditure"`
+	CapitalExpenditurePercentage float64 `json:"capitalExpenditurePercentage"`
+	CostOfEquity                 float64 `json:"costOfEquity"`
+	CostofDebt                   float64 `json:"costofDebt"`
+	DebtWeighting                float64 `json:"debtWeighting"`
+	Depreciation                 float64 `json:"depreciation"`
+	DepreciationPercentage       float64 `json:"depreciationPercentage"`
+	DilutedSharesOutstanding     float64 `json:"dilutedSharesOutstanding"`
+	Ebiat                        float64 `json:"ebiat"`
+	Ebit                         float64 `json:"ebit"`
+	EbitPercentage               float64 `json:"ebitPercentage"`
+	Ebitda                       float64 `json:"ebitda"`
+	EbitdaPercentage             float64 `json:"ebitdaPercentage"`
+	EnterpriseValue              float64 `json:"enterpriseValue"`
+	EquityValue                  float64 `json:"equityValue"`
+	EquityValuePerShare          float64 `json:"equityValuePerShare"`
+	EquityWeighting              float64 `json:"equityWeighting"`
+	FreeCashFlowT1               float64 `json:"freeCashFlowT1"`
+	Inventories                  float64 `json:"inventories"`
+	InventoriesPercentage        float64 `json:"inventoriesPercentage"`
+	LongTermGrowthRate           float64 `json:"longTermGrowthRate"`
+	MarketRiskPremium            float64 `json:"marketRiskPremium"`
+	NetDebt                      float64 `json:"netDebt"`
+	Payable                      float64 `json:"payable"`
+	PayablePercentage            float64 `json:"payablePercentage"`
+	PresentTerminalValue         float64 `json:"presentTerminalValue"`
+	Price                        float64 `json:"price"`
+	Receivables                  float64 `json:"receivables"`
+	ReceivablesPercentage        float64 `json:"receivablesPercentage"`
+	Revenue                      float64 `json:"revenue"`
+	RevenuePercentage            float64 `json:"revenuePercentage"`
+	RiskFreeRate                 float64 `json:"riskFreeRate"`
+	SumPvUfcf                    float64 `json:"sumPvUfcf"`
+	Symbol                       string  `json:"symbol"`
+	TaxRate                      float64 `json:"taxRate"`
+	TaxRateCash                  float64 `json:"taxRateCash"`
+	TerminalValue                float64 `json:"terminalValue"`
+	TotalCapital                 float64 `json:"totalCapital"`
+	TotalCash                    float64 `json:"totalCash"`
+	TotalCashPercentage          float64 `json:"totalCashPercentage"`
+	TotalDebt                    float64 `json:"totalDebt"`
+	TotalEquity                  float64 `json:"totalEquity"`
+	Ufcf                         float64 `json:"ufcf"`
+	Wacc                         float64 `json:"wacc"`
+	Year                         string  `json:"year"`
+}
+
+// CustomLeveredDiscountedCashFlow defines model for CustomLeveredDiscountedCashFlow.
+type CustomLeveredDiscountedCashFlow struct {
+	AfterTaxCostOfDebt           float64 `json:"afterTaxCostOfDebt"`
+	Beta                         float64 `json:"beta"`
+	CapitalExpenditure           float64 `json:"capitalExpenditure"`
+	CapitalExpenditurePercentage float64 `json:"capitalExpenditurePercentage"`
+	CostOfEquity                 float64 `json:"costOfEquity"`
+	CostofDebt                   float64 `json:"costofDebt"`
+	DebtWeighting                float64 `json:"debtWeighting"`
+	DilutedSharesOutstanding     float64 `json:"dilutedSharesOutstanding"`
+	EnterpriseValue              float64 `json:"enterpriseValue"`
+	EquityValue                  float64 `json:"equityValue"`
+	EquityValuePerShare          float64 `json:"equityValuePerShare"`
+	EquityWeighting              float64 `json:"equityWeighting"`
+	FreeCashFlow                 float64 `json:"freeCashFlow"`
+	FreeCashFlowT1               float64 `json:"freeCashFlowT1"`
+	LongTermGrowthRate           float64 `json:"longTermGrowthRate"`
+	MarketRiskPremium            float64 `json:"marketRiskPremium"`
+	NetDebt                      float64 `json:"netDebt"`
+	OperatingCashFlow            float64 `json:"operatingCashFlow"`
+	OperatingCashFlowPercentage  float64 `json:"operatingCashFlowPercentage"`
+	PresentTerminalValue         float64 `json:"presentTerminalValue"`
+	Price                        float64 `json:"price"`
+	PvLfcf                       float64 `json:"pvLfcf"`
+	Revenue                      float64 `json:"revenue"`
+	RevenuePercentage            float64 `json:"revenuePercentage"`
+	RiskFreeRate                 float64 `json:"riskFreeRate"`
+	SumPvLfcf                    float64 `json:"sumPvLfcf"`
+	Symbol                       string  `json:"symbol"`
+	TaxRate                      float64 `json:"taxRate"`
+	TerminalValue                float64 `json:"terminalValue"`
+	TotalCapital                 float64 `json:"totalCapital"`
+	TotalDebt                    float64 `json:"totalDebt"`
+	TotalEquity                  float64 `json:"totalEquity"`
+	Wacc                         float64 `json:"wacc"`
+	Year                         string  `json:"year"`
+}
+
 // DCF defines model for DCF.
 type DCF struct {
 	Date       string `json:"date"`
@@ -402,6 +528,14 @@ type DetailedCandle struct {
 	Volume float64 `json:"volume"`
 }
 
+// DiscountedCashFlow defines model for DiscountedCashFlow.
+type DiscountedCashFlow struct {
+	StockPrice float64            `json:"Stock Price"`
+	Date       openapi_types.Date `json:"date"`
+	Dcf        float64            `json:"dcf"`
+	Symbol     string             `json:"symbol"`
+}
+
 // DividendEvent defines model for DividendEvent.
 type DividendEvent struct {
 	AdjDividend     float64            `json:"adjDividend"`
@@ -547,6 +681,26 @@ type Exchange struct {
 	SymbolSuffix string `json:"symbolSuffix"`
 }
 
+// ExchangeHoliday defines model for ExchangeHoliday.
+type ExchangeHoliday struct {
+	AdjCloseTime *string `json:"adjCloseTime,omitempty"`
+	AdjOpenTime  *string `json:"adjOpenTime,omitempty"`
+	Date         string  `json:"date"`
+	Exchange     string  `json:"exchange"`
+	IsClosed     bool    `json:"isClosed"`
+	Name         string  `json:"name"`
+}
+
+// ExchangeMarketHours defines model for ExchangeMarketHours.
+type ExchangeMarketHours struct {
+	ClosingHour  string `json:"closingHour"`
+	Exchange     string `json:"exchange"`
+	IsMarketOpen bool   `json:"isMarketOpen"`
+	Name         string `json:"name"`
+	OpeningHour  string `json:"openingHour"`
+	Timezone     string `json:"timezone"`
+}
+
 // FinancialEstimates defines model for FinancialEstimates.
 type FinancialEstimates struct {
 	Date               openapi_types.Date `json:"date"`
@@ -640,6 +794,21 @@ type FinancialRatios struct {
 	WorkingCapitalTurnoverRatio             float64            `json:"workingCapitalTurnoverRatio"`
 }
 
+// FinancialScores defines model for FinancialScores.
+type FinancialScores struct {
+	AltmanZScore     float64 `json:"altmanZScore"`
+	Ebit             float64 `json:"ebit"`
+	MarketCap        float64 `json:"marketCap"`
+	PiotroskiScore   int     `json:"piotroskiScore"`
+	ReportedCurrency string  `json:"reportedCurrency"`
+	RetainedEarnings float64 `json:"retainedEarnings"`
+	Revenue          float64 `json:"revenue"`
+	Symbol           string  `json:"symbol"`
+	TotalAssets      float64 `json:"totalAssets"`
+	TotalLiabilities float64 `json:"totalLiabilities"`
+	WorkingCapital   float64 `json:"workingCapital"`
+}
+
 // ForexCurrencyPair defines model for ForexCurrencyPair.
 type ForexCurrencyPair struct {
 	FromCurrency string `json:"fromCurrency"`
@@ -684,6 +853,16 @@ type FullQuote struct {
 	YearLow          float64 `json:"yearLow"`
 }
 
+// Grade defines model for Grade.
+type Grade struct {
+	Action         string             `json:"action"`
+	Date           openapi_types.Date `json:"date"`
+	GradingCompany string             `json:"gradingCompany"`
+	NewGrade       string             `json:"newGrade"`
+	PreviousGrade  string             `json:"previousGrade"`
+	Symbol         string             `json:"symbol"`
+}
+
 // GradeNews defines model for GradeNews.
 type GradeNews struct {
 	Action          string    `json:"action"`
@@ -699,6 +878,36 @@ type GradeNews struct {
 	Symbol          string    `json:"symbol"`
 }
 
+// HistoricalGrade defines model for HistoricalGrade.
+type HistoricalGrade struct {
+	AnalystRatingsBuy        int                `json:"analystRatingsBuy"`
+	AnalystRatingsHold       int                `json:"analystRatingsHold"`
+	AnalystRatingsSell       int                `json:"analystRatingsSell"`
+	AnalystRatingsStrongBuy  int                `json:"analystRatingsStrongBuy"`
+	AnalystRatingsStrongSell int                `json:"analystRatingsStrongSell"`
+	Date                     openapi_types.Date `json:"date"`
+	Symbol                   string             `json:"symbol"`
+}
+
+// HistoricalIndexConstituent defines model for HistoricalIndexConstituent.
+type HistoricalIndexConstituent struct {
+	AddedSecurity   string             `json:"addedSecurity"`
+	Date            openapi_types.Date `json:"date"`
+	DateAdded       string             `json:"dateAdded"`
+	Reason          string             `json:"reason"`
+	RemovedSecurity string             `json:"removedSecurity"`
+	RemovedTicker   string             `json:"removedTicker"`
+	Symbol          string             `json:"symbol"`
+}
+
+// ISINSearchResult defines model for ISINSearchResult.
+type ISINSearchResult struct {
+	Isin      string  `json:"isin"`
+	MarketCap float64 `json:"marketCap"`
+	Name      string  `json:"name"`
+	Symbol    string  `json:"symbol"`
+}
+
 // IncomeStatement defines model for IncomeStatement.
 type IncomeStatement struct {
 	AcceptedDate                            string             `json:"acceptedDate"`
@@ -946,6 +1155,44 @@ type NewsArticle struct {
 // Period Reporting period
 type Period string
 
+// PriceTargetConsensus defines model for PriceTargetConsensus.
+type PriceTargetConsensus struct {
+	Symbol          string  `json:"symbol"`
+	TargetConsensus float64 `json:"targetConsensus"`
+	TargetHigh      float64 `json:"targetHigh"`
+	TargetLow       float64 `json:"targetLow"`
+	TargetMedian    float64 `json:"targetMedian"`
+}
+
+// PriceTargetNews defines model for PriceTargetNews.
+type PriceTargetNews struct {
+	AdjPriceTarget  float64   `json:"adjPriceTarget"`
+	AnalystCompany  string    `json:"analystCompany"`
+	AnalystName     string    `json:"analystName"`
+	NewsBaseURL     string    `json:"newsBaseURL"`
+	NewsPublisher   string    `json:"newsPublisher"`
+	NewsTitle       string    `json:"newsTitle"`
+	NewsURL         string    `json:"newsURL"`
+	PriceTarget     float64   `json:"priceTarget"`
+	PriceWhenPosted float64   `json:"priceWhenPosted"`
+	PublishedDate   time.Time `json:"publishedDate"`
+	Symbol          string    `json:"symbol"`
+}
+
+// PriceTargetSummary defines model for PriceTargetSummary.
+type PriceTargetSummary struct {
+	AllTimeAvgPriceTarget     float64 `json:"allTimeAvgPriceTarget"`
+	AllTimeCount              int     `json:"allTimeCount"`
+	LastMonthAvgPriceTarget   float64 `json:"lastMonthAvgPriceTarget"`
+	LastMonthCount            int     `json:"lastMonthCount"`
+	LastQuarterAvgPriceTarget float64 `json:"lastQuarterAvgPriceTarget"`
+	LastQuarterCount          int     `json:"lastQuarterCount"`
+	LastYearAvgPriceTarget    float64 `json:"lastYearAvgPriceTarget"`
+	LastYearCount             int     `json:"lastYearCount"`
+	Publishers                string  `json:"publishers"`
+	Symbol                    string  `json:"symbol"`
+}
+
 // RatiosTTM defines model for RatiosTTM.
 type RatiosTTM struct {
 	AssetTurnoverTTM                           float64 `json:"assetTurnoverTTM"`
@@ -1057,6 +1304,14 @@ type StockRating struct {
 	Symbol                  string `json:"symbol"`
 }
 
+// SymbolChange defines model for SymbolChange.
+type SymbolChange struct {
+	CompanyName string             `json:"companyName"`
+	Date        openapi_types.Date `json:"date"`
+	NewSymbol   string             `json:"newSymbol"`
+	OldSymbol   string             `json:"oldSymbol"`
+}
+
 // TechnicalIndicator defines model for TechnicalIndicator.
 type TechnicalIndicator struct {
 	Close  float64  `json:"close"`
@@ -1158,12 +1413,86 @@ type CashFlowStatementTTMGetParams struct {
 	Limit  *int   `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// CompanyScreenerGetParams defines parameters for CompanyScreenerGet.
+type CompanyScreenerGetParams struct {
+	MarketCapMoreThan      *float64 `form:"marketCapMoreThan,omitempty" json:"marketCapMoreThan,omitempty"`
+	MarketCapLowerThan     *float64 `form:"marketCapLowerThan,omitempty" json:"marketCapLowerThan,omitempty"`
+	Sector                 *string  `form:"sector,omitempty" json:"sector,omitempty"`
+	Industry               *string  `form:"industry,omitempty" json:"industry,omitempty"`
+	BetaMoreThan           *float64 `form:"betaMoreThan,omitempty" json:"betaMoreThan,omitempty"`
+	BetaLowerThan          *float64 `form:"betaLowerThan,omitempty" json:"betaLowerThan,omitempty"`
+	PriceMoreThan          *float64 `form:"priceMoreThan,omitempty" json:"priceMoreThan,omitempty"`
+	PriceLowerThan         *float64 `form:"priceLowerThan,omitempty" json:"priceLowerThan,omitempty"`
+	DividendMoreThan       *float64 `form:"dividendMoreThan,omitempty" json:"dividendMoreThan,omitempty"`
+	DividendLowerThan      *float64 `form:"dividendLowerThan,omitempty" json:"dividendLowerThan,omitempty"`
+	VolumeMoreThan         *float64 `form:"volumeMoreThan,omitempty" json:"volumeMoreThan,omitempty"`
+	VolumeLowerThan        *float64 `form:"volumeLowerThan,omitempty" json:"volumeLowerThan,omitempty"`
+	Exchange               *string  `form:"exchange,omitempty" json:"exchange,omitempty"`
+	Country                *string  `form:"country,omitempty" json:"country,omitempty"`
+	IsEtf                  *bool    `form:"isEtf,omitempty" json:"isEtf,omitempty"`
+	IsFund                 *bool    `form:"isFund,omitempty" json:"isFund,omitempty"`
+	IsActivelyTrading      *bool    `form:"isActivelyTrading,omitempty" json:"isActivelyTrading,omitempty"`
+	IncludeAllShareClasses *bool    `form:"includeAllShareClasses,omitempty" json:"includeAllShareClasses,omitempty"`
+	Limit                  *int     `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CustomDiscountedCashFlowGetParams defines parameters for CustomDiscountedCashFlowGet.
+type CustomDiscountedCashFlowGetParams struct {
+	Symbol                                     string   `form:"symbol" json:"symbol"`
+	RevenueGrowthPct                           *float64 `form:"revenueGrowthPct,omitempty" json:"revenueGrowthPct,omitempty"`
+	EbitdaPct                                  *float64 `form:"ebitdaPct,omitempty" json:"ebitdaPct,omitempty"`
+	DepreciationAndAmortizationPct             *float64 `form:"depreciationAndAmortizationPct,omitempty" json:"depreciationAndAmortizationPct,omitempty"`
+	CashAndShortTermInvestmentsPct             *float64 `form:"cashAndShortTermInvestmentsPct,omitempty" json:"cashAndShortTermInvestmentsPct,omitempty"`
+	ReceivablesPct                             *float64 `form:"receivablesPct,omitempty" json:"receivablesPct,omitempty"`
+	InventoriesPct                             *float64 `form:"inventoriesPct,omitempty" json:"inventoriesPct,omitempty"`
+	PayablePct                                 *float64 `form:"payablePct,omitempty" json:"payablePct,omitempty"`
+	EbitPct                                    *float64 `form:"ebitPct,omitempty" json:"ebitPct,omitempty"`
+	CapitalExpenditurePct                      *float64 `form:"capitalExpenditurePct,omitempty" json:"capitalExpenditurePct,omitempty"`
+	OperatingCashFlowPct                       *float64 `form:"operatingCashFlowPct,omitempty" json:"operatingCashFlowPct,omitempty"`
+	SellingGeneralAndAdministrativeExpensesPct *float64 `form:"sellingGeneralAndAdministrativeExpensesPct,omitempty" json:"sellingGeneralAndAdministrativeExpensesPct,omitempty"`
+	TaxRate                                    *float64 `form:"taxRate,omitempty" json:"taxRate,omitempty"`
+	LongTermGrowthRate                         *float64 `form:"longTermGrowthRate,omitempty" json:"longTermGrowthRate,omitempty"`
+	CostOfDebt                                 *float64 `form:"costOfDebt,omitempty" json:"costOfDebt,omitempty"`
+	CostOfEquity                               *float64 `form:"costOfEquity,omitempty" json:"costOfEquity,omitempty"`
+	MarketRiskPremium                          *float64 `form:"marketRiskPremium,omitempty" json:"marketRiskPremium,omitempty"`
+	Beta                                       *float64 `form:"beta,omitempty" json:"beta,omitempty"`
+	RiskFreeRate                               *float64 `form:"riskFreeRate,omitempty" json:"riskFreeRate,omitempty"`
+}
+
+// CustomLeveredDiscountedCashFlowGetParams defines parameters for CustomLeveredDiscountedCashFlowGet.
+type CustomLeveredDiscountedCashFlowGetParams struct {
+	Symbol                                     string   `form:"symbol" json:"symbol"`
+	RevenueGrowthPct                           *float64 `form:"revenueGrowthPct,omitempty" json:"revenueGrowthPct,omitempty"`
+	EbitdaPct                                  *float64 `form:"ebitdaPct,omitempty" json:"ebitdaPct,omitempty"`
+	DepreciationAndAmortizationPct             *float64 `form:"depreciationAndAmortizationPct,omitempty" json:"depreciationAndAmortizationPct,omitempty"`
+	CashAndShortTermInvestmentsPct             *float64 `form:"cashAndShortTermInvestmentsPct,omitempty" json:"cashAndShortTermInvestmentsPct,omitempty"`
+	ReceivablesPct                             *float64 `form:"receivablesPct,omitempty" json:"receivablesPct,omitempty"`
+	InventoriesPct                             *float64 `form:"inventoriesPct,omitempty" json:"inventoriesPct,omitempty"`
+	PayablePct                                 *float64 `form:"payablePct,omitempty" json:"payablePct,omitempty"`
+	EbitPct                                    *float64 `form:"ebitPct,omitempty" json:"ebitPct,omitempty"`
+	CapitalExpenditurePct                      *float64 `form:"capitalExpenditurePct,omitempty" json:"capitalExpenditurePct,omitempty"`
+	OperatingCashFlowPct                       *float64 `form:"operatingCashFlowPct,omitempty" json:"operatingCashFlowPct,omitempty"`
+	SellingGeneralAndAdministrativeExpensesPct *float64 `form:"sellingGeneralAndAdministrativeExpensesPct,omitempty" json:"sellingGeneralAndAdministrativeExpensesPct,omitempty"`
+	TaxRate                                    *float64 `form:"taxRate,omitempty" json:"taxRate,omitempty"`
+	LongTermGrowthRate                         *float64 `form:"longTermGrowthRate,omitempty" json:"longTermGrowthRate,omitempty"`
+	CostOfDebt                                 *float64 `form:"costOfDebt,omitempty" json:"costOfDebt,omitempty"`
+	CostOfEquity                               *float64 `form:"costOfEquity,omitempty" json:"costOfEquity,omitempty"`
+	MarketRiskPremium                          *float64 `form:"marketRiskPremium,omitempty" json:"marketRiskPremium,omitempty"`
+	Beta                                       *float64 `form:"beta,omitempty" json:"beta,omitempty"`
+	RiskFreeRate                               *float64 `form:"riskFreeRate,omitempty" json:"riskFreeRate,omitempty"`
+}
+
 // DelistedCompaniesParams defines parameters for DelistedCompanies.
 type DelistedCompaniesParams struct {
 	Page  *int `form:"page,omitempty" json:"page,omitempty"`
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// DiscountedCashFlowGetParams defines parameters for DiscountedCashFlowGet.
+type DiscountedCashFlowGetParams struct {
+	Symbol string `form:"symbol" json:"symbol"`
+}
+
 // DividendsGetParams defines parameters for DividendsGet.
 type DividendsGetParams struct {
 	Symbol string `form:"symbol" json:"symbol"`
@@ -1229,6 +1558,27 @@ type ETFSectorWeightingsGetParams struct {
 	Symbol string `form:"symbol" json:"symbol"`
 }
 
+// ExchangeMarketHoursGetParams defines parameters for ExchangeMarketHoursGet.
+type ExchangeMarketHoursGetParams struct {
+	Exchange string `form:"exchange" json:"exchange"`
+}
+
+// FinancialScoresGetParams defines parameters for FinancialScoresGet.
+type FinancialScoresGetParams struct {
+	Symbol string `form:"symbol" json:"symbol"`
+}
+
+// GradesGetParams defines parameters for GradesGet.
+type GradesGetParams struct {
+	Symbol string `form:"symbol" json:"symbol"`
+}
+
+// GradesHistoricalGetParams defines parameters for GradesHistoricalGet.
+type GradesHistoricalGetParams struct {
+	Symbol string `form:"symbol" json:"symbol"`
+	Limit  *int   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GradesLatestNewsGetParams defines parameters for GradesLatestNewsGet.
 type GradesLatestNewsGetParams struct {
 	Page  *int `form:"page,omitempty" json:"page,omitempty"`
@@ -1257,6 +1607,13 @@ type HistoricalPriceEodLightGetParams struct {
 	To     *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// HolidaysByExchangeGetParams defines parameters for HolidaysByExchangeGet.
+type HolidaysByExchangeGetParams struct {
+	Exchange string              `form:"exchange" json:"exchange"`
+	From     *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+	To       *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // IncomeStatementGetParams defines parameters for IncomeStatementGet.
 type IncomeStatementGetParams struct {
 	Symbol string  `form:"symbol" json:"symbol"`
@@ -1309,6 +1666,11 @@ type KeyMetricsTTMGetParams struct {
 	Symbol string `form:"symbol" json:"symbol"`
 }
 
+// LeveredDiscountedCashFlowGetParams defines parameters for LeveredDiscountedCashFlowGet.
+type LeveredDiscountedCashFlowGetParams struct {
+	Symbol string `form:"symbol" json:"symbol"`
+}
+
 // MarketCapitalizationGetParams defines parameters for MarketCapitalizationGet.
 type MarketCapitalizationGetParams struct {
 	Symbol string `form:"symbol" json:"symbol"`
@@ -1336,6 +1698,29 @@ type NewsStockLatestGetParams struct {
 	Limit *int                `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// PriceTargetConsensusGetParams defines parameters for PriceTargetConsensusGet.
+type PriceTargetConsensusGetParams struct {
+	Symbol string `form:"symbol" json:"symbol"`
+}
+
+// PriceTargetLatestNewsGetParams defines parameters for PriceTargetLatestNewsGet.
+type PriceTargetLatestNewsGetParams struct {
+	Page  *int `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PriceTargetNewsGetParams defines parameters for PriceTargetNewsGet.
+type PriceTargetNewsGetParams struct {
+	Symbol string `form:"symbol" json:"symbol"`
+	Page   *int   `form:"page,omitempty" json:"page,omitempty"`
+	Limit  *int   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PriceTargetSummaryGetParams defines parameters for PriceTargetSummaryGet.
+type PriceTargetSummaryGetParams struct {
+	Symbol string `form:"symbol" json:"symbol"`
+}
+
 // ProfileGetParams defines parameters for ProfileGet.
 type ProfileGetParams struct {
 	Symbol string `form:"symbol" json:"symbol"`
@@ -1388,6 +1773,21 @@ type RevenueProductSegmentationGetParams struct {
 	Structure *string `form:"structure,omitempty" json:"structure,omitempty"`
 }
 
+// SearchCIKGetParams defines parameters for SearchCIKGet.
+type SearchCIKGetParams struct {
+	Cik string `form:"cik" json:"cik"`
+}
+
+// SearchCUSIPGetParams defines parameters for SearchCUSIPGet.
+type SearchCUSIPGetParams struct {
+	Cusip string `form:"cusip" json:"cusip"`
+}
+
+// SearchISINGetParams defines parameters for SearchISINGet.
+type SearchISINGetParams struct {
+	Isin string `form:"isin" json:"isin"`
+}
+
 // SearchNameGetParams defines parameters for SearchNameGet.
 type SearchNameGetParams struct {
 	SearchSymbol string  `form:"search-symbol" json:"search-symbol"`
@@ -1419,6 +1819,12 @@ type GetSplitsCalendarParams struct {
 	To   *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
 }
 
+// SymbolChangeGetParams defines parameters for SymbolChangeGet.
+type SymbolChangeGetParams struct {
+	Invalid *bool `form:"invalid,omitempty" json:"invalid,omitempty"`
+	Limit   *int  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // TechnicalIndicatorsRsiGetParams defines parameters for TechnicalIndicatorsRsiGet.
 type TechnicalIndicatorsRsiGetParams struct {
 	Symbol       string              `form:"symbol" json:"symbol"`
@@ -1438,6 +1844,9 @@ type OperationPath string
 
 const (
 
+	// /all-exchange-market-hours
+	AllExchangeMarketHoursGetOperationPath OperationPath = "/all-exchange-market-hours"
+
 	// /analyst-estimates
 	AnalystEstimatesGetOperationPath OperationPath = "/analyst-estimates"
 
@@ -1474,18 +1883,33 @@ const (
 	// /commodities-list
 	CommoditiesListGetOperationPath OperationPath = "/commodities-list"
 
+	// /company-screener
+	CompanyScreenerGetOperationPath OperationPath = "/company-screener"
+
+	// /custom-discounted-cash-flow
+	CustomDiscountedCashFlowGetOperationPath OperationPath = "/custom-discounted-cash-flow"
+
+	// /custom-levered-discounted-cash-flow
+	CustomLeveredDiscountedCashFlowGetOperationPath OperationPath = "/custom-levered-discounted-cash-flow"
+
 	// /dcf-bulk
 	DcfBulkGetOperationPath OperationPath = "/dcf-bulk"
 
 	// /delisted-companies
 	DelistedCompaniesOperationPath OperationPath = "/delisted-companies"
 
+	// /discounted-cash-flow
+	DiscountedCashFlowGetOperationPath OperationPath = "/discounted-cash-flow"
+
 	// /dividends
 	DividendsGetOperationPath OperationPath = "/dividends"
 
 	// /dividends-calendar
 	DividendsCalendarGetOperationPath OperationPath = "/dividends-calendar"
 
+	// /dowjones-constituent
+	DowJonesConstituentGetOperationPath OperationPath = "/dowjones-constituent"
+
 	// /earnings
 	EarningsGetOperationPath OperationPath = "/earnings"
 
@@ -1519,21 +1943,45 @@ const (
 	// /etf/sector-weightings
 	ETFSectorWeightingsGetOperationPath OperationPath = "/etf/sector-weightings"
 
+	// /exchange-market-hours
+	ExchangeMarketHoursGetOperationPath OperationPath = "/exchange-market-hours"
+
+	// /financial-scores
+	FinancialScoresGetOperationPath OperationPath = "/financial-scores"
+
 	// /forex-list
 	ForexCurrencyPairsGetOperationPath OperationPath = "/forex-list"
 
+	// /grades
+	GradesGetOperationPath OperationPath = "/grades"
+
+	// /grades-historical
+	GradesHistoricalGetOperationPath OperationPath = "/grades-historical"
+
 	// /grades-latest-news
 	GradesLatestNewsGetOperationPath OperationPath = "/grades-latest-news"
 
 	// /historical-chart/15min
 	HistoricalChart15MinGetOperationPath OperationPath = "/historical-chart/15min"
 
+	// /historical-dowjones-constituent
+	HistoricalDowJonesConstituentGetOperationPath OperationPath = "/historical-dowjones-constituent"
+
+	// /historical-nasdaq-constituent
+	HistoricalNasdaqConstituentGetOperationPath OperationPath = "/historical-nasdaq-constituent"
+
 	// /historical-price-eod/full
 	HistoricalPriceEodFullGetOperationPath OperationPath = "/historical-price-eod/full"
 
 	// /historical-price-eod/light
 	HistoricalPriceEodLightGetOperationPath OperationPath = "/historical-price-eod/light"
 
+	// /historical-sp500-constituent
+	HistoricalSP500ConstituentGetOperationPath OperationPath = "/historical-sp500-constituent"
+
+	// /holidays-by-exchange
+	HolidaysByExchangeGetOperationPath OperationPath = "/holidays-by-exchange"
+
 	// /income-statement
 	IncomeStatementGetOperationPath OperationPath = "/income-statement"
 
@@ -1564,18 +2012,36 @@ const (
 	// /key-metrics-ttm-bulk
 	KeyMetricsTTMBulkGetOperationPath OperationPath = "/key-metrics-ttm-bulk"
 
+	// /levered-discounted-cash-flow
+	LeveredDiscountedCashFlowGetOperationPath OperationPath = "/levered-discounted-cash-flow"
+
 	// /market-capitalization
 	MarketCapitalizationGetOperationPath OperationPath = "/market-capitalization"
 
 	// /market-capitalization-batch
 	MarketCapitalizationBatchGetOperationPath OperationPath = "/market-capitalization-batch"
 
+	// /nasdaq-constituent
+	NasdaqConstituentGetOperationPath OperationPath = "/nasdaq-constituent"
+
 	// /news/general-latest
 	NewsGeneralLatestGetOperationPath OperationPath = "/news/general-latest"
 
 	// /news/stock-latest
 	NewsStockLatestGetOperationPath OperationPath = "/news/stock-latest"
 
+	// /price-target-consensus
+	PriceTargetConsensusGetOperationPath OperationPath = "/price-target-consensus"
+
+	// /price-target-latest-news
+	PriceTargetLatestNewsGetOperationPath OperationPath = "/price-target-latest-news"
+
+	// /price-target-news
+	PriceTargetNewsGetOperationPath OperationPath = "/price-target-news"
+
+	// /price-target-summary
+	PriceTargetSummaryGetOperationPath OperationPath = "/price-target-summary"
+
 	// /profile
 	ProfileGetOperationPath OperationPath = "/profile"
 
@@ -1609,6 +2075,15 @@ const (
 	// /revenue-product-segmentation
 	RevenueProductSegmentationGetOperationPath OperationPath = "/revenue-product-segmentation"
 
+	// /search-cik
+	SearchCIKGetOperationPath OperationPath = "/search-cik"
+
+	// /search-cusip
+	SearchCUSIPGetOperationPath OperationPath = "/search-cusip"
+
+	// /search-isin
+	SearchISINGetOperationPath OperationPath = "/search-isin"
+
 	// /search-name
 	SearchNameGetOperationPath OperationPath = "/search-name"
 
@@ -1618,6 +2093,9 @@ const (
 	// /shares-float
 	SharesFloatGetOperationPath OperationPath = "/shares-float"
 
+	// /sp500-constituent
+	SP500ConstituentGetOperationPath OperationPath = "/sp500-constituent"
+
 	// /splits
 	GetSplitsOperationPath OperationPath = "/splits"
 
@@ -1627,6 +2105,9 @@ const (
 	// /stock-list
 	StockListGetOperationPath OperationPath = "/stock-list"
 
+	// /symbol-change
+	SymbolChangeGetOperationPath OperationPath = "/symbol-change"
+
 	// /technical-indicators/rsi
 	TechnicalIndicatorsRsiGetOperationPath OperationPath = "/technical-indicators/rsi"
 
@@ -1707,6 +2188,9 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// AllExchangeMarketHoursGet request
+	AllExchangeMarketHoursGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AnalystEstimatesGet request
 	AnalystEstimatesGet(ctx context.Context, params *AnalystEstimatesGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1743,18 +2227,33 @@ type ClientInterface interface {
 	// CommoditiesListGet request
 	CommoditiesListGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CompanyScreenerGet request
+	CompanyScreenerGet(ctx context.Context, params *CompanyScreenerGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CustomDiscountedCashFlowGet request
+	CustomDiscountedCashFlowGet(ctx context.Context, params *CustomDiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CustomLeveredDiscountedCashFlowGet request
+	CustomLeveredDiscountedCashFlowGet(ctx context.Context, params *CustomLeveredDiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DcfBulkGet request
 	DcfBulkGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DelistedCompanies request
 	DelistedCompanies(ctx context.Context, params *DelistedCompaniesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DiscountedCashFlowGet request
+	DiscountedCashFlowGet(ctx context.Context, params *DiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DividendsGet request
 	DividendsGet(ctx context.Context, params *DividendsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DividendsCalendarGet request
 	DividendsCalendarGet(ctx context.Context, params *DividendsCalendarGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DowJonesConstituentGet request
+	DowJonesConstituentGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// EarningsGet request
 	EarningsGet(ctx context.Context, params *EarningsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1788,21 +2287,45 @@ type ClientInterface interface {
 	// ETFSectorWeightingsGet request
 	ETFSectorWeightingsGet(ctx context.Context, params *ETFSectorWeightingsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ExchangeMarketHoursGet request
+	ExchangeMarketHoursGet(ctx context.Context, params *ExchangeMarketHoursGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FinancialScoresGet request
+	FinancialScoresGet(ctx context.Context, params *FinancialScoresGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ForexCurrencyPairsGet request
 	ForexCurrencyPairsGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GradesGet request
+	GradesGet(ctx context.Context, params *GradesGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GradesHistoricalGet request
+	GradesHistoricalGet(ctx context.Context, params *GradesHistoricalGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GradesLatestNewsGet request
 	GradesLatestNewsGet(ctx context.Context, params *GradesLatestNewsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// HistoricalChart15MinGet request
 	HistoricalChart15MinGet(ctx context.Context, params *HistoricalChart15MinGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// HistoricalDowJonesConstituentGet request
+	HistoricalDowJonesConstituentGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HistoricalNasdaqConstituentGet request
+	HistoricalNasdaqConstituentGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// HistoricalPriceEodFullGet request
 	HistoricalPriceEodFullGet(ctx context.Context, params *HistoricalPriceEodFullGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// HistoricalPriceEodLightGet request
 	HistoricalPriceEodLightGet(ctx context.Context, params *HistoricalPriceEodLightGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// HistoricalSP500ConstituentGet request
+	HistoricalSP500ConstituentGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HolidaysByExchangeGet request
+	HolidaysByExchangeGet(ctx context.Context, params *HolidaysByExchangeGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// IncomeStatementGet request
 	IncomeStatementGet(ctx context.Context, params *IncomeStatementGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1833,18 +2356,36 @@ type ClientInterface interface {
 	// KeyMetricsTTMBulkGet request
 	KeyMetricsTTMBulkGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// LeveredDiscountedCashFlowGet request
+	LeveredDiscountedCashFlowGet(ctx context.Context, params *LeveredDiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// MarketCapitalizationGet request
 	MarketCapitalizationGet(ctx context.Context, params *MarketCapitalizationGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// MarketCapitalizationBatchGet request
 	MarketCapitalizationBatchGet(ctx context.Context, params *MarketCapitalizationBatchGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// NasdaqConstituentGet request
+	NasdaqConstituentGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// NewsGeneralLatestGet request
 	NewsGeneralLatestGet(ctx context.Context, params *NewsGeneralLatestGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// NewsStockLatestGet request
 	NewsStockLatestGet(ctx context.Context, params *NewsStockLatestGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PriceTargetConsensusGet request
+	PriceTargetConsensusGet(ctx context.Context, params *PriceTargetConsensusGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PriceTargetLatestNewsGet request
+	PriceTargetLatestNewsGet(ctx context.Context, params *PriceTargetLatestNewsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PriceTargetNewsGet request
+	PriceTargetNewsGet(ctx context.Context, params *PriceTargetNewsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PriceTargetSummaryGet request
+	PriceTargetSummaryGet(ctx context.Context, params *PriceTargetSummaryGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ProfileGet request
 	ProfileGet(ctx context.Context, params *ProfileGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1878,6 +2419,15 @@ type ClientInterface interface {
 	// RevenueProductSegmentationGet request
 	RevenueProductSegmentationGet(ctx context.Context, params *RevenueProductSegmentationGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SearchCIKGet request
+	SearchCIKGet(ctx context.Context, params *SearchCIKGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchCUSIPGet request
+	SearchCUSIPGet(ctx context.Context, params *SearchCUSIPGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchISINGet request
+	SearchISINGet(ctx context.Context, params *SearchISINGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SearchNameGet request
 	SearchNameGet(ctx context.Context, params *SearchNameGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1887,6 +2437,9 @@ type ClientInterface interface {
 	// SharesFloatGet request
 	SharesFloatGet(ctx context.Context, params *SharesFloatGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SP500ConstituentGet request
+	SP500ConstituentGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetSplits request
 	GetSplits(ctx context.Context, params *GetSplitsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1896,6 +2449,9 @@ type ClientInterface interface {
 	// StockListGet request
 	StockListGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SymbolChangeGet request
+	SymbolChangeGet(ctx context.Context, params *SymbolChangeGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// TechnicalIndicatorsRsiGet request
 	TechnicalIndicatorsRsiGet(ctx context.Context, params *TechnicalIndicatorsRsiGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1903,6 +2459,18 @@ type ClientInterface interface {
 	TreasuryRatesGet(ctx context.Context, params *TreasuryRatesGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) AllExchangeMarketHoursGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAllExchangeMarketHoursGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) AnalystEstimatesGet(ctx context.Context, params *AnalystEstimatesGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewAnalystEstimatesGetRequest(c.Server, params)
 	if err != nil {
@@ -2047,6 +2615,42 @@ func (c *Client) CommoditiesListGet(ctx context.Context, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
+func (c *Client) CompanyScreenerGet(ctx context.Context, params *CompanyScreenerGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompanyScreenerGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CustomDiscountedCashFlowGet(ctx context.Context, params *CustomDiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCustomDiscountedCashFlowGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CustomLeveredDiscountedCashFlowGet(ctx context.Context, params *CustomLeveredDiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCustomLeveredDiscountedCashFlowGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DcfBulkGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDcfBulkGetRequest(c.Server)
 	if err != nil {
@@ -2071,6 +2675,18 @@ func (c *Client) DelistedCompanies(ctx context.Context, params *DelistedCompanie
 	return c.Client.Do(req)
 }
 
+func (c *Client) DiscountedCashFlowGet(ctx context.Context, params *DiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDiscountedCashFlowGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DividendsGet(ctx context.Context, params *DividendsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDividendsGetRequest(c.Server, params)
 	if err != nil {
@@ -2095,6 +2711,18 @@ func (c *Client) DividendsCalendarGet(ctx context.Context, params *DividendsCale
 	return c.Client.Do(req)
 }
 
+func (c *Client) DowJonesConstituentGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDowJonesConstituentGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) EarningsGet(ctx context.Context, params *EarningsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewEarningsGetRequest(c.Server, params)
 	if err != nil {
@@ -2227,8 +2855,8 @@ func (c *Client) ETFSectorWeightingsGet(ctx context.Context, params *ETFSectorWe
 	return c.Client.Do(req)
 }
 
-func (c *Client) ForexCurrencyPairsGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewForexCurrencyPairsGetRequest(c.Server)
+func (c *Client) ExchangeMarketHoursGet(ctx context.Context, params *ExchangeMarketHoursGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExchangeMarketHoursGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2239,8 +2867,8 @@ func (c *Client) ForexCurrencyPairsGet(ctx context.Context, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) GradesLatestNewsGet(ctx context.Context, params *GradesLatestNewsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGradesLatestNewsGetRequest(c.Server, params)
+func (c *Client) FinancialScoresGet(ctx context.Context, params *FinancialScoresGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFinancialScoresGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2251,8 +2879,8 @@ func (c *Client) GradesLatestNewsGet(ctx context.Context, params *GradesLatestNe
 	return c.Client.Do(req)
 }
 
-func (c *Client) HistoricalChart15MinGet(ctx context.Context, params *HistoricalChart15MinGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHistoricalChart15MinGetRequest(c.Server, params)
+func (c *Client) ForexCurrencyPairsGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewForexCurrencyPairsGetRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -2263,8 +2891,8 @@ func (c *Client) HistoricalChart15MinGet(ctx context.Context, params *Historical
 	return c.Client.Do(req)
 }
 
-func (c *Client) HistoricalPriceEodFullGet(ctx context.Context, params *HistoricalPriceEodFullGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHistoricalPriceEodFullGetRequest(c.Server, params)
+func (c *Client) GradesGet(ctx context.Context, params *GradesGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGradesGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2275,8 +2903,8 @@ func (c *Client) HistoricalPriceEodFullGet(ctx context.Context, params *Historic
 	return c.Client.Do(req)
 }
 
-func (c *Client) HistoricalPriceEodLightGet(ctx context.Context, params *HistoricalPriceEodLightGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHistoricalPriceEodLightGetRequest(c.Server, params)
+func (c *Client) GradesHistoricalGet(ctx context.Context, params *GradesHistoricalGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGradesHistoricalGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2287,8 +2915,8 @@ func (c *Client) HistoricalPriceEodLightGet(ctx context.Context, params *Histori
 	return c.Client.Do(req)
 }
 
-func (c *Client) IncomeStatementGet(ctx context.Context, params *IncomeStatementGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewIncomeStatementGetRequest(c.Server, params)
+func (c *Client) GradesLatestNewsGet(ctx context.Context, params *GradesLatestNewsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGradesLatestNewsGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2299,8 +2927,8 @@ func (c *Client) IncomeStatementGet(ctx context.Context, params *IncomeStatement
 	return c.Client.Do(req)
 }
 
-func (c *Client) IncomeStatementBulkGet(ctx context.Context, params *IncomeStatementBulkGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewIncomeStatementBulkGetRequest(c.Server, params)
+func (c *Client) HistoricalChart15MinGet(ctx context.Context, params *HistoricalChart15MinGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHistoricalChart15MinGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2311,8 +2939,8 @@ func (c *Client) IncomeStatementBulkGet(ctx context.Context, params *IncomeState
 	return c.Client.Do(req)
 }
 
-func (c *Client) IncomeStatementTTMGet(ctx context.Context, params *IncomeStatementTTMGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewIncomeStatementTTMGetRequest(c.Server, params)
+func (c *Client) HistoricalDowJonesConstituentGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHistoricalDowJonesConstituentGetRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -2323,8 +2951,8 @@ func (c *Client) IncomeStatementTTMGet(ctx context.Context, params *IncomeStatem
 	return c.Client.Do(req)
 }
 
-func (c *Client) IndexConstituentListGet(ctx context.Context, params *IndexConstituentListGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewIndexConstituentListGetRequest(c.Server, params)
+func (c *Client) HistoricalNasdaqConstituentGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHistoricalNasdaqConstituentGetRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -2335,8 +2963,8 @@ func (c *Client) IndexConstituentListGet(ctx context.Context, params *IndexConst
 	return c.Client.Do(req)
 }
 
-func (c *Client) IndexListGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewIndexListGetRequest(c.Server)
+func (c *Client) HistoricalPriceEodFullGet(ctx context.Context, params *HistoricalPriceEodFullGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHistoricalPriceEodFullGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2347,8 +2975,8 @@ func (c *Client) IndexListGet(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) InsiderTradingLatestGet(ctx context.Context, params *InsiderTradingLatestGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewInsiderTradingLatestGetRequest(c.Server, params)
+func (c *Client) HistoricalPriceEodLightGet(ctx context.Context, params *HistoricalPriceEodLightGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHistoricalPriceEodLightGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2359,8 +2987,8 @@ func (c *Client) InsiderTradingLatestGet(ctx context.Context, params *InsiderTra
 	return c.Client.Do(req)
 }
 
-func (c *Client) InsiderTradingSearchGet(ctx context.Context, params *InsiderTradingSearchGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewInsiderTradingSearchGetRequest(c.Server, params)
+func (c *Client) HistoricalSP500ConstituentGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHistoricalSP500ConstituentGetRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -2371,8 +2999,8 @@ func (c *Client) InsiderTradingSearchGet(ctx context.Context, params *InsiderTra
 	return c.Client.Do(req)
 }
 
-func (c *Client) KeyMetricsGet(ctx context.Context, params *KeyMetricsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewKeyMetricsGetRequest(c.Server, params)
+func (c *Client) HolidaysByExchangeGet(ctx context.Context, params *HolidaysByExchangeGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHolidaysByExchangeGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2383,8 +3011,8 @@ func (c *Client) KeyMetricsGet(ctx context.Context, params *KeyMetricsGetParams,
 	return c.Client.Do(req)
 }
 
-func (c *Client) KeyMetricsTTMGet(ctx context.Context, params *KeyMetricsTTMGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewKeyMetricsTTMGetRequest(c.Server, params)
+func (c *Client) IncomeStatementGet(ctx context.Context, params *IncomeStatementGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIncomeStatementGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2395,8 +3023,8 @@ func (c *Client) KeyMetricsTTMGet(ctx context.Context, params *KeyMetricsTTMGetP
 	return c.Client.Do(req)
 }
 
-func (c *Client) KeyMetricsTTMBulkGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewKeyMetricsTTMBulkGetRequest(c.Server)
+func (c *Client) IncomeStatementBulkGet(ctx context.Context, params *IncomeStatementBulkGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIncomeStatementBulkGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2407,8 +3035,8 @@ func (c *Client) KeyMetricsTTMBulkGet(ctx context.Context, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) MarketCapitalizationGet(ctx context.Context, params *MarketCapitalizationGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMarketCapitalizationGetRequest(c.Server, params)
+func (c *Client) IncomeStatementTTMGet(ctx context.Context, params *IncomeStatementTTMGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIncomeStatementTTMGetRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -2419,9 +3047,129 @@ func (c *Client) MarketCapitalizationGet(ctx context.Context, params *MarketCapi
 	return c.Client.Do(req)
 }
 
-func (c *Client) MarketCapitalizationBatchGet(ctx context.Context, params *MarketCapitalizationBatchGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMarketCapitalizationBatchGetRequest(c.Server, params)
-	if err != nil {
+func (c *Client) IndexConstituentListGet(ctx context.Context, params *IndexConstituentListGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIndexConstituentListGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IndexListGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIndexListGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InsiderTradingLatestGet(ctx context.Context, params *InsiderTradingLatestGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInsiderTradingLatestGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InsiderTradingSearchGet(ctx context.Context, params *InsiderTradingSearchGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInsiderTradingSearchGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) KeyMetricsGet(ctx context.Context, params *KeyMetricsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewKeyMetricsGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) KeyMetricsTTMGet(ctx context.Context, params *KeyMetricsTTMGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewKeyMetricsTTMGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) KeyMetricsTTMBulkGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewKeyMetricsTTMBulkGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LeveredDiscountedCashFlowGet(ctx context.Context, params *LeveredDiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLeveredDiscountedCashFlowGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MarketCapitalizationGet(ctx context.Context, params *MarketCapitalizationGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMarketCapitalizationGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MarketCapitalizationBatchGet(ctx context.Context, params *MarketCapitalizationBatchGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMarketCapitalizationBatchGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NasdaqConstituentGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNasdaqConstituentGetRequest(c.Server)
+	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
@@ -2455,6 +3203,54 @@ func (c *Client) NewsStockLatestGet(ctx context.Context, params *NewsStockLatest
 	return c.Client.Do(req)
 }
 
+func (c *Client) PriceTargetConsensusGet(ctx context.Context, params *PriceTargetConsensusGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPriceTargetConsensusGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PriceTargetLatestNewsGet(ctx context.Context, params *PriceTargetLatestNewsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPriceTargetLatestNewsGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PriceTargetNewsGet(ctx context.Context, params *PriceTargetNewsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPriceTargetNewsGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PriceTargetSummaryGet(ctx context.Context, params *PriceTargetSummaryGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPriceTargetSummaryGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ProfileGet(ctx context.Context, params *ProfileGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewProfileGetRequest(c.Server, params)
 	if err != nil {
@@ -2587,6 +3383,42 @@ func (c *Client) RevenueProductSegmentationGet(ctx context.Context, params *Reve
 	return c.Client.Do(req)
 }
 
+func (c *Client) SearchCIKGet(ctx context.Context, params *SearchCIKGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchCIKGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchCUSIPGet(ctx context.Context, params *SearchCUSIPGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchCUSIPGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchISINGet(ctx context.Context, params *SearchISINGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchISINGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) SearchNameGet(ctx context.Context, params *SearchNameGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewSearchNameGetRequest(c.Server, params)
 	if err != nil {
@@ -2623,6 +3455,18 @@ func (c *Client) SharesFloatGet(ctx context.Context, params *SharesFloatGetParam
 	return c.Client.Do(req)
 }
 
+func (c *Client) SP500ConstituentGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSP500ConstituentGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetSplits(ctx context.Context, params *GetSplitsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetSplitsRequest(c.Server, params)
 	if err != nil {
@@ -2659,6 +3503,18 @@ func (c *Client) StockListGet(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
+func (c *Client) SymbolChangeGet(ctx context.Context, params *SymbolChangeGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSymbolChangeGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) TechnicalIndicatorsRsiGet(ctx context.Context, params *TechnicalIndicatorsRsiGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewTechnicalIndicatorsRsiGetRequest(c.Server, params)
 	if err != nil {
@@ -2683,6 +3539,33 @@ func (c *Client) TreasuryRatesGet(ctx context.Context, params *TreasuryRatesGetP
 	return c.Client.Do(req)
 }
 
+// NewAllExchangeMarketHoursGetRequest generates requests for AllExchangeMarketHoursGet
+func NewAllExchangeMarketHoursGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/all-exchange-market-hours")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewAnalystEstimatesGetRequest generates requests for AnalystEstimatesGet
 func NewAnalystEstimatesGetRequest(server string, params *AnalystEstimatesGetParams) (*http.Request, error) {
 	var err error
@@ -3361,8 +4244,8 @@ func NewCommoditiesListGetRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewDcfBulkGetRequest generates requests for DcfBulkGet
-func NewDcfBulkGetRequest(server string) (*http.Request, error) {
+// NewCompanyScreenerGetRequest generates requests for CompanyScreenerGet
+func NewCompanyScreenerGetRequest(server string, params *CompanyScreenerGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3370,7 +4253,7 @@ func NewDcfBulkGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/dcf-bulk")
+	operationPath := fmt.Sprintf("/company-screener")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3380,45 +4263,42 @@ func NewDcfBulkGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-// NewDelistedCompaniesRequest generates requests for DelistedCompanies
-func NewDelistedCompaniesRequest(server string, params *DelistedCompaniesParams) (*http.Request, error) {
-	var err error
+		if params.MarketCapMoreThan != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "marketCapMoreThan", *params.MarketCapMoreThan, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	operationPath := fmt.Sprintf("/delisted-companies")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		if params.MarketCapLowerThan != nil {
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "marketCapLowerThan", *params.MarketCapLowerThan, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-		if params.Page != nil {
+		}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "page", *params.Page, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+		if params.Sector != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sector", *params.Sector, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -3428,9 +4308,9 @@ func NewDelistedCompaniesRequest(server string, params *DelistedCompaniesParams)
 
 		}
 
-		if params.Limit != nil {
+		if params.Industry != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "industry", *params.Industry, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -3440,59 +4320,57 @@ func NewDelistedCompaniesRequest(server string, params *DelistedCompaniesParams)
 
 		}
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
+		if params.BetaMoreThan != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "betaMoreThan", *params.BetaMoreThan, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
 		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		if params.BetaLowerThan != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "betaLowerThan", *params.BetaLowerThan, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-// NewDividendsGetRequest generates requests for DividendsGet
-func NewDividendsGetRequest(server string, params *DividendsGetParams) (*http.Request, error) {
-	var err error
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.PriceMoreThan != nil {
 
-	operationPath := fmt.Sprintf("/dividends")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "priceMoreThan", *params.PriceMoreThan, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
+		if params.PriceLowerThan != nil {
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "priceLowerThan", *params.PriceLowerThan, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
 			}
+
 		}
 
-		if params.Limit != nil {
+		if params.DividendMoreThan != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "dividendMoreThan", *params.DividendMoreThan, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -3502,51 +4380,45 @@ func NewDividendsGetRequest(server string, params *DividendsGetParams) (*http.Re
 
 		}
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
+		if params.DividendLowerThan != nil {
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "dividendLowerThan", *params.DividendLowerThan, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	return req, nil
-}
+		}
 
-// NewDividendsCalendarGetRequest generates requests for DividendsCalendarGet
-func NewDividendsCalendarGetRequest(server string, params *DividendsCalendarGetParams) (*http.Request, error) {
-	var err error
+		if params.VolumeMoreThan != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "volumeMoreThan", *params.VolumeMoreThan, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	operationPath := fmt.Sprintf("/dividends-calendar")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		if params.VolumeLowerThan != nil {
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "volumeLowerThan", *params.VolumeLowerThan, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-		if params.From != nil {
+		}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+		if params.Exchange != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "exchange", *params.Exchange, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -3556,9 +4428,9 @@ func NewDividendsCalendarGetRequest(server string, params *DividendsCalendarGetP
 
 		}
 
-		if params.To != nil {
+		if params.Country != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "country", *params.Country, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -3568,54 +4440,52 @@ func NewDividendsCalendarGetRequest(server string, params *DividendsCalendarGetP
 
 		}
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
+		if params.IsEtf != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "isEtf", *params.IsEtf, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
 		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		if params.IsFund != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "isFund", *params.IsFund, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-// NewEarningsGetRequest generates requests for EarningsGet
-func NewEarningsGetRequest(server string, params *EarningsGetParams) (*http.Request, error) {
-	var err error
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.IsActivelyTrading != nil {
 
-	operationPath := fmt.Sprintf("/earnings")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "isActivelyTrading", *params.IsActivelyTrading, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
+		if params.IncludeAllShareClasses != nil {
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "includeAllShareClasses", *params.IncludeAllShareClasses, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
 			}
+
 		}
 
 		if params.Limit != nil {
@@ -3644,8 +4514,8 @@ func NewEarningsGetRequest(server string, params *EarningsGetParams) (*http.Requ
 	return req, nil
 }
 
-// NewGetEarningsCalendarRequest generates requests for GetEarningsCalendar
-func NewGetEarningsCalendarRequest(server string, params *GetEarningsCalendarParams) (*http.Request, error) {
+// NewCustomDiscountedCashFlowGetRequest generates requests for CustomDiscountedCashFlowGet
+func NewCustomDiscountedCashFlowGetRequest(server string, params *CustomDiscountedCashFlowGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3653,7 +4523,7 @@ func NewGetEarningsCalendarRequest(server string, params *GetEarningsCalendarPar
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/earnings-calendar")
+	operationPath := fmt.Sprintf("/custom-discounted-cash-flow")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3672,9 +4542,17 @@ func NewGetEarningsCalendarRequest(server string, params *GetEarningsCalendarPar
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.From != nil {
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+		if params.RevenueGrowthPct != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "revenueGrowthPct", *params.RevenueGrowthPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -3684,9 +4562,9 @@ func NewGetEarningsCalendarRequest(server string, params *GetEarningsCalendarPar
 
 		}
 
-		if params.To != nil {
+		if params.EbitdaPct != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "ebitdaPct", *params.EbitdaPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -3696,51 +4574,45 @@ func NewGetEarningsCalendarRequest(server string, params *GetEarningsCalendarPar
 
 		}
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
+		if params.DepreciationAndAmortizationPct != nil {
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "depreciationAndAmortizationPct", *params.DepreciationAndAmortizationPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	return req, nil
-}
+		}
 
-// NewEconomicCalendarGetRequest generates requests for EconomicCalendarGet
-func NewEconomicCalendarGetRequest(server string, params *EconomicCalendarGetParams) (*http.Request, error) {
-	var err error
+		if params.CashAndShortTermInvestmentsPct != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cashAndShortTermInvestmentsPct", *params.CashAndShortTermInvestmentsPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	operationPath := fmt.Sprintf("/economic-calendar")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		if params.ReceivablesPct != nil {
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "receivablesPct", *params.ReceivablesPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-		if params.From != nil {
+		}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+		if params.InventoriesPct != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "inventoriesPct", *params.InventoriesPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -3750,9 +4622,9 @@ func NewEconomicCalendarGetRequest(server string, params *EconomicCalendarGetPar
 
 		}
 
-		if params.To != nil {
+		if params.PayablePct != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "payablePct", *params.PayablePct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -3762,104 +4634,136 @@ func NewEconomicCalendarGetRequest(server string, params *EconomicCalendarGetPar
 
 		}
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
+		if params.EbitPct != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "ebitPct", *params.EbitPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
 		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		if params.CapitalExpenditurePct != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "capitalExpenditurePct", *params.CapitalExpenditurePct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-// NewEnterpriseValueGetRequest generates requests for EnterpriseValueGet
-func NewEnterpriseValueGetRequest(server string, params *EnterpriseValueGetParams) (*http.Request, error) {
-	var err error
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.OperatingCashFlowPct != nil {
 
-	operationPath := fmt.Sprintf("/enterprise-values")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "operatingCashFlowPct", *params.OperatingCashFlowPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
+		if params.SellingGeneralAndAdministrativeExpensesPct != nil {
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sellingGeneralAndAdministrativeExpensesPct", *params.SellingGeneralAndAdministrativeExpensesPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
 			}
+
 		}
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
+		if params.TaxRate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "taxRate", *params.TaxRate, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
 		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		if params.LongTermGrowthRate != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "longTermGrowthRate", *params.LongTermGrowthRate, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-// NewEsgDisclosuresGetRequest generates requests for EsgDisclosuresGet
-func NewEsgDisclosuresGetRequest(server string, params *EsgDisclosuresGetParams) (*http.Request, error) {
-	var err error
+		}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+		if params.CostOfDebt != nil {
 
-	operationPath := fmt.Sprintf("/esg-disclosures")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "costOfDebt", *params.CostOfDebt, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+		}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
+		if params.CostOfEquity != nil {
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "costOfEquity", *params.CostOfEquity, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.MarketRiskPremium != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "marketRiskPremium", *params.MarketRiskPremium, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Beta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "beta", *params.Beta, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.RiskFreeRate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "riskFreeRate", *params.RiskFreeRate, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
 			}
+
 		}
 
 		if encoded := queryValues.Encode(); encoded != "" {
@@ -3876,8 +4780,8 @@ func NewEsgDisclosuresGetRequest(server string, params *EsgDisclosuresGetParams)
 	return req, nil
 }
 
-// NewEsgRatingsGetRequest generates requests for EsgRatingsGet
-func NewEsgRatingsGetRequest(server string, params *EsgRatingsGetParams) (*http.Request, error) {
+// NewCustomLeveredDiscountedCashFlowGetRequest generates requests for CustomLeveredDiscountedCashFlowGet
+func NewCustomLeveredDiscountedCashFlowGetRequest(server string, params *CustomLeveredDiscountedCashFlowGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3885,7 +4789,7 @@ func NewEsgRatingsGetRequest(server string, params *EsgRatingsGetParams) (*http.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/esg-ratings")
+	operationPath := fmt.Sprintf("/custom-levered-discounted-cash-flow")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3912,37 +4816,226 @@ func NewEsgRatingsGetRequest(server string, params *EsgRatingsGetParams) (*http.
 			}
 		}
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
+		if params.RevenueGrowthPct != nil {
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "revenueGrowthPct", *params.RevenueGrowthPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	return req, nil
-}
+		}
 
-// NewETFListGetRequest generates requests for ETFListGet
-func NewETFListGetRequest(server string) (*http.Request, error) {
-	var err error
+		if params.EbitdaPct != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "ebitdaPct", *params.EbitdaPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	operationPath := fmt.Sprintf("/etf-list")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		if params.DepreciationAndAmortizationPct != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "depreciationAndAmortizationPct", *params.DepreciationAndAmortizationPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.CashAndShortTermInvestmentsPct != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cashAndShortTermInvestmentsPct", *params.CashAndShortTermInvestmentsPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.ReceivablesPct != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "receivablesPct", *params.ReceivablesPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.InventoriesPct != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "inventoriesPct", *params.InventoriesPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.PayablePct != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "payablePct", *params.PayablePct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.EbitPct != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "ebitPct", *params.EbitPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.CapitalExpenditurePct != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "capitalExpenditurePct", *params.CapitalExpenditurePct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.OperatingCashFlowPct != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "operatingCashFlowPct", *params.OperatingCashFlowPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.SellingGeneralAndAdministrativeExpensesPct != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sellingGeneralAndAdministrativeExpensesPct", *params.SellingGeneralAndAdministrativeExpensesPct, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.TaxRate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "taxRate", *params.TaxRate, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.LongTermGrowthRate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "longTermGrowthRate", *params.LongTermGrowthRate, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.CostOfDebt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "costOfDebt", *params.CostOfDebt, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.CostOfEquity != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "costOfEquity", *params.CostOfEquity, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.MarketRiskPremium != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "marketRiskPremium", *params.MarketRiskPremium, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Beta != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "beta", *params.Beta, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.RiskFreeRate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "riskFreeRate", *params.RiskFreeRate, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "number", Format: "double"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
 
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
@@ -3953,8 +5046,8 @@ func NewETFListGetRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewETFCountryWeightingsGetRequest generates requests for ETFCountryWeightingsGet
-func NewETFCountryWeightingsGetRequest(server string, params *ETFCountryWeightingsGetParams) (*http.Request, error) {
+// NewDcfBulkGetRequest generates requests for DcfBulkGet
+func NewDcfBulkGetRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3962,7 +5055,7 @@ func NewETFCountryWeightingsGetRequest(server string, params *ETFCountryWeightin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/etf/country-weightings")
+	operationPath := fmt.Sprintf("/dcf-bulk")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3972,29 +5065,6 @@ func NewETFCountryWeightingsGetRequest(server string, params *ETFCountryWeightin
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -4003,8 +5073,8 @@ func NewETFCountryWeightingsGetRequest(server string, params *ETFCountryWeightin
 	return req, nil
 }
 
-// NewETFHoldingsGetRequest generates requests for ETFHoldingsGet
-func NewETFHoldingsGetRequest(server string, params *ETFHoldingsGetParams) (*http.Request, error) {
+// NewDelistedCompaniesRequest generates requests for DelistedCompanies
+func NewDelistedCompaniesRequest(server string, params *DelistedCompaniesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4012,7 +5082,7 @@ func NewETFHoldingsGetRequest(server string, params *ETFHoldingsGetParams) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/etf/holdings")
+	operationPath := fmt.Sprintf("/delisted-companies")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4031,12 +5101,28 @@ func NewETFHoldingsGetRequest(server string, params *ETFHoldingsGetParams) (*htt
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "page", *params.Page, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
 			}
+
 		}
 
 		if encoded := queryValues.Encode(); encoded != "" {
@@ -4053,8 +5139,8 @@ func NewETFHoldingsGetRequest(server string, params *ETFHoldingsGetParams) (*htt
 	return req, nil
 }
 
-// NewETFInfoGetRequest generates requests for ETFInfoGet
-func NewETFInfoGetRequest(server string, params *ETFInfoGetParams) (*http.Request, error) {
+// NewDiscountedCashFlowGetRequest generates requests for DiscountedCashFlowGet
+func NewDiscountedCashFlowGetRequest(server string, params *DiscountedCashFlowGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4062,7 +5148,7 @@ func NewETFInfoGetRequest(server string, params *ETFInfoGetParams) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/etf/info")
+	operationPath := fmt.Sprintf("/discounted-cash-flow")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4103,8 +5189,8 @@ func NewETFInfoGetRequest(server string, params *ETFInfoGetParams) (*http.Reques
 	return req, nil
 }
 
-// NewETFSectorWeightingsGetRequest generates requests for ETFSectorWeightingsGet
-func NewETFSectorWeightingsGetRequest(server string, params *ETFSectorWeightingsGetParams) (*http.Request, error) {
+// NewDividendsGetRequest generates requests for DividendsGet
+func NewDividendsGetRequest(server string, params *DividendsGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4112,7 +5198,7 @@ func NewETFSectorWeightingsGetRequest(server string, params *ETFSectorWeightings
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/etf/sector-weightings")
+	operationPath := fmt.Sprintf("/dividends")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4139,6 +5225,18 @@ func NewETFSectorWeightingsGetRequest(server string, params *ETFSectorWeightings
 			}
 		}
 
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -4153,8 +5251,8 @@ func NewETFSectorWeightingsGetRequest(server string, params *ETFSectorWeightings
 	return req, nil
 }
 
-// NewForexCurrencyPairsGetRequest generates requests for ForexCurrencyPairsGet
-func NewForexCurrencyPairsGetRequest(server string) (*http.Request, error) {
+// NewDividendsCalendarGetRequest generates requests for DividendsCalendarGet
+func NewDividendsCalendarGetRequest(server string, params *DividendsCalendarGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4162,34 +5260,7 @@ func NewForexCurrencyPairsGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/forex-list")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewGradesLatestNewsGetRequest generates requests for GradesLatestNewsGet
-func NewGradesLatestNewsGetRequest(server string, params *GradesLatestNewsGetParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/grades-latest-news")
+	operationPath := fmt.Sprintf("/dividends-calendar")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4208,9 +5279,9 @@ func NewGradesLatestNewsGetRequest(server string, params *GradesLatestNewsGetPar
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Page != nil {
+		if params.From != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "page", *params.Page, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -4220,9 +5291,9 @@ func NewGradesLatestNewsGetRequest(server string, params *GradesLatestNewsGetPar
 
 		}
 
-		if params.Limit != nil {
+		if params.To != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -4246,8 +5317,8 @@ func NewGradesLatestNewsGetRequest(server string, params *GradesLatestNewsGetPar
 	return req, nil
 }
 
-// NewHistoricalChart15MinGetRequest generates requests for HistoricalChart15MinGet
-func NewHistoricalChart15MinGetRequest(server string, params *HistoricalChart15MinGetParams) (*http.Request, error) {
+// NewDowJonesConstituentGetRequest generates requests for DowJonesConstituentGet
+func NewDowJonesConstituentGetRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4255,7 +5326,7 @@ func NewHistoricalChart15MinGetRequest(server string, params *HistoricalChart15M
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/historical-chart/15min")
+	operationPath := fmt.Sprintf("/dowjones-constituent")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4265,65 +5336,6 @@ func NewHistoricalChart15MinGetRequest(server string, params *HistoricalChart15M
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
-		}
-
-		if params.From != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.To != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Nonadjusted != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "nonadjusted", *params.Nonadjusted, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -4332,8 +5344,8 @@ func NewHistoricalChart15MinGetRequest(server string, params *HistoricalChart15M
 	return req, nil
 }
 
-// NewHistoricalPriceEodFullGetRequest generates requests for HistoricalPriceEodFullGet
-func NewHistoricalPriceEodFullGetRequest(server string, params *HistoricalPriceEodFullGetParams) (*http.Request, error) {
+// NewEarningsGetRequest generates requests for EarningsGet
+func NewEarningsGetRequest(server string, params *EarningsGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4341,7 +5353,7 @@ func NewHistoricalPriceEodFullGetRequest(server string, params *HistoricalPriceE
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/historical-price-eod/full")
+	operationPath := fmt.Sprintf("/earnings")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4368,21 +5380,9 @@ func NewHistoricalPriceEodFullGetRequest(server string, params *HistoricalPriceE
 			}
 		}
 
-		if params.From != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.To != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -4406,8 +5406,8 @@ func NewHistoricalPriceEodFullGetRequest(server string, params *HistoricalPriceE
 	return req, nil
 }
 
-// NewHistoricalPriceEodLightGetRequest generates requests for HistoricalPriceEodLightGet
-func NewHistoricalPriceEodLightGetRequest(server string, params *HistoricalPriceEodLightGetParams) (*http.Request, error) {
+// NewGetEarningsCalendarRequest generates requests for GetEarningsCalendar
+func NewGetEarningsCalendarRequest(server string, params *GetEarningsCalendarParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4415,7 +5415,7 @@ func NewHistoricalPriceEodLightGetRequest(server string, params *HistoricalPrice
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/historical-price-eod/light")
+	operationPath := fmt.Sprintf("/earnings-calendar")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4434,14 +5434,6 @@ func NewHistoricalPriceEodLightGetRequest(server string, params *HistoricalPrice
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
-		}
-
 		if params.From != nil {
 
 			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
@@ -4480,8 +5472,8 @@ func NewHistoricalPriceEodLightGetRequest(server string, params *HistoricalPrice
 	return req, nil
 }
 
-// NewIncomeStatementGetRequest generates requests for IncomeStatementGet
-func NewIncomeStatementGetRequest(server string, params *IncomeStatementGetParams) (*http.Request, error) {
+// NewEconomicCalendarGetRequest generates requests for EconomicCalendarGet
+func NewEconomicCalendarGetRequest(server string, params *EconomicCalendarGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4489,7 +5481,7 @@ func NewIncomeStatementGetRequest(server string, params *IncomeStatementGetParam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/income-statement")
+	operationPath := fmt.Sprintf("/economic-calendar")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4508,17 +5500,9 @@ func NewIncomeStatementGetRequest(server string, params *IncomeStatementGetParam
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
-		}
-
-		if params.Limit != nil {
+		if params.From != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -4528,9 +5512,9 @@ func NewIncomeStatementGetRequest(server string, params *IncomeStatementGetParam
 
 		}
 
-		if params.Period != nil {
+		if params.To != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "period", *params.Period, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -4554,8 +5538,8 @@ func NewIncomeStatementGetRequest(server string, params *IncomeStatementGetParam
 	return req, nil
 }
 
-// NewIncomeStatementBulkGetRequest generates requests for IncomeStatementBulkGet
-func NewIncomeStatementBulkGetRequest(server string, params *IncomeStatementBulkGetParams) (*http.Request, error) {
+// NewEnterpriseValueGetRequest generates requests for EnterpriseValueGet
+func NewEnterpriseValueGetRequest(server string, params *EnterpriseValueGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4563,7 +5547,7 @@ func NewIncomeStatementBulkGetRequest(server string, params *IncomeStatementBulk
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/income-statement-bulk")
+	operationPath := fmt.Sprintf("/enterprise-values")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4582,15 +5566,7 @@ func NewIncomeStatementBulkGetRequest(server string, params *IncomeStatementBulk
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "period", params.Period, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
-		}
-
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "year", params.Year, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 			return nil, err
 		} else {
 			for _, qp := range strings.Split(queryFrag, "&") {
@@ -4612,8 +5588,8 @@ func NewIncomeStatementBulkGetRequest(server string, params *IncomeStatementBulk
 	return req, nil
 }
 
-// NewIncomeStatementTTMGetRequest generates requests for IncomeStatementTTMGet
-func NewIncomeStatementTTMGetRequest(server string, params *IncomeStatementTTMGetParams) (*http.Request, error) {
+// NewEsgDisclosuresGetRequest generates requests for EsgDisclosuresGet
+func NewEsgDisclosuresGetRequest(server string, params *EsgDisclosuresGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4621,7 +5597,7 @@ func NewIncomeStatementTTMGetRequest(server string, params *IncomeStatementTTMGe
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/income-statement-ttm")
+	operationPath := fmt.Sprintf("/esg-disclosures")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4648,18 +5624,6 @@ func NewIncomeStatementTTMGetRequest(server string, params *IncomeStatementTTMGe
 			}
 		}
 
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -4674,8 +5638,8 @@ func NewIncomeStatementTTMGetRequest(server string, params *IncomeStatementTTMGe
 	return req, nil
 }
 
-// NewIndexConstituentListGetRequest generates requests for IndexConstituentListGet
-func NewIndexConstituentListGetRequest(server string, params *IndexConstituentListGetParams) (*http.Request, error) {
+// NewEsgRatingsGetRequest generates requests for EsgRatingsGet
+func NewEsgRatingsGetRequest(server string, params *EsgRatingsGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4683,7 +5647,7 @@ func NewIndexConstituentListGetRequest(server string, params *IndexConstituentLi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/index-constituent-list")
+	operationPath := fmt.Sprintf("/esg-ratings")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4702,7 +5666,7 @@ func NewIndexConstituentListGetRequest(server string, params *IndexConstituentLi
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "type", params.Type, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 			return nil, err
 		} else {
 			for _, qp := range strings.Split(queryFrag, "&") {
@@ -4724,8 +5688,8 @@ func NewIndexConstituentListGetRequest(server string, params *IndexConstituentLi
 	return req, nil
 }
 
-// NewIndexListGetRequest generates requests for IndexListGet
-func NewIndexListGetRequest(server string) (*http.Request, error) {
+// NewETFListGetRequest generates requests for ETFListGet
+func NewETFListGetRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4733,7 +5697,7 @@ func NewIndexListGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/index-list")
+	operationPath := fmt.Sprintf("/etf-list")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4751,8 +5715,8 @@ func NewIndexListGetRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewInsiderTradingLatestGetRequest generates requests for InsiderTradingLatestGet
-func NewInsiderTradingLatestGetRequest(server string, params *InsiderTradingLatestGetParams) (*http.Request, error) {
+// NewETFCountryWeightingsGetRequest generates requests for ETFCountryWeightingsGet
+func NewETFCountryWeightingsGetRequest(server string, params *ETFCountryWeightingsGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4760,7 +5724,7 @@ func NewInsiderTradingLatestGetRequest(server string, params *InsiderTradingLate
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/insider-trading/latest")
+	operationPath := fmt.Sprintf("/etf/country-weightings")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4779,40 +5743,12 @@ func NewInsiderTradingLatestGetRequest(server string, params *InsiderTradingLate
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.Date != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "date", *params.Date, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Page != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "page", *params.Page, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
 			}
-
 		}
 
 		if encoded := queryValues.Encode(); encoded != "" {
@@ -4829,8 +5765,8 @@ func NewInsiderTradingLatestGetRequest(server string, params *InsiderTradingLate
 	return req, nil
 }
 
-// NewInsiderTradingSearchGetRequest generates requests for InsiderTradingSearchGet
-func NewInsiderTradingSearchGetRequest(server string, params *InsiderTradingSearchGetParams) (*http.Request, error) {
+// NewETFHoldingsGetRequest generates requests for ETFHoldingsGet
+func NewETFHoldingsGetRequest(server string, params *ETFHoldingsGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4838,7 +5774,7 @@ func NewInsiderTradingSearchGetRequest(server string, params *InsiderTradingSear
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/insider-trading/search")
+	operationPath := fmt.Sprintf("/etf/holdings")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4865,52 +5801,54 @@ func NewInsiderTradingSearchGetRequest(server string, params *InsiderTradingSear
 			}
 		}
 
-		if params.Page != nil {
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "page", *params.Page, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewETFInfoGetRequest generates requests for ETFInfoGet
+func NewETFInfoGetRequest(server string, params *ETFInfoGetParams) (*http.Request, error) {
+	var err error
 
-		if params.ReportingCik != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "reportingCik", *params.ReportingCik, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	operationPath := fmt.Sprintf("/etf/info")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.TransactionType != nil {
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "transactionType", *params.TransactionType, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
 			}
-
 		}
 
 		if encoded := queryValues.Encode(); encoded != "" {
@@ -4927,8 +5865,8 @@ func NewInsiderTradingSearchGetRequest(server string, params *InsiderTradingSear
 	return req, nil
 }
 
-// NewKeyMetricsGetRequest generates requests for KeyMetricsGet
-func NewKeyMetricsGetRequest(server string, params *KeyMetricsGetParams) (*http.Request, error) {
+// NewETFSectorWeightingsGetRequest generates requests for ETFSectorWeightingsGet
+func NewETFSectorWeightingsGetRequest(server string, params *ETFSectorWeightingsGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4936,7 +5874,7 @@ func NewKeyMetricsGetRequest(server string, params *KeyMetricsGetParams) (*http.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/key-metrics")
+	operationPath := fmt.Sprintf("/etf/sector-weightings")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4963,28 +5901,54 @@ func NewKeyMetricsGetRequest(server string, params *KeyMetricsGetParams) (*http.
 			}
 		}
 
-		if params.Limit != nil {
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.Period != nil {
+// NewExchangeMarketHoursGetRequest generates requests for ExchangeMarketHoursGet
+func NewExchangeMarketHoursGetRequest(server string, params *ExchangeMarketHoursGetParams) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "period", *params.Period, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/exchange-market-hours")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "exchange", params.Exchange, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
 		}
 
 		if encoded := queryValues.Encode(); encoded != "" {
@@ -5001,8 +5965,8 @@ func NewKeyMetricsGetRequest(server string, params *KeyMetricsGetParams) (*http.
 	return req, nil
 }
 
-// NewKeyMetricsTTMGetRequest generates requests for KeyMetricsTTMGet
-func NewKeyMetricsTTMGetRequest(server string, params *KeyMetricsTTMGetParams) (*http.Request, error) {
+// NewFinancialScoresGetRequest generates requests for FinancialScoresGet
+func NewFinancialScoresGetRequest(server string, params *FinancialScoresGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5010,7 +5974,7 @@ func NewKeyMetricsTTMGetRequest(server string, params *KeyMetricsTTMGetParams) (
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/key-metrics-ttm")
+	operationPath := fmt.Sprintf("/financial-scores")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5051,8 +6015,8 @@ func NewKeyMetricsTTMGetRequest(server string, params *KeyMetricsTTMGetParams) (
 	return req, nil
 }
 
-// NewKeyMetricsTTMBulkGetRequest generates requests for KeyMetricsTTMBulkGet
-func NewKeyMetricsTTMBulkGetRequest(server string) (*http.Request, error) {
+// NewForexCurrencyPairsGetRequest generates requests for ForexCurrencyPairsGet
+func NewForexCurrencyPairsGetRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5060,7 +6024,7 @@ func NewKeyMetricsTTMBulkGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/key-metrics-ttm-bulk")
+	operationPath := fmt.Sprintf("/forex-list")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5078,8 +6042,8 @@ func NewKeyMetricsTTMBulkGetRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewMarketCapitalizationGetRequest generates requests for MarketCapitalizationGet
-func NewMarketCapitalizationGetRequest(server string, params *MarketCapitalizationGetParams) (*http.Request, error) {
+// NewGradesGetRequest generates requests for GradesGet
+func NewGradesGetRequest(server string, params *GradesGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5087,7 +6051,7 @@ func NewMarketCapitalizationGetRequest(server string, params *MarketCapitalizati
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/market-capitalization")
+	operationPath := fmt.Sprintf("/grades")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5128,8 +6092,8 @@ func NewMarketCapitalizationGetRequest(server string, params *MarketCapitalizati
 	return req, nil
 }
 
-// NewMarketCapitalizationBatchGetRequest generates requests for MarketCapitalizationBatchGet
-func NewMarketCapitalizationBatchGetRequest(server string, params *MarketCapitalizationBatchGetParams) (*http.Request, error) {
+// NewGradesHistoricalGetRequest generates requests for GradesHistoricalGet
+func NewGradesHistoricalGetRequest(server string, params *GradesHistoricalGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5137,7 +6101,7 @@ func NewMarketCapitalizationBatchGetRequest(server string, params *MarketCapital
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/market-capitalization-batch")
+	operationPath := fmt.Sprintf("/grades-historical")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5156,7 +6120,7 @@ func NewMarketCapitalizationBatchGetRequest(server string, params *MarketCapital
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbols", params.Symbols, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 			return nil, err
 		} else {
 			for _, qp := range strings.Split(queryFrag, "&") {
@@ -5164,6 +6128,18 @@ func NewMarketCapitalizationBatchGetRequest(server string, params *MarketCapital
 			}
 		}
 
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -5178,8 +6154,8 @@ func NewMarketCapitalizationBatchGetRequest(server string, params *MarketCapital
 	return req, nil
 }
 
-// NewNewsGeneralLatestGetRequest generates requests for NewsGeneralLatestGet
-func NewNewsGeneralLatestGetRequest(server string, params *NewsGeneralLatestGetParams) (*http.Request, error) {
+// NewGradesLatestNewsGetRequest generates requests for GradesLatestNewsGet
+func NewGradesLatestNewsGetRequest(server string, params *GradesLatestNewsGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5187,7 +6163,7 @@ func NewNewsGeneralLatestGetRequest(server string, params *NewsGeneralLatestGetP
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/news/general-latest")
+	operationPath := fmt.Sprintf("/grades-latest-news")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5206,30 +6182,6 @@ func NewNewsGeneralLatestGetRequest(server string, params *NewsGeneralLatestGetP
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.From != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.To != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
 		if params.Page != nil {
 
 			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "page", *params.Page, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
@@ -5268,8 +6220,8 @@ func NewNewsGeneralLatestGetRequest(server string, params *NewsGeneralLatestGetP
 	return req, nil
 }
 
-// NewNewsStockLatestGetRequest generates requests for NewsStockLatestGet
-func NewNewsStockLatestGetRequest(server string, params *NewsStockLatestGetParams) (*http.Request, error) {
+// NewHistoricalChart15MinGetRequest generates requests for HistoricalChart15MinGet
+func NewHistoricalChart15MinGetRequest(server string, params *HistoricalChart15MinGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5277,7 +6229,7 @@ func NewNewsStockLatestGetRequest(server string, params *NewsStockLatestGetParam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/news/stock-latest")
+	operationPath := fmt.Sprintf("/historical-chart/15min")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5296,6 +6248,14 @@ func NewNewsStockLatestGetRequest(server string, params *NewsStockLatestGetParam
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
 		if params.From != nil {
 
 			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
@@ -5320,21 +6280,9 @@ func NewNewsStockLatestGetRequest(server string, params *NewsStockLatestGetParam
 
 		}
 
-		if params.Page != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "page", *params.Page, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
+		if params.Nonadjusted != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "nonadjusted", *params.Nonadjusted, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -5358,8 +6306,8 @@ func NewNewsStockLatestGetRequest(server string, params *NewsStockLatestGetParam
 	return req, nil
 }
 
-// NewProfileGetRequest generates requests for ProfileGet
-func NewProfileGetRequest(server string, params *ProfileGetParams) (*http.Request, error) {
+// NewHistoricalDowJonesConstituentGetRequest generates requests for HistoricalDowJonesConstituentGet
+func NewHistoricalDowJonesConstituentGetRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5367,7 +6315,7 @@ func NewProfileGetRequest(server string, params *ProfileGetParams) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/profile")
+	operationPath := fmt.Sprintf("/historical-dowjones-constituent")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5377,29 +6325,6 @@ func NewProfileGetRequest(server string, params *ProfileGetParams) (*http.Reques
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -5408,8 +6333,8 @@ func NewProfileGetRequest(server string, params *ProfileGetParams) (*http.Reques
 	return req, nil
 }
 
-// NewProfileBulkGetRequest generates requests for ProfileBulkGet
-func NewProfileBulkGetRequest(server string, params *ProfileBulkGetParams) (*http.Request, error) {
+// NewHistoricalNasdaqConstituentGetRequest generates requests for HistoricalNasdaqConstituentGet
+func NewHistoricalNasdaqConstituentGetRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5417,7 +6342,7 @@ func NewProfileBulkGetRequest(server string, params *ProfileBulkGetParams) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/profile-bulk")
+	operationPath := fmt.Sprintf("/historical-nasdaq-constituent")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5427,29 +6352,6 @@ func NewProfileBulkGetRequest(server string, params *ProfileBulkGetParams) (*htt
 		return nil, err
 	}
 
-	if params != nil {
-		// queryValues collects non-styled parameters (passthrough, JSON)
-		// that are safe to round-trip through url.Values.Encode().
-		queryValues := queryURL.Query()
-		// rawQueryFragments collects pre-encoded query fragments from
-		// styled parameters, preserving literal commas as delimiters
-		// per the OpenAPI spec (e.g. "color=blue,black,brown").
-		var rawQueryFragments []string
-
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "part", params.Part, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
-		}
-
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
-
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -5458,8 +6360,8 @@ func NewProfileBulkGetRequest(server string, params *ProfileBulkGetParams) (*htt
 	return req, nil
 }
 
-// NewQuoteGetRequest generates requests for QuoteGet
-func NewQuoteGetRequest(server string, params *QuoteGetParams) (*http.Request, error) {
+// NewHistoricalPriceEodFullGetRequest generates requests for HistoricalPriceEodFullGet
+func NewHistoricalPriceEodFullGetRequest(server string, params *HistoricalPriceEodFullGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5467,7 +6369,7 @@ func NewQuoteGetRequest(server string, params *QuoteGetParams) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/quote")
+	operationPath := fmt.Sprintf("/historical-price-eod/full")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5494,6 +6396,30 @@ func NewQuoteGetRequest(server string, params *QuoteGetParams) (*http.Request, e
 			}
 		}
 
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -5508,8 +6434,8 @@ func NewQuoteGetRequest(server string, params *QuoteGetParams) (*http.Request, e
 	return req, nil
 }
 
-// NewQuoteShortGetRequest generates requests for QuoteShortGet
-func NewQuoteShortGetRequest(server string, params *QuoteShortGetParams) (*http.Request, error) {
+// NewHistoricalPriceEodLightGetRequest generates requests for HistoricalPriceEodLightGet
+func NewHistoricalPriceEodLightGetRequest(server string, params *HistoricalPriceEodLightGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5517,7 +6443,7 @@ func NewQuoteShortGetRequest(server string, params *QuoteShortGetParams) (*http.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/quote-short")
+	operationPath := fmt.Sprintf("/historical-price-eod/light")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5544,6 +6470,30 @@ func NewQuoteShortGetRequest(server string, params *QuoteShortGetParams) (*http.
 			}
 		}
 
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -5558,8 +6508,8 @@ func NewQuoteShortGetRequest(server string, params *QuoteShortGetParams) (*http.
 	return req, nil
 }
 
-// NewRatingBulkGetRequest generates requests for RatingBulkGet
-func NewRatingBulkGetRequest(server string) (*http.Request, error) {
+// NewHistoricalSP500ConstituentGetRequest generates requests for HistoricalSP500ConstituentGet
+func NewHistoricalSP500ConstituentGetRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5567,7 +6517,7 @@ func NewRatingBulkGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/rating-bulk")
+	operationPath := fmt.Sprintf("/historical-sp500-constituent")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5585,8 +6535,8 @@ func NewRatingBulkGetRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewRatingsSnapshotGetRequest generates requests for RatingsSnapshotGet
-func NewRatingsSnapshotGetRequest(server string, params *RatingsSnapshotGetParams) (*http.Request, error) {
+// NewHolidaysByExchangeGetRequest generates requests for HolidaysByExchangeGet
+func NewHolidaysByExchangeGetRequest(server string, params *HolidaysByExchangeGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5594,7 +6544,7 @@ func NewRatingsSnapshotGetRequest(server string, params *RatingsSnapshotGetParam
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/ratings-snapshot")
+	operationPath := fmt.Sprintf("/holidays-by-exchange")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5613,7 +6563,7 @@ func NewRatingsSnapshotGetRequest(server string, params *RatingsSnapshotGetParam
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "exchange", params.Exchange, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 			return nil, err
 		} else {
 			for _, qp := range strings.Split(queryFrag, "&") {
@@ -5621,9 +6571,21 @@ func NewRatingsSnapshotGetRequest(server string, params *RatingsSnapshotGetParam
 			}
 		}
 
-		if params.Limit != nil {
+		if params.From != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -5647,8 +6609,8 @@ func NewRatingsSnapshotGetRequest(server string, params *RatingsSnapshotGetParam
 	return req, nil
 }
 
-// NewRatiosGetRequest generates requests for RatiosGet
-func NewRatiosGetRequest(server string, params *RatiosGetParams) (*http.Request, error) {
+// NewIncomeStatementGetRequest generates requests for IncomeStatementGet
+func NewIncomeStatementGetRequest(server string, params *IncomeStatementGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5656,7 +6618,7 @@ func NewRatiosGetRequest(server string, params *RatiosGetParams) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/ratios")
+	operationPath := fmt.Sprintf("/income-statement")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5721,8 +6683,8 @@ func NewRatiosGetRequest(server string, params *RatiosGetParams) (*http.Request,
 	return req, nil
 }
 
-// NewRatiosTTMGetRequest generates requests for RatiosTTMGet
-func NewRatiosTTMGetRequest(server string, params *RatiosTTMGetParams) (*http.Request, error) {
+// NewIncomeStatementBulkGetRequest generates requests for IncomeStatementBulkGet
+func NewIncomeStatementBulkGetRequest(server string, params *IncomeStatementBulkGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5730,7 +6692,7 @@ func NewRatiosTTMGetRequest(server string, params *RatiosTTMGetParams) (*http.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/ratios-ttm")
+	operationPath := fmt.Sprintf("/income-statement-bulk")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5749,7 +6711,15 @@ func NewRatiosTTMGetRequest(server string, params *RatiosTTMGetParams) (*http.Re
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "period", params.Period, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "year", params.Year, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
 			return nil, err
 		} else {
 			for _, qp := range strings.Split(queryFrag, "&") {
@@ -5771,35 +6741,8 @@ func NewRatiosTTMGetRequest(server string, params *RatiosTTMGetParams) (*http.Re
 	return req, nil
 }
 
-// NewRatiosTTMBulkGetRequest generates requests for RatiosTTMBulkGet
-func NewRatiosTTMBulkGetRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/ratios-ttm-bulk")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewRevenueGeographicSegmentationGetRequest generates requests for RevenueGeographicSegmentationGet
-func NewRevenueGeographicSegmentationGetRequest(server string, params *RevenueGeographicSegmentationGetParams) (*http.Request, error) {
+// NewIncomeStatementTTMGetRequest generates requests for IncomeStatementTTMGet
+func NewIncomeStatementTTMGetRequest(server string, params *IncomeStatementTTMGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5807,7 +6750,7 @@ func NewRevenueGeographicSegmentationGetRequest(server string, params *RevenueGe
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/revenue-geographic-segmentation")
+	operationPath := fmt.Sprintf("/income-statement-ttm")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5834,21 +6777,9 @@ func NewRevenueGeographicSegmentationGetRequest(server string, params *RevenueGe
 			}
 		}
 
-		if params.Period != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "period", *params.Period, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.Structure != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "structure", *params.Structure, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -5872,8 +6803,8 @@ func NewRevenueGeographicSegmentationGetRequest(server string, params *RevenueGe
 	return req, nil
 }
 
-// NewRevenueProductSegmentationGetRequest generates requests for RevenueProductSegmentationGet
-func NewRevenueProductSegmentationGetRequest(server string, params *RevenueProductSegmentationGetParams) (*http.Request, error) {
+// NewIndexConstituentListGetRequest generates requests for IndexConstituentListGet
+func NewIndexConstituentListGetRequest(server string, params *IndexConstituentListGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5881,7 +6812,7 @@ func NewRevenueProductSegmentationGetRequest(server string, params *RevenueProdu
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/revenue-product-segmentation")
+	operationPath := fmt.Sprintf("/index-constituent-list")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5900,7 +6831,7 @@ func NewRevenueProductSegmentationGetRequest(server string, params *RevenueProdu
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "type", params.Type, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 			return nil, err
 		} else {
 			for _, qp := range strings.Split(queryFrag, "&") {
@@ -5908,34 +6839,37 @@ func NewRevenueProductSegmentationGetRequest(server string, params *RevenueProdu
 			}
 		}
 
-		if params.Period != nil {
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "period", *params.Period, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.Structure != nil {
+// NewIndexListGetRequest generates requests for IndexListGet
+func NewIndexListGetRequest(server string) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "structure", *params.Structure, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/index-list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
@@ -5946,8 +6880,8 @@ func NewRevenueProductSegmentationGetRequest(server string, params *RevenueProdu
 	return req, nil
 }
 
-// NewSearchNameGetRequest generates requests for SearchNameGet
-func NewSearchNameGetRequest(server string, params *SearchNameGetParams) (*http.Request, error) {
+// NewInsiderTradingLatestGetRequest generates requests for InsiderTradingLatestGet
+func NewInsiderTradingLatestGetRequest(server string, params *InsiderTradingLatestGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -5955,7 +6889,7 @@ func NewSearchNameGetRequest(server string, params *SearchNameGetParams) (*http.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/search-name")
+	operationPath := fmt.Sprintf("/insider-trading/latest")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5974,17 +6908,21 @@ func NewSearchNameGetRequest(server string, params *SearchNameGetParams) (*http.
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search-symbol", params.SearchSymbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
+		if params.Date != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "date", *params.Date, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
 			}
+
 		}
 
-		if params.Limit != nil {
+		if params.Page != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "page", *params.Page, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -5994,9 +6932,9 @@ func NewSearchNameGetRequest(server string, params *SearchNameGetParams) (*http.
 
 		}
 
-		if params.Exchange != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "exchange", *params.Exchange, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -6020,16 +6958,16 @@ func NewSearchNameGetRequest(server string, params *SearchNameGetParams) (*http.
 	return req, nil
 }
 
-// NewSearchSymbolGetRequest generates requests for SearchSymbolGet
-func NewSearchSymbolGetRequest(server string, params *SearchSymbolGetParams) (*http.Request, error) {
-	var err error
+// NewInsiderTradingSearchGetRequest generates requests for InsiderTradingSearchGet
+func NewInsiderTradingSearchGetRequest(server string, params *InsiderTradingSearchGetParams) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/search-symbol")
+	operationPath := fmt.Sprintf("/insider-trading/search")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6048,7 +6986,7 @@ func NewSearchSymbolGetRequest(server string, params *SearchSymbolGetParams) (*h
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "query", params.Query, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 			return nil, err
 		} else {
 			for _, qp := range strings.Split(queryFrag, "&") {
@@ -6056,6 +6994,18 @@ func NewSearchSymbolGetRequest(server string, params *SearchSymbolGetParams) (*h
 			}
 		}
 
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "page", *params.Page, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
 		if params.Limit != nil {
 
 			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
@@ -6068,9 +7018,21 @@ func NewSearchSymbolGetRequest(server string, params *SearchSymbolGetParams) (*h
 
 		}
 
-		if params.Exchange != nil {
+		if params.ReportingCik != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "exchange", *params.Exchange, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "reportingCik", *params.ReportingCik, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.TransactionType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "transactionType", *params.TransactionType, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 				return nil, err
 			} else {
 				for _, qp := range strings.Split(queryFrag, "&") {
@@ -6094,8 +7056,8 @@ func NewSearchSymbolGetRequest(server string, params *SearchSymbolGetParams) (*h
 	return req, nil
 }
 
-// NewSharesFloatGetRequest generates requests for SharesFloatGet
-func NewSharesFloatGetRequest(server string, params *SharesFloatGetParams) (*http.Request, error) {
+// NewKeyMetricsGetRequest generates requests for KeyMetricsGet
+func NewKeyMetricsGetRequest(server string, params *KeyMetricsGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -6103,7 +7065,7 @@ func NewSharesFloatGetRequest(server string, params *SharesFloatGetParams) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/shares-float")
+	operationPath := fmt.Sprintf("/key-metrics")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6130,6 +7092,30 @@ func NewSharesFloatGetRequest(server string, params *SharesFloatGetParams) (*htt
 			}
 		}
 
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "period", *params.Period, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -6144,8 +7130,8 @@ func NewSharesFloatGetRequest(server string, params *SharesFloatGetParams) (*htt
 	return req, nil
 }
 
-// NewGetSplitsRequest generates requests for GetSplits
-func NewGetSplitsRequest(server string, params *GetSplitsParams) (*http.Request, error) {
+// NewKeyMetricsTTMGetRequest generates requests for KeyMetricsTTMGet
+func NewKeyMetricsTTMGetRequest(server string, params *KeyMetricsTTMGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -6153,7 +7139,7 @@ func NewGetSplitsRequest(server string, params *GetSplitsParams) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/splits")
+	operationPath := fmt.Sprintf("/key-metrics-ttm")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6180,18 +7166,6 @@ func NewGetSplitsRequest(server string, params *GetSplitsParams) (*http.Request,
 			}
 		}
 
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
 		if encoded := queryValues.Encode(); encoded != "" {
 			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
@@ -6206,8 +7180,8 @@ func NewGetSplitsRequest(server string, params *GetSplitsParams) (*http.Request,
 	return req, nil
 }
 
-// NewGetSplitsCalendarRequest generates requests for GetSplitsCalendar
-func NewGetSplitsCalendarRequest(server string, params *GetSplitsCalendarParams) (*http.Request, error) {
+// NewKeyMetricsTTMBulkGetRequest generates requests for KeyMetricsTTMBulkGet
+func NewKeyMetricsTTMBulkGetRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -6215,7 +7189,34 @@ func NewGetSplitsCalendarRequest(server string, params *GetSplitsCalendarParams)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/splits-calendar")
+	operationPath := fmt.Sprintf("/key-metrics-ttm-bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLeveredDiscountedCashFlowGetRequest generates requests for LeveredDiscountedCashFlowGet
+func NewLeveredDiscountedCashFlowGetRequest(server string, params *LeveredDiscountedCashFlowGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/levered-discounted-cash-flow")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6234,28 +7235,12 @@ func NewGetSplitsCalendarRequest(server string, params *GetSplitsCalendarParams)
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if params.From != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
-
-		}
-
-		if params.To != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
 			}
-
 		}
 
 		if encoded := queryValues.Encode(); encoded != "" {
@@ -6272,8 +7257,8 @@ func NewGetSplitsCalendarRequest(server string, params *GetSplitsCalendarParams)
 	return req, nil
 }
 
-// NewStockListGetRequest generates requests for StockListGet
-func NewStockListGetRequest(server string) (*http.Request, error) {
+// NewMarketCapitalizationGetRequest generates requests for MarketCapitalizationGet
+func NewMarketCapitalizationGetRequest(server string, params *MarketCapitalizationGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -6281,7 +7266,7 @@ func NewStockListGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/stock-list")
+	operationPath := fmt.Sprintf("/market-capitalization")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6291,6 +7276,29 @@ func NewStockListGetRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -6299,8 +7307,8 @@ func NewStockListGetRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewTechnicalIndicatorsRsiGetRequest generates requests for TechnicalIndicatorsRsiGet
-func NewTechnicalIndicatorsRsiGetRequest(server string, params *TechnicalIndicatorsRsiGetParams) (*http.Request, error) {
+// NewMarketCapitalizationBatchGetRequest generates requests for MarketCapitalizationBatchGet
+func NewMarketCapitalizationBatchGetRequest(server string, params *MarketCapitalizationBatchGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -6308,7 +7316,7 @@ func NewTechnicalIndicatorsRsiGetRequest(server string, params *TechnicalIndicat
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/technical-indicators/rsi")
+	operationPath := fmt.Sprintf("/market-capitalization-batch")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6327,15 +7335,7 @@ func NewTechnicalIndicatorsRsiGetRequest(server string, params *TechnicalIndicat
 		// per the OpenAPI spec (e.g. "color=blue,black,brown").
 		var rawQueryFragments []string
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
-		}
-
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "periodLength", params.PeriodLength, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbols", params.Symbols, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
 			return nil, err
 		} else {
 			for _, qp := range strings.Split(queryFrag, "&") {
@@ -6343,42 +7343,37 @@ func NewTechnicalIndicatorsRsiGetRequest(server string, params *TechnicalIndicat
 			}
 		}
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "timeframe", params.Timeframe, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else {
-			for _, qp := range strings.Split(queryFrag, "&") {
-				rawQueryFragments = append(rawQueryFragments, qp)
-			}
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-		if params.From != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	return req, nil
+}
 
-		if params.To != nil {
+// NewNasdaqConstituentGetRequest generates requests for NasdaqConstituentGet
+func NewNasdaqConstituentGetRequest(server string) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
-				return nil, err
-			} else {
-				for _, qp := range strings.Split(queryFrag, "&") {
-					rawQueryFragments = append(rawQueryFragments, qp)
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/nasdaq-constituent")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
-		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
 	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
@@ -6389,8 +7384,8 @@ func NewTechnicalIndicatorsRsiGetRequest(server string, params *TechnicalIndicat
 	return req, nil
 }
 
-// NewTreasuryRatesGetRequest generates requests for TreasuryRatesGet
-func NewTreasuryRatesGetRequest(server string, params *TreasuryRatesGetParams) (*http.Request, error) {
+// NewNewsGeneralLatestGetRequest generates requests for NewsGeneralLatestGet
+func NewNewsGeneralLatestGetRequest(server string, params *NewsGeneralLatestGetParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -6398,7 +7393,7 @@ func NewTreasuryRatesGetRequest(server string, params *TreasuryRatesGetParams) (
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/treasury-rates")
+	operationPath := fmt.Sprintf("/news/general-latest")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6441,267 +7436,2914 @@ func NewTreasuryRatesGetRequest(server string, params *TreasuryRatesGetParams) (
 
 		}
 
-		if encoded := queryValues.Encode(); encoded != "" {
-			rawQueryFragments = append(rawQueryFragments, encoded)
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "page", *params.Page, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
 		}
-		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
-	}
 
-	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+		if params.Limit != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
 		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
 		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// NewNewsStockLatestGetRequest generates requests for NewsStockLatestGet
+func NewNewsStockLatestGetRequest(server string, params *NewsStockLatestGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/news/stock-latest")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// AnalystEstimatesGetWithResponse request
-	AnalystEstimatesGetWithResponse(ctx context.Context, params *AnalystEstimatesGetParams, reqEditors ...RequestEditorFn) (*AnalystEstimatesGetClientResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// AvailableExchangesGetWithResponse request
-	AvailableExchangesGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AvailableExchangesGetClientResponse, error)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	// BalanceSheetStatementGetWithResponse request
-	BalanceSheetStatementGetWithResponse(ctx context.Context, params *BalanceSheetStatementGetParams, reqEditors ...RequestEditorFn) (*BalanceSheetStatementGetClientResponse, error)
+		if params.From != nil {
 
-	// BalanceSheetStatementBulkGetWithResponse request
-	BalanceSheetStatementBulkGetWithResponse(ctx context.Context, params *BalanceSheetStatementBulkGetParams, reqEditors ...RequestEditorFn) (*BalanceSheetStatementBulkGetClientResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// BalanceSheetStatementTTMGetWithResponse request
-	BalanceSheetStatementTTMGetWithResponse(ctx context.Context, params *BalanceSheetStatementTTMGetParams, reqEditors ...RequestEditorFn) (*BalanceSheetStatementTTMGetClientResponse, error)
+		}
 
-	// BatchIndexQuotesGetWithResponse request
-	BatchIndexQuotesGetWithResponse(ctx context.Context, params *BatchIndexQuotesGetParams, reqEditors ...RequestEditorFn) (*BatchIndexQuotesGetClientResponse, error)
+		if params.To != nil {
 
-	// BatchQuoteGetWithResponse request
-	BatchQuoteGetWithResponse(ctx context.Context, params *BatchQuoteGetParams, reqEditors ...RequestEditorFn) (*BatchQuoteGetClientResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// BatchQuoteShortGetWithResponse request
-	BatchQuoteShortGetWithResponse(ctx context.Context, params *BatchQuoteShortGetParams, reqEditors ...RequestEditorFn) (*BatchQuoteShortGetClientResponse, error)
+		}
 
-	// CashFlowStatementGetWithResponse request
-	CashFlowStatementGetWithResponse(ctx context.Context, params *CashFlowStatementGetParams, reqEditors ...RequestEditorFn) (*CashFlowStatementGetClientResponse, error)
+		if params.Page != nil {
 
-	// CashFlowStatementBulkGetWithResponse request
-	CashFlowStatementBulkGetWithResponse(ctx context.Context, params *CashFlowStatementBulkGetParams, reqEditors ...RequestEditorFn) (*CashFlowStatementBulkGetClientResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "page", *params.Page, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// CashFlowStatementTTMGetWithResponse request
-	CashFlowStatementTTMGetWithResponse(ctx context.Context, params *CashFlowStatementTTMGetParams, reqEditors ...RequestEditorFn) (*CashFlowStatementTTMGetClientResponse, error)
+		}
 
-	// CommoditiesListGetWithResponse request
-	CommoditiesListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CommoditiesListGetClientResponse, error)
+		if params.Limit != nil {
 
-	// DcfBulkGetWithResponse request
-	DcfBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DcfBulkGetClientResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// DelistedCompaniesWithResponse request
-	DelistedCompaniesWithResponse(ctx context.Context, params *DelistedCompaniesParams, reqEditors ...RequestEditorFn) (*DelistedCompaniesClientResponse, error)
+		}
 
-	// DividendsGetWithResponse request
-	DividendsGetWithResponse(ctx context.Context, params *DividendsGetParams, reqEditors ...RequestEditorFn) (*DividendsGetClientResponse, error)
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-	// DividendsCalendarGetWithResponse request
-	DividendsCalendarGetWithResponse(ctx context.Context, params *DividendsCalendarGetParams, reqEditors ...RequestEditorFn) (*DividendsCalendarGetClientResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// EarningsGetWithResponse request
-	EarningsGetWithResponse(ctx context.Context, params *EarningsGetParams, reqEditors ...RequestEditorFn) (*EarningsGetClientResponse, error)
+	return req, nil
+}
 
-	// GetEarningsCalendarWithResponse request
-	GetEarningsCalendarWithResponse(ctx context.Context, params *GetEarningsCalendarParams, reqEditors ...RequestEditorFn) (*GetEarningsCalendarClientResponse, error)
+// NewPriceTargetConsensusGetRequest generates requests for PriceTargetConsensusGet
+func NewPriceTargetConsensusGetRequest(server string, params *PriceTargetConsensusGetParams) (*http.Request, error) {
+	var err error
 
-	// EconomicCalendarGetWithResponse request
-	EconomicCalendarGetWithResponse(ctx context.Context, params *EconomicCalendarGetParams, reqEditors ...RequestEditorFn) (*EconomicCalendarGetClientResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// EnterpriseValueGetWithResponse request
-	EnterpriseValueGetWithResponse(ctx context.Context, params *EnterpriseValueGetParams, reqEditors ...RequestEditorFn) (*EnterpriseValueGetClientResponse, error)
+	operationPath := fmt.Sprintf("/price-target-consensus")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// EsgDisclosuresGetWithResponse request
-	EsgDisclosuresGetWithResponse(ctx context.Context, params *EsgDisclosuresGetParams, reqEditors ...RequestEditorFn) (*EsgDisclosuresGetClientResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// EsgRatingsGetWithResponse request
-	EsgRatingsGetWithResponse(ctx context.Context, params *EsgRatingsGetParams, reqEditors ...RequestEditorFn) (*EsgRatingsGetClientResponse, error)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	// ETFListGetWithResponse request
-	ETFListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ETFListGetClientResponse, error)
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
 
-	// ETFCountryWeightingsGetWithResponse request
-	ETFCountryWeightingsGetWithResponse(ctx context.Context, params *ETFCountryWeightingsGetParams, reqEditors ...RequestEditorFn) (*ETFCountryWeightingsGetClientResponse, error)
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
 
-	// ETFHoldingsGetWithResponse request
-	ETFHoldingsGetWithResponse(ctx context.Context, params *ETFHoldingsGetParams, reqEditors ...RequestEditorFn) (*ETFHoldingsGetClientResponse, error)
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// ETFInfoGetWithResponse request
-	ETFInfoGetWithResponse(ctx context.Context, params *ETFInfoGetParams, reqEditors ...RequestEditorFn) (*ETFInfoGetClientResponse, error)
+	return req, nil
+}
 
-	// ETFSectorWeightingsGetWithResponse request
-	ETFSectorWeightingsGetWithResponse(ctx context.Context, params *ETFSectorWeightingsGetParams, reqEditors ...RequestEditorFn) (*ETFSectorWeightingsGetClientResponse, error)
+// NewPriceTargetLatestNewsGetRequest generates requests for PriceTargetLatestNewsGet
+func NewPriceTargetLatestNewsGetRequest(server string, params *PriceTargetLatestNewsGetParams) (*http.Request, error) {
+	var err error
 
-	// ForexCurrencyPairsGetWithResponse request
-	ForexCurrencyPairsGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ForexCurrencyPairsGetClientResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GradesLatestNewsGetWithResponse request
-	GradesLatestNewsGetWithResponse(ctx context.Context, params *GradesLatestNewsGetParams, reqEditors ...RequestEditorFn) (*GradesLatestNewsGetClientResponse, error)
+	operationPath := fmt.Sprintf("/price-target-latest-news")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// HistoricalChart15MinGetWithResponse request
-	HistoricalChart15MinGetWithResponse(ctx context.Context, params *HistoricalChart15MinGetParams, reqEditors ...RequestEditorFn) (*HistoricalChart15MinGetClientResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// HistoricalPriceEodFullGetWithResponse request
-	HistoricalPriceEodFullGetWithResponse(ctx context.Context, params *HistoricalPriceEodFullGetParams, reqEditors ...RequestEditorFn) (*HistoricalPriceEodFullGetClientResponse, error)
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
 
-	// HistoricalPriceEodLightGetWithResponse request
-	HistoricalPriceEodLightGetWithResponse(ctx context.Context, params *HistoricalPriceEodLightGetParams, reqEditors ...RequestEditorFn) (*HistoricalPriceEodLightGetClientResponse, error)
+		if params.Page != nil {
 
-	// IncomeStatementGetWithResponse request
-	IncomeStatementGetWithResponse(ctx context.Context, params *IncomeStatementGetParams, reqEditors ...RequestEditorFn) (*IncomeStatementGetClientResponse, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "page", *params.Page, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
 
-	// IncomeStatementBulkGetWithResponse request
-	IncomeStatementBulkGetWithResponse(ctx context.Context, params *IncomeStatementBulkGetParams, reqEditors ...RequestEditorFn) (*IncomeStatementBulkGetClientResponse, error)
+		}
 
-	// IncomeStatementTTMGetWithResponse request
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPriceTargetNewsGetRequest generates requests for PriceTargetNewsGet
+func NewPriceTargetNewsGetRequest(server string, params *PriceTargetNewsGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/price-target-news")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "page", *params.Page, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPriceTargetSummaryGetRequest generates requests for PriceTargetSummaryGet
+func NewPriceTargetSummaryGetRequest(server string, params *PriceTargetSummaryGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/price-target-summary")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewProfileGetRequest generates requests for ProfileGet
+func NewProfileGetRequest(server string, params *ProfileGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/profile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewProfileBulkGetRequest generates requests for ProfileBulkGet
+func NewProfileBulkGetRequest(server string, params *ProfileBulkGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/profile-bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "part", params.Part, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewQuoteGetRequest generates requests for QuoteGet
+func NewQuoteGetRequest(server string, params *QuoteGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/quote")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewQuoteShortGetRequest generates requests for QuoteShortGet
+func NewQuoteShortGetRequest(server string, params *QuoteShortGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/quote-short")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRatingBulkGetRequest generates requests for RatingBulkGet
+func NewRatingBulkGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rating-bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRatingsSnapshotGetRequest generates requests for RatingsSnapshotGet
+func NewRatingsSnapshotGetRequest(server string, params *RatingsSnapshotGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ratings-snapshot")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRatiosGetRequest generates requests for RatiosGet
+func NewRatiosGetRequest(server string, params *RatiosGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ratios")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "period", *params.Period, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRatiosTTMGetRequest generates requests for RatiosTTMGet
+func NewRatiosTTMGetRequest(server string, params *RatiosTTMGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ratios-ttm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRatiosTTMBulkGetRequest generates requests for RatiosTTMBulkGet
+func NewRatiosTTMBulkGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ratios-ttm-bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevenueGeographicSegmentationGetRequest generates requests for RevenueGeographicSegmentationGet
+func NewRevenueGeographicSegmentationGetRequest(server string, params *RevenueGeographicSegmentationGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/revenue-geographic-segmentation")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "period", *params.Period, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Structure != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "structure", *params.Structure, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevenueProductSegmentationGetRequest generates requests for RevenueProductSegmentationGet
+func NewRevenueProductSegmentationGetRequest(server string, params *RevenueProductSegmentationGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/revenue-product-segmentation")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "period", *params.Period, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Structure != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "structure", *params.Structure, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchCIKGetRequest generates requests for SearchCIKGet
+func NewSearchCIKGetRequest(server string, params *SearchCIKGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search-cik")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cik", params.Cik, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchCUSIPGetRequest generates requests for SearchCUSIPGet
+func NewSearchCUSIPGetRequest(server string, params *SearchCUSIPGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search-cusip")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cusip", params.Cusip, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchISINGetRequest generates requests for SearchISINGet
+func NewSearchISINGetRequest(server string, params *SearchISINGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search-isin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "isin", params.Isin, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchNameGetRequest generates requests for SearchNameGet
+func NewSearchNameGetRequest(server string, params *SearchNameGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search-name")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "search-symbol", params.SearchSymbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Exchange != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "exchange", *params.Exchange, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchSymbolGetRequest generates requests for SearchSymbolGet
+func NewSearchSymbolGetRequest(server string, params *SearchSymbolGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search-symbol")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "query", params.Query, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Exchange != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "exchange", *params.Exchange, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSharesFloatGetRequest generates requests for SharesFloatGet
+func NewSharesFloatGetRequest(server string, params *SharesFloatGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/shares-float")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSP500ConstituentGetRequest generates requests for SP500ConstituentGet
+func NewSP500ConstituentGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sp500-constituent")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSplitsRequest generates requests for GetSplits
+func NewGetSplitsRequest(server string, params *GetSplitsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/splits")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSplitsCalendarRequest generates requests for GetSplitsCalendar
+func NewGetSplitsCalendarRequest(server string, params *GetSplitsCalendarParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/splits-calendar")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStockListGetRequest generates requests for StockListGet
+func NewStockListGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock-list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSymbolChangeGetRequest generates requests for SymbolChangeGet
+func NewSymbolChangeGetRequest(server string, params *SymbolChangeGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/symbol-change")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Invalid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "invalid", *params.Invalid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTechnicalIndicatorsRsiGetRequest generates requests for TechnicalIndicatorsRsiGet
+func NewTechnicalIndicatorsRsiGetRequest(server string, params *TechnicalIndicatorsRsiGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/technical-indicators/rsi")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "symbol", params.Symbol, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "periodLength", params.PeriodLength, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "timeframe", params.Timeframe, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTreasuryRatesGetRequest generates requests for TreasuryRatesGet
+func NewTreasuryRatesGetRequest(server string, params *TreasuryRatesGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/treasury-rates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "from", *params.From, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "to", *params.To, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: "date"}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AllExchangeMarketHoursGetWithResponse request
+	AllExchangeMarketHoursGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AllExchangeMarketHoursGetClientResponse, error)
+
+	// AnalystEstimatesGetWithResponse request
+	AnalystEstimatesGetWithResponse(ctx context.Context, params *AnalystEstimatesGetParams, reqEditors ...RequestEditorFn) (*AnalystEstimatesGetClientResponse, error)
+
+	// AvailableExchangesGetWithResponse request
+	AvailableExchangesGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AvailableExchangesGetClientResponse, error)
+
+	// BalanceSheetStatementGetWithResponse request
+	BalanceSheetStatementGetWithResponse(ctx context.Context, params *BalanceSheetStatementGetParams, reqEditors ...RequestEditorFn) (*BalanceSheetStatementGetClientResponse, error)
+
+	// BalanceSheetStatementBulkGetWithResponse request
+	BalanceSheetStatementBulkGetWithResponse(ctx context.Context, params *BalanceSheetStatementBulkGetParams, reqEditors ...RequestEditorFn) (*BalanceSheetStatementBulkGetClientResponse, error)
+
+	// BalanceSheetStatementTTMGetWithResponse request
+	BalanceSheetStatementTTMGetWithResponse(ctx context.Context, params *BalanceSheetStatementTTMGetParams, reqEditors ...RequestEditorFn) (*BalanceSheetStatementTTMGetClientResponse, error)
+
+	// BatchIndexQuotesGetWithResponse request
+	BatchIndexQuotesGetWithResponse(ctx context.Context, params *BatchIndexQuotesGetParams, reqEditors ...RequestEditorFn) (*BatchIndexQuotesGetClientResponse, error)
+
+	// BatchQuoteGetWithResponse request
+	BatchQuoteGetWithResponse(ctx context.Context, params *BatchQuoteGetParams, reqEditors ...RequestEditorFn) (*BatchQuoteGetClientResponse, error)
+
+	// BatchQuoteShortGetWithResponse request
+	BatchQuoteShortGetWithResponse(ctx context.Context, params *BatchQuoteShortGetParams, reqEditors ...RequestEditorFn) (*BatchQuoteShortGetClientResponse, error)
+
+	// CashFlowStatementGetWithResponse request
+	CashFlowStatementGetWithResponse(ctx context.Context, params *CashFlowStatementGetParams, reqEditors ...RequestEditorFn) (*CashFlowStatementGetClientResponse, error)
+
+	// CashFlowStatementBulkGetWithResponse request
+	CashFlowStatementBulkGetWithResponse(ctx context.Context, params *CashFlowStatementBulkGetParams, reqEditors ...RequestEditorFn) (*CashFlowStatementBulkGetClientResponse, error)
+
+	// CashFlowStatementTTMGetWithResponse request
+	CashFlowStatementTTMGetWithResponse(ctx context.Context, params *CashFlowStatementTTMGetParams, reqEditors ...RequestEditorFn) (*CashFlowStatementTTMGetClientResponse, error)
+
+	// CommoditiesListGetWithResponse request
+	CommoditiesListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CommoditiesListGetClientResponse, error)
+
+	// CompanyScreenerGetWithResponse request
+	CompanyScreenerGetWithResponse(ctx context.Context, params *CompanyScreenerGetParams, reqEditors ...RequestEditorFn) (*CompanyScreenerGetClientResponse, error)
+
+	// CustomDiscountedCashFlowGetWithResponse request
+	CustomDiscountedCashFlowGetWithResponse(ctx context.Context, params *CustomDiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*CustomDiscountedCashFlowGetClientResponse, error)
+
+	// CustomLeveredDiscountedCashFlowGetWithResponse request
+	CustomLeveredDiscountedCashFlowGetWithResponse(ctx context.Context, params *CustomLeveredDiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*CustomLeveredDiscountedCashFlowGetClientResponse, error)
+
+	// DcfBulkGetWithResponse request
+	DcfBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DcfBulkGetClientResponse, error)
+
+	// DelistedCompaniesWithResponse request
+	DelistedCompaniesWithResponse(ctx context.Context, params *DelistedCompaniesParams, reqEditors ...RequestEditorFn) (*DelistedCompaniesClientResponse, error)
+
+	// DiscountedCashFlowGetWithResponse request
+	DiscountedCashFlowGetWithResponse(ctx context.Context, params *DiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*DiscountedCashFlowGetClientResponse, error)
+
+	// DividendsGetWithResponse request
+	DividendsGetWithResponse(ctx context.Context, params *DividendsGetParams, reqEditors ...RequestEditorFn) (*DividendsGetClientResponse, error)
+
+	// DividendsCalendarGetWithResponse request
+	DividendsCalendarGetWithResponse(ctx context.Context, params *DividendsCalendarGetParams, reqEditors ...RequestEditorFn) (*DividendsCalendarGetClientResponse, error)
+
+	// DowJonesConstituentGetWithResponse request
+	DowJonesConstituentGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DowJonesConstituentGetClientResponse, error)
+
+	// EarningsGetWithResponse request
+	EarningsGetWithResponse(ctx context.Context, params *EarningsGetParams, reqEditors ...RequestEditorFn) (*EarningsGetClientResponse, error)
+
+	// GetEarningsCalendarWithResponse request
+	GetEarningsCalendarWithResponse(ctx context.Context, params *GetEarningsCalendarParams, reqEditors ...RequestEditorFn) (*GetEarningsCalendarClientResponse, error)
+
+	// EconomicCalendarGetWithResponse request
+	EconomicCalendarGetWithResponse(ctx context.Context, params *EconomicCalendarGetParams, reqEditors ...RequestEditorFn) (*EconomicCalendarGetClientResponse, error)
+
+	// EnterpriseValueGetWithResponse request
+	EnterpriseValueGetWithResponse(ctx context.Context, params *EnterpriseValueGetParams, reqEditors ...RequestEditorFn) (*EnterpriseValueGetClientResponse, error)
+
+	// EsgDisclosuresGetWithResponse request
+	EsgDisclosuresGetWithResponse(ctx context.Context, params *EsgDisclosuresGetParams, reqEditors ...RequestEditorFn) (*EsgDisclosuresGetClientResponse, error)
+
+	// EsgRatingsGetWithResponse request
+	EsgRatingsGetWithResponse(ctx context.Context, params *EsgRatingsGetParams, reqEditors ...RequestEditorFn) (*EsgRatingsGetClientResponse, error)
+
+	// ETFListGetWithResponse request
+	ETFListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ETFListGetClientResponse, error)
+
+	// ETFCountryWeightingsGetWithResponse request
+	ETFCountryWeightingsGetWithResponse(ctx context.Context, params *ETFCountryWeightingsGetParams, reqEditors ...RequestEditorFn) (*ETFCountryWeightingsGetClientResponse, error)
+
+	// ETFHoldingsGetWithResponse request
+	ETFHoldingsGetWithResponse(ctx context.Context, params *ETFHoldingsGetParams, reqEditors ...RequestEditorFn) (*ETFHoldingsGetClientResponse, error)
+
+	// ETFInfoGetWithResponse request
+	ETFInfoGetWithResponse(ctx context.Context, params *ETFInfoGetParams, reqEditors ...RequestEditorFn) (*ETFInfoGetClientResponse, error)
+
+	// ETFSectorWeightingsGetWithResponse request
+	ETFSectorWeightingsGetWithResponse(ctx context.Context, params *ETFSectorWeightingsGetParams, reqEditors ...RequestEditorFn) (*ETFSectorWeightingsGetClientResponse, error)
+
+	// ExchangeMarketHoursGetWithResponse request
+	ExchangeMarketHoursGetWithResponse(ctx context.Context, params *ExchangeMarketHoursGetParams, reqEditors ...RequestEditorFn) (*ExchangeMarketHoursGetClientResponse, error)
+
+	// FinancialScoresGetWithResponse request
+	FinancialScoresGetWithResponse(ctx context.Context, params *FinancialScoresGetParams, reqEditors ...RequestEditorFn) (*FinancialScoresGetClientResponse, error)
+
+	// ForexCurrencyPairsGetWithResponse request
+	ForexCurrencyPairsGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ForexCurrencyPairsGetClientResponse, error)
+
+	// GradesGetWithResponse request
+	GradesGetWithResponse(ctx context.Context, params *GradesGetParams, reqEditors ...RequestEditorFn) (*GradesGetClientResponse, error)
+
+	// GradesHistoricalGetWithResponse request
+	GradesHistoricalGetWithResponse(ctx context.Context, params *GradesHistoricalGetParams, reqEditors ...RequestEditorFn) (*GradesHistoricalGetClientResponse, error)
+
+	// GradesLatestNewsGetWithResponse request
+	GradesLatestNewsGetWithResponse(ctx context.Context, params *GradesLatestNewsGetParams, reqEditors ...RequestEditorFn) (*GradesLatestNewsGetClientResponse, error)
+
+	// HistoricalChart15MinGetWithResponse request
+	HistoricalChart15MinGetWithResponse(ctx context.Context, params *HistoricalChart15MinGetParams, reqEditors ...RequestEditorFn) (*HistoricalChart15MinGetClientResponse, error)
+
+	// HistoricalDowJonesConstituentGetWithResponse request
+	HistoricalDowJonesConstituentGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HistoricalDowJonesConstituentGetClientResponse, error)
+
+	// HistoricalNasdaqConstituentGetWithResponse request
+	HistoricalNasdaqConstituentGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HistoricalNasdaqConstituentGetClientResponse, error)
+
+	// HistoricalPriceEodFullGetWithResponse request
+	HistoricalPriceEodFullGetWithResponse(ctx context.Context, params *HistoricalPriceEodFullGetParams, reqEditors ...RequestEditorFn) (*HistoricalPriceEodFullGetClientResponse, error)
+
+	// HistoricalPriceEodLightGetWithResponse request
+	HistoricalPriceEodLightGetWithResponse(ctx context.Context, params *HistoricalPriceEodLightGetParams, reqEditors ...RequestEditorFn) (*HistoricalPriceEodLightGetClientResponse, error)
+
+	// HistoricalSP500ConstituentGetWithResponse request
+	HistoricalSP500ConstituentGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HistoricalSP500ConstituentGetClientResponse, error)
+
+	// HolidaysByExchangeGetWithResponse request
+	HolidaysByExchangeGetWithResponse(ctx context.Context, params *HolidaysByExchangeGetParams, reqEditors ...RequestEditorFn) (*HolidaysByExchangeGetClientResponse, error)
+
+	// IncomeStatementGetWithResponse request
+	IncomeStatementGetWithResponse(ctx context.Context, params *IncomeStatementGetParams, reqEditors ...RequestEditorFn) (*IncomeStatementGetClientResponse, error)
+
+	// IncomeStatementBulkGetWithResponse request
+	IncomeStatementBulkGetWithResponse(ctx context.Context, params *IncomeStatementBulkGetParams, reqEditors ...RequestEditorFn) (*IncomeStatementBulkGetClientResponse, error)
+
+	// IncomeStatementTTMGetWithResponse request
 	IncomeStatementTTMGetWithResponse(ctx context.Context, params *IncomeStatementTTMGetParams, reqEditors ...RequestEditorFn) (*IncomeStatementTTMGetClientResponse, error)
 
-	// IndexConstituentListGetWithResponse request
-	IndexConstituentListGetWithResponse(ctx context.Context, params *IndexConstituentListGetParams, reqEditors ...RequestEditorFn) (*IndexConstituentListGetClientResponse, error)
+	// IndexConstituentListGetWithResponse request
+	IndexConstituentListGetWithResponse(ctx context.Context, params *IndexConstituentListGetParams, reqEditors ...RequestEditorFn) (*IndexConstituentListGetClientResponse, error)
+
+	// IndexListGetWithResponse request
+	IndexListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*IndexListGetClientResponse, error)
+
+	// InsiderTradingLatestGetWithResponse request
+	InsiderTradingLatestGetWithResponse(ctx context.Context, params *InsiderTradingLatestGetParams, reqEditors ...RequestEditorFn) (*InsiderTradingLatestGetClientResponse, error)
+
+	// InsiderTradingSearchGetWithResponse request
+	InsiderTradingSearchGetWithResponse(ctx context.Context, params *InsiderTradingSearchGetParams, reqEditors ...RequestEditorFn) (*InsiderTradingSearchGetClientResponse, error)
+
+	// KeyMetricsGetWithResponse request
+	KeyMetricsGetWithResponse(ctx context.Context, params *KeyMetricsGetParams, reqEditors ...RequestEditorFn) (*KeyMetricsGetClientResponse, error)
+
+	// KeyMetricsTTMGetWithResponse request
+	KeyMetricsTTMGetWithResponse(ctx context.Context, params *KeyMetricsTTMGetParams, reqEditors ...RequestEditorFn) (*KeyMetricsTTMGetClientResponse, error)
+
+	// KeyMetricsTTMBulkGetWithResponse request
+	KeyMetricsTTMBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*KeyMetricsTTMBulkGetClientResponse, error)
+
+	// LeveredDiscountedCashFlowGetWithResponse request
+	LeveredDiscountedCashFlowGetWithResponse(ctx context.Context, params *LeveredDiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*LeveredDiscountedCashFlowGetClientResponse, error)
+
+	// MarketCapitalizationGetWithResponse request
+	MarketCapitalizationGetWithResponse(ctx context.Context, params *MarketCapitalizationGetParams, reqEditors ...RequestEditorFn) (*MarketCapitalizationGetClientResponse, error)
+
+	// MarketCapitalizationBatchGetWithResponse request
+	MarketCapitalizationBatchGetWithResponse(ctx context.Context, params *MarketCapitalizationBatchGetParams, reqEditors ...RequestEditorFn) (*MarketCapitalizationBatchGetClientResponse, error)
+
+	// NasdaqConstituentGetWithResponse request
+	NasdaqConstituentGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NasdaqConstituentGetClientResponse, error)
+
+	// NewsGeneralLatestGetWithResponse request
+	NewsGeneralLatestGetWithResponse(ctx context.Context, params *NewsGeneralLatestGetParams, reqEditors ...RequestEditorFn) (*NewsGeneralLatestGetClientResponse, error)
+
+	// NewsStockLatestGetWithResponse request
+	NewsStockLatestGetWithResponse(ctx context.Context, params *NewsStockLatestGetParams, reqEditors ...RequestEditorFn) (*NewsStockLatestGetClientResponse, error)
+
+	// PriceTargetConsensusGetWithResponse request
+	PriceTargetConsensusGetWithResponse(ctx context.Context, params *PriceTargetConsensusGetParams, reqEditors ...RequestEditorFn) (*PriceTargetConsensusGetClientResponse, error)
+
+	// PriceTargetLatestNewsGetWithResponse request
+	PriceTargetLatestNewsGetWithResponse(ctx context.Context, params *PriceTargetLatestNewsGetParams, reqEditors ...RequestEditorFn) (*PriceTargetLatestNewsGetClientResponse, error)
+
+	// PriceTargetNewsGetWithResponse request
+	PriceTargetNewsGetWithResponse(ctx context.Context, params *PriceTargetNewsGetParams, reqEditors ...RequestEditorFn) (*PriceTargetNewsGetClientResponse, error)
+
+	// PriceTargetSummaryGetWithResponse request
+	PriceTargetSummaryGetWithResponse(ctx context.Context, params *PriceTargetSummaryGetParams, reqEditors ...RequestEditorFn) (*PriceTargetSummaryGetClientResponse, error)
+
+	// ProfileGetWithResponse request
+	ProfileGetWithResponse(ctx context.Context, params *ProfileGetParams, reqEditors ...RequestEditorFn) (*ProfileGetClientResponse, error)
+
+	// ProfileBulkGetWithResponse request
+	ProfileBulkGetWithResponse(ctx context.Context, params *ProfileBulkGetParams, reqEditors ...RequestEditorFn) (*ProfileBulkGetClientResponse, error)
+
+	// QuoteGetWithResponse request
+	QuoteGetWithResponse(ctx context.Context, params *QuoteGetParams, reqEditors ...RequestEditorFn) (*QuoteGetClientResponse, error)
+
+	// QuoteShortGetWithResponse request
+	QuoteShortGetWithResponse(ctx context.Context, params *QuoteShortGetParams, reqEditors ...RequestEditorFn) (*QuoteShortGetClientResponse, error)
+
+	// RatingBulkGetWithResponse request
+	RatingBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RatingBulkGetClientResponse, error)
+
+	// RatingsSnapshotGetWithResponse request
+	RatingsSnapshotGetWithResponse(ctx context.Context, params *RatingsSnapshotGetParams, reqEditors ...RequestEditorFn) (*RatingsSnapshotGetClientResponse, error)
+
+	// RatiosGetWithResponse request
+	RatiosGetWithResponse(ctx context.Context, params *RatiosGetParams, reqEditors ...RequestEditorFn) (*RatiosGetClientResponse, error)
+
+	// RatiosTTMGetWithResponse request
+	RatiosTTMGetWithResponse(ctx context.Context, params *RatiosTTMGetParams, reqEditors ...RequestEditorFn) (*RatiosTTMGetClientResponse, error)
+
+	// RatiosTTMBulkGetWithResponse request
+	RatiosTTMBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RatiosTTMBulkGetClientResponse, error)
+
+	// RevenueGeographicSegmentationGetWithResponse request
+	RevenueGeographicSegmentationGetWithResponse(ctx context.Context, params *RevenueGeographicSegmentationGetParams, reqEditors ...RequestEditorFn) (*RevenueGeographicSegmentationGetClientResponse, error)
+
+	// RevenueProductSegmentationGetWithResponse request
+	RevenueProductSegmentationGetWithResponse(ctx context.Context, params *RevenueProductSegmentationGetParams, reqEditors ...RequestEditorFn) (*RevenueProductSegmentationGetClientResponse, error)
+
+	// SearchCIKGetWithResponse request
+	SearchCIKGetWithResponse(ctx context.Context, params *SearchCIKGetParams, reqEditors ...RequestEditorFn) (*SearchCIKGetClientResponse, error)
+
+	// SearchCUSIPGetWithResponse request
+	SearchCUSIPGetWithResponse(ctx context.Context, params *SearchCUSIPGetParams, reqEditors ...RequestEditorFn) (*SearchCUSIPGetClientResponse, error)
+
+	// SearchISINGetWithResponse request
+	SearchISINGetWithResponse(ctx context.Context, params *SearchISINGetParams, reqEditors ...RequestEditorFn) (*SearchISINGetClientResponse, error)
+
+	// SearchNameGetWithResponse request
+	SearchNameGetWithResponse(ctx context.Context, params *SearchNameGetParams, reqEditors ...RequestEditorFn) (*SearchNameGetClientResponse, error)
+
+	// SearchSymbolGetWithResponse request
+	SearchSymbolGetWithResponse(ctx context.Context, params *SearchSymbolGetParams, reqEditors ...RequestEditorFn) (*SearchSymbolGetClientResponse, error)
+
+	// SharesFloatGetWithResponse request
+	SharesFloatGetWithResponse(ctx context.Context, params *SharesFloatGetParams, reqEditors ...RequestEditorFn) (*SharesFloatGetClientResponse, error)
+
+	// SP500ConstituentGetWithResponse request
+	SP500ConstituentGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SP500ConstituentGetClientResponse, error)
+
+	// GetSplitsWithResponse request
+	GetSplitsWithResponse(ctx context.Context, params *GetSplitsParams, reqEditors ...RequestEditorFn) (*GetSplitsClientResponse, error)
+
+	// GetSplitsCalendarWithResponse request
+	GetSplitsCalendarWithResponse(ctx context.Context, params *GetSplitsCalendarParams, reqEditors ...RequestEditorFn) (*GetSplitsCalendarClientResponse, error)
+
+	// StockListGetWithResponse request
+	StockListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StockListGetClientResponse, error)
+
+	// SymbolChangeGetWithResponse request
+	SymbolChangeGetWithResponse(ctx context.Context, params *SymbolChangeGetParams, reqEditors ...RequestEditorFn) (*SymbolChangeGetClientResponse, error)
+
+	// TechnicalIndicatorsRsiGetWithResponse request
+	TechnicalIndicatorsRsiGetWithResponse(ctx context.Context, params *TechnicalIndicatorsRsiGetParams, reqEditors ...RequestEditorFn) (*TechnicalIndicatorsRsiGetClientResponse, error)
+
+	// TreasuryRatesGetWithResponse request
+	TreasuryRatesGetWithResponse(ctx context.Context, params *TreasuryRatesGetParams, reqEditors ...RequestEditorFn) (*TreasuryRatesGetClientResponse, error)
+}
+
+type AllExchangeMarketHoursGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ExchangeMarketHours
+}
+
+// Status returns HTTPResponse.Status
+func (r AllExchangeMarketHoursGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AllExchangeMarketHoursGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AnalystEstimatesGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]FinancialEstimates
+}
+
+// Status returns HTTPResponse.Status
+func (r AnalystEstimatesGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AnalystEstimatesGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AvailableExchangesGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Exchange
+}
+
+// Status returns HTTPResponse.Status
+func (r AvailableExchangesGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AvailableExchangesGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BalanceSheetStatementGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BalanceSheetStatement
+}
+
+// Status returns HTTPResponse.Status
+func (r BalanceSheetStatementGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BalanceSheetStatementGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BalanceSheetStatementBulkGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r BalanceSheetStatementBulkGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BalanceSheetStatementBulkGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BalanceSheetStatementTTMGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BalanceSheetStatementTTM
+}
+
+// Status returns HTTPResponse.Status
+func (r BalanceSheetStatementTTMGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BalanceSheetStatementTTMGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BatchIndexQuotesGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ShortQuote
+}
+
+// Status returns HTTPResponse.Status
+func (r BatchIndexQuotesGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BatchIndexQuotesGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BatchQuoteGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]FullQuote
+}
+
+// Status returns HTTPResponse.Status
+func (r BatchQuoteGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BatchQuoteGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BatchQuoteShortGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ShortQuote
+}
+
+// Status returns HTTPResponse.Status
+func (r BatchQuoteShortGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BatchQuoteShortGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CashFlowStatementGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CashFlowStatement
+}
+
+// Status returns HTTPResponse.Status
+func (r CashFlowStatementGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CashFlowStatementGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CashFlowStatementBulkGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CashFlowStatementBulkGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CashFlowStatementBulkGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CashFlowStatementTTMGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CashFlowStatementTTM
+}
+
+// Status returns HTTPResponse.Status
+func (r CashFlowStatementTTMGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CashFlowStatementTTMGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CommoditiesListGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CommoditySymbol
+}
+
+// Status returns HTTPResponse.Status
+func (r CommoditiesListGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CommoditiesListGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompanyScreenerGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CompanyScreenerResult
+}
+
+// Status returns HTTPResponse.Status
+func (r CompanyScreenerGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompanyScreenerGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CustomDiscountedCashFlowGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CustomDiscountedCashFlow
+}
+
+// Status returns HTTPResponse.Status
+func (r CustomDiscountedCashFlowGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CustomDiscountedCashFlowGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CustomLeveredDiscountedCashFlowGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CustomLeveredDiscountedCashFlow
+}
+
+// Status returns HTTPResponse.Status
+func (r CustomLeveredDiscountedCashFlowGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CustomLeveredDiscountedCashFlowGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DcfBulkGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DCF
+}
+
+// Status returns HTTPResponse.Status
+func (r DcfBulkGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DcfBulkGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DelistedCompaniesClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DelistedCompany
+}
+
+// Status returns HTTPResponse.Status
+func (r DelistedCompaniesClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DelistedCompaniesClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DiscountedCashFlowGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DiscountedCashFlow
+}
+
+// Status returns HTTPResponse.Status
+func (r DiscountedCashFlowGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DiscountedCashFlowGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DividendsGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DividendEvent
+}
+
+// Status returns HTTPResponse.Status
+func (r DividendsGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DividendsGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DividendsCalendarGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DividendEvent
+}
+
+// Status returns HTTPResponse.Status
+func (r DividendsCalendarGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DividendsCalendarGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DowJonesConstituentGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]IndexConstituent
+}
+
+// Status returns HTTPResponse.Status
+func (r DowJonesConstituentGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DowJonesConstituentGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EarningsGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]EarningEvent
+}
+
+// Status returns HTTPResponse.Status
+func (r EarningsGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EarningsGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEarningsCalendarClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]EarningEvent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEarningsCalendarClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEarningsCalendarClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EconomicCalendarGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]EconomicEvent
+}
+
+// Status returns HTTPResponse.Status
+func (r EconomicCalendarGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EconomicCalendarGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnterpriseValueGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]EnterpriseValue
+}
+
+// Status returns HTTPResponse.Status
+func (r EnterpriseValueGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnterpriseValueGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EsgDisclosuresGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]EconomicEsgDisclosure
+}
 
-	// IndexListGetWithResponse request
-	IndexListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*IndexListGetClientResponse, error)
+// Status returns HTTPResponse.Status
+func (r EsgDisclosuresGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// InsiderTradingLatestGetWithResponse request
-	InsiderTradingLatestGetWithResponse(ctx context.Context, params *InsiderTradingLatestGetParams, reqEditors ...RequestEditorFn) (*InsiderTradingLatestGetClientResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r EsgDisclosuresGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// InsiderTradingSearchGetWithResponse request
-	InsiderTradingSearchGetWithResponse(ctx context.Context, params *InsiderTradingSearchGetParams, reqEditors ...RequestEditorFn) (*InsiderTradingSearchGetClientResponse, error)
+type EsgRatingsGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]EconomicEsgRating
+}
 
-	// KeyMetricsGetWithResponse request
-	KeyMetricsGetWithResponse(ctx context.Context, params *KeyMetricsGetParams, reqEditors ...RequestEditorFn) (*KeyMetricsGetClientResponse, error)
+// Status returns HTTPResponse.Status
+func (r EsgRatingsGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// KeyMetricsTTMGetWithResponse request
-	KeyMetricsTTMGetWithResponse(ctx context.Context, params *KeyMetricsTTMGetParams, reqEditors ...RequestEditorFn) (*KeyMetricsTTMGetClientResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r EsgRatingsGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// KeyMetricsTTMBulkGetWithResponse request
-	KeyMetricsTTMBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*KeyMetricsTTMBulkGetClientResponse, error)
+type ETFListGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ETFSymbol
+}
 
-	// MarketCapitalizationGetWithResponse request
-	MarketCapitalizationGetWithResponse(ctx context.Context, params *MarketCapitalizationGetParams, reqEditors ...RequestEditorFn) (*MarketCapitalizationGetClientResponse, error)
+// Status returns HTTPResponse.Status
+func (r ETFListGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// MarketCapitalizationBatchGetWithResponse request
-	MarketCapitalizationBatchGetWithResponse(ctx context.Context, params *MarketCapitalizationBatchGetParams, reqEditors ...RequestEditorFn) (*MarketCapitalizationBatchGetClientResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ETFListGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// NewsGeneralLatestGetWithResponse request
-	NewsGeneralLatestGetWithResponse(ctx context.Context, params *NewsGeneralLatestGetParams, reqEditors ...RequestEditorFn) (*NewsGeneralLatestGetClientResponse, error)
+type ETFCountryWeightingsGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ETFCountryWeight
+}
 
-	// NewsStockLatestGetWithResponse request
-	NewsStockLatestGetWithResponse(ctx context.Context, params *NewsStockLatestGetParams, reqEditors ...RequestEditorFn) (*NewsStockLatestGetClientResponse, error)
+// Status returns HTTPResponse.Status
+func (r ETFCountryWeightingsGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// ProfileGetWithResponse request
-	ProfileGetWithResponse(ctx context.Context, params *ProfileGetParams, reqEditors ...RequestEditorFn) (*ProfileGetClientResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ETFCountryWeightingsGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// ProfileBulkGetWithResponse request
-	ProfileBulkGetWithResponse(ctx context.Context, params *ProfileBulkGetParams, reqEditors ...RequestEditorFn) (*ProfileBulkGetClientResponse, error)
+type ETFHoldingsGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ETFHolding
+}
 
-	// QuoteGetWithResponse request
-	QuoteGetWithResponse(ctx context.Context, params *QuoteGetParams, reqEditors ...RequestEditorFn) (*QuoteGetClientResponse, error)
+// Status returns HTTPResponse.Status
+func (r ETFHoldingsGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// QuoteShortGetWithResponse request
-	QuoteShortGetWithResponse(ctx context.Context, params *QuoteShortGetParams, reqEditors ...RequestEditorFn) (*QuoteShortGetClientResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ETFHoldingsGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// RatingBulkGetWithResponse request
-	RatingBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RatingBulkGetClientResponse, error)
+type ETFInfoGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ETFProfile
+}
 
-	// RatingsSnapshotGetWithResponse request
-	RatingsSnapshotGetWithResponse(ctx context.Context, params *RatingsSnapshotGetParams, reqEditors ...RequestEditorFn) (*RatingsSnapshotGetClientResponse, error)
+// Status returns HTTPResponse.Status
+func (r ETFInfoGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// RatiosGetWithResponse request
-	RatiosGetWithResponse(ctx context.Context, params *RatiosGetParams, reqEditors ...RequestEditorFn) (*RatiosGetClientResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ETFInfoGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// RatiosTTMGetWithResponse request
-	RatiosTTMGetWithResponse(ctx context.Context, params *RatiosTTMGetParams, reqEditors ...RequestEditorFn) (*RatiosTTMGetClientResponse, error)
+type ETFSectorWeightingsGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ETFSectorWeight
+}
 
-	// RatiosTTMBulkGetWithResponse request
-	RatiosTTMBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RatiosTTMBulkGetClientResponse, error)
+// Status returns HTTPResponse.Status
+func (r ETFSectorWeightingsGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// RevenueGeographicSegmentationGetWithResponse request
-	RevenueGeographicSegmentationGetWithResponse(ctx context.Context, params *RevenueGeographicSegmentationGetParams, reqEditors ...RequestEditorFn) (*RevenueGeographicSegmentationGetClientResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ETFSectorWeightingsGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExchangeMarketHoursGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ExchangeMarketHours
+}
+
+// Status returns HTTPResponse.Status
+func (r ExchangeMarketHoursGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExchangeMarketHoursGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FinancialScoresGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]FinancialScores
+}
+
+// Status returns HTTPResponse.Status
+func (r FinancialScoresGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FinancialScoresGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ForexCurrencyPairsGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ForexCurrencyPair
+}
+
+// Status returns HTTPResponse.Status
+func (r ForexCurrencyPairsGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ForexCurrencyPairsGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GradesGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Grade
+}
+
+// Status returns HTTPResponse.Status
+func (r GradesGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GradesGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// RevenueProductSegmentationGetWithResponse request
-	RevenueProductSegmentationGetWithResponse(ctx context.Context, params *RevenueProductSegmentationGetParams, reqEditors ...RequestEditorFn) (*RevenueProductSegmentationGetClientResponse, error)
+type GradesHistoricalGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]HistoricalGrade
+}
 
-	// SearchNameGetWithResponse request
-	SearchNameGetWithResponse(ctx context.Context, params *SearchNameGetParams, reqEditors ...RequestEditorFn) (*SearchNameGetClientResponse, error)
+// Status returns HTTPResponse.Status
+func (r GradesHistoricalGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// SearchSymbolGetWithResponse request
-	SearchSymbolGetWithResponse(ctx context.Context, params *SearchSymbolGetParams, reqEditors ...RequestEditorFn) (*SearchSymbolGetClientResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GradesHistoricalGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// SharesFloatGetWithResponse request
-	SharesFloatGetWithResponse(ctx context.Context, params *SharesFloatGetParams, reqEditors ...RequestEditorFn) (*SharesFloatGetClientResponse, error)
+type GradesLatestNewsGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]GradeNews
+}
 
-	// GetSplitsWithResponse request
-	GetSplitsWithResponse(ctx context.Context, params *GetSplitsParams, reqEditors ...RequestEditorFn) (*GetSplitsClientResponse, error)
+// Status returns HTTPResponse.Status
+func (r GradesLatestNewsGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetSplitsCalendarWithResponse request
-	GetSplitsCalendarWithResponse(ctx context.Context, params *GetSplitsCalendarParams, reqEditors ...RequestEditorFn) (*GetSplitsCalendarClientResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GradesLatestNewsGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// StockListGetWithResponse request
-	StockListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StockListGetClientResponse, error)
+type HistoricalChart15MinGetClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DetailedCandle
+}
 
-	// TechnicalIndicatorsRsiGetWithResponse request
-	TechnicalIndicatorsRsiGetWithResponse(ctx context.Context, params *TechnicalIndicatorsRsiGetParams, reqEditors ...RequestEditorFn) (*TechnicalIndicatorsRsiGetClientResponse, error)
+// Status returns HTTPResponse.Status
+func (r HistoricalChart15MinGetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// TreasuryRatesGetWithResponse request
-	TreasuryRatesGetWithResponse(ctx context.Context, params *TreasuryRatesGetParams, reqEditors ...RequestEditorFn) (*TreasuryRatesGetClientResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r HistoricalChart15MinGetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type AnalystEstimatesGetClientResponse struct {
+type HistoricalDowJonesConstituentGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]FinancialEstimates
+	JSON200      *[]HistoricalIndexConstituent
 }
 
 // Status returns HTTPResponse.Status
-func (r AnalystEstimatesGetClientResponse) Status() string {
+func (r HistoricalDowJonesConstituentGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6709,21 +10351,21 @@ func (r AnalystEstimatesGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AnalystEstimatesGetClientResponse) StatusCode() int {
+func (r HistoricalDowJonesConstituentGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AvailableExchangesGetClientResponse struct {
+type HistoricalNasdaqConstituentGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Exchange
+	JSON200      *[]HistoricalIndexConstituent
 }
 
 // Status returns HTTPResponse.Status
-func (r AvailableExchangesGetClientResponse) Status() string {
+func (r HistoricalNasdaqConstituentGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6731,21 +10373,21 @@ func (r AvailableExchangesGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AvailableExchangesGetClientResponse) StatusCode() int {
+func (r HistoricalNasdaqConstituentGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type BalanceSheetStatementGetClientResponse struct {
+type HistoricalPriceEodFullGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]BalanceSheetStatement
+	JSON200      *[]FullCandle
 }
 
 // Status returns HTTPResponse.Status
-func (r BalanceSheetStatementGetClientResponse) Status() string {
+func (r HistoricalPriceEodFullGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6753,20 +10395,21 @@ func (r BalanceSheetStatementGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r BalanceSheetStatementGetClientResponse) StatusCode() int {
+func (r HistoricalPriceEodFullGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type BalanceSheetStatementBulkGetClientResponse struct {
+type HistoricalPriceEodLightGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *[]LightCandle
 }
 
 // Status returns HTTPResponse.Status
-func (r BalanceSheetStatementBulkGetClientResponse) Status() string {
+func (r HistoricalPriceEodLightGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6774,21 +10417,21 @@ func (r BalanceSheetStatementBulkGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r BalanceSheetStatementBulkGetClientResponse) StatusCode() int {
+func (r HistoricalPriceEodLightGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type BalanceSheetStatementTTMGetClientResponse struct {
+type HistoricalSP500ConstituentGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]BalanceSheetStatementTTM
+	JSON200      *[]HistoricalIndexConstituent
 }
 
 // Status returns HTTPResponse.Status
-func (r BalanceSheetStatementTTMGetClientResponse) Status() string {
+func (r HistoricalSP500ConstituentGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6796,21 +10439,21 @@ func (r BalanceSheetStatementTTMGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r BalanceSheetStatementTTMGetClientResponse) StatusCode() int {
+func (r HistoricalSP500ConstituentGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type BatchIndexQuotesGetClientResponse struct {
+type HolidaysByExchangeGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]ShortQuote
+	JSON200      *[]ExchangeHoliday
 }
 
 // Status returns HTTPResponse.Status
-func (r BatchIndexQuotesGetClientResponse) Status() string {
+func (r HolidaysByExchangeGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6818,21 +10461,21 @@ func (r BatchIndexQuotesGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r BatchIndexQuotesGetClientResponse) StatusCode() int {
+func (r HolidaysByExchangeGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type BatchQuoteGetClientResponse struct {
+type IncomeStatementGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]FullQuote
+	JSON200      *[]IncomeStatement
 }
 
 // Status returns HTTPResponse.Status
-func (r BatchQuoteGetClientResponse) Status() string {
+func (r IncomeStatementGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6840,21 +10483,20 @@ func (r BatchQuoteGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r BatchQuoteGetClientResponse) StatusCode() int {
+func (r IncomeStatementGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type BatchQuoteShortGetClientResponse struct {
+type IncomeStatementBulkGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]ShortQuote
 }
 
 // Status returns HTTPResponse.Status
-func (r BatchQuoteShortGetClientResponse) Status() string {
+func (r IncomeStatementBulkGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6862,21 +10504,21 @@ func (r BatchQuoteShortGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r BatchQuoteShortGetClientResponse) StatusCode() int {
+func (r IncomeStatementBulkGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CashFlowStatementGetClientResponse struct {
+type IncomeStatementTTMGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]CashFlowStatement
+	JSON200      *[]IncomeStatementTTM
 }
 
 // Status returns HTTPResponse.Status
-func (r CashFlowStatementGetClientResponse) Status() string {
+func (r IncomeStatementTTMGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6884,20 +10526,21 @@ func (r CashFlowStatementGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CashFlowStatementGetClientResponse) StatusCode() int {
+func (r IncomeStatementTTMGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CashFlowStatementBulkGetClientResponse struct {
+type IndexConstituentListGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *[]IndexConstituent
 }
 
 // Status returns HTTPResponse.Status
-func (r CashFlowStatementBulkGetClientResponse) Status() string {
+func (r IndexConstituentListGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6905,21 +10548,21 @@ func (r CashFlowStatementBulkGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CashFlowStatementBulkGetClientResponse) StatusCode() int {
+func (r IndexConstituentListGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CashFlowStatementTTMGetClientResponse struct {
+type IndexListGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]CashFlowStatementTTM
+	JSON200      *[]Index
 }
 
 // Status returns HTTPResponse.Status
-func (r CashFlowStatementTTMGetClientResponse) Status() string {
+func (r IndexListGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6927,21 +10570,21 @@ func (r CashFlowStatementTTMGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CashFlowStatementTTMGetClientResponse) StatusCode() int {
+func (r IndexListGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CommoditiesListGetClientResponse struct {
+type InsiderTradingLatestGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]CommoditySymbol
+	JSON200      *[]InsiderTransaction
 }
 
 // Status returns HTTPResponse.Status
-func (r CommoditiesListGetClientResponse) Status() string {
+func (r InsiderTradingLatestGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6949,21 +10592,21 @@ func (r CommoditiesListGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CommoditiesListGetClientResponse) StatusCode() int {
+func (r InsiderTradingLatestGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DcfBulkGetClientResponse struct {
+type InsiderTradingSearchGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]DCF
+	JSON200      *[]InsiderTransaction
 }
 
 // Status returns HTTPResponse.Status
-func (r DcfBulkGetClientResponse) Status() string {
+func (r InsiderTradingSearchGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6971,21 +10614,21 @@ func (r DcfBulkGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DcfBulkGetClientResponse) StatusCode() int {
+func (r InsiderTradingSearchGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DelistedCompaniesClientResponse struct {
+type KeyMetricsGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]DelistedCompany
+	JSON200      *[]KeyMetrics
 }
 
 // Status returns HTTPResponse.Status
-func (r DelistedCompaniesClientResponse) Status() string {
+func (r KeyMetricsGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -6993,21 +10636,21 @@ func (r DelistedCompaniesClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DelistedCompaniesClientResponse) StatusCode() int {
+func (r KeyMetricsGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DividendsGetClientResponse struct {
+type KeyMetricsTTMGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]DividendEvent
+	JSON200      *[]KeyMetricsTTM
 }
 
 // Status returns HTTPResponse.Status
-func (r DividendsGetClientResponse) Status() string {
+func (r KeyMetricsTTMGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7015,21 +10658,21 @@ func (r DividendsGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DividendsGetClientResponse) StatusCode() int {
+func (r KeyMetricsTTMGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DividendsCalendarGetClientResponse struct {
+type KeyMetricsTTMBulkGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]DividendEvent
+	JSON200      *[]KeyMetricsTTM
 }
 
 // Status returns HTTPResponse.Status
-func (r DividendsCalendarGetClientResponse) Status() string {
+func (r KeyMetricsTTMBulkGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7037,21 +10680,21 @@ func (r DividendsCalendarGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DividendsCalendarGetClientResponse) StatusCode() int {
+func (r KeyMetricsTTMBulkGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EarningsGetClientResponse struct {
+type LeveredDiscountedCashFlowGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]EarningEvent
+	JSON200      *[]DiscountedCashFlow
 }
 
 // Status returns HTTPResponse.Status
-func (r EarningsGetClientResponse) Status() string {
+func (r LeveredDiscountedCashFlowGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7059,21 +10702,21 @@ func (r EarningsGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EarningsGetClientResponse) StatusCode() int {
+func (r LeveredDiscountedCashFlowGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetEarningsCalendarClientResponse struct {
+type MarketCapitalizationGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]EarningEvent
+	JSON200      *[]CompanyCapitalization
 }
 
 // Status returns HTTPResponse.Status
-func (r GetEarningsCalendarClientResponse) Status() string {
+func (r MarketCapitalizationGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7081,21 +10724,21 @@ func (r GetEarningsCalendarClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetEarningsCalendarClientResponse) StatusCode() int {
+func (r MarketCapitalizationGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EconomicCalendarGetClientResponse struct {
+type MarketCapitalizationBatchGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]EconomicEvent
+	JSON200      *[]CompanyCapitalization
 }
 
 // Status returns HTTPResponse.Status
-func (r EconomicCalendarGetClientResponse) Status() string {
+func (r MarketCapitalizationBatchGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7103,21 +10746,21 @@ func (r EconomicCalendarGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EconomicCalendarGetClientResponse) StatusCode() int {
+func (r MarketCapitalizationBatchGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EnterpriseValueGetClientResponse struct {
+type NasdaqConstituentGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]EnterpriseValue
+	JSON200      *[]IndexConstituent
 }
 
 // Status returns HTTPResponse.Status
-func (r EnterpriseValueGetClientResponse) Status() string {
+func (r NasdaqConstituentGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7125,21 +10768,21 @@ func (r EnterpriseValueGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EnterpriseValueGetClientResponse) StatusCode() int {
+func (r NasdaqConstituentGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EsgDisclosuresGetClientResponse struct {
+type NewsGeneralLatestGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]EconomicEsgDisclosure
+	JSON200      *[]NewsArticle
 }
 
 // Status returns HTTPResponse.Status
-func (r EsgDisclosuresGetClientResponse) Status() string {
+func (r NewsGeneralLatestGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7147,21 +10790,21 @@ func (r EsgDisclosuresGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EsgDisclosuresGetClientResponse) StatusCode() int {
+func (r NewsGeneralLatestGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EsgRatingsGetClientResponse struct {
+type NewsStockLatestGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]EconomicEsgRating
+	JSON200      *[]NewsArticle
 }
 
 // Status returns HTTPResponse.Status
-func (r EsgRatingsGetClientResponse) Status() string {
+func (r NewsStockLatestGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7169,21 +10812,21 @@ func (r EsgRatingsGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EsgRatingsGetClientResponse) StatusCode() int {
+func (r NewsStockLatestGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ETFListGetClientResponse struct {
+type PriceTargetConsensusGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]ETFSymbol
+	JSON200      *[]PriceTargetConsensus
 }
 
 // Status returns HTTPResponse.Status
-func (r ETFListGetClientResponse) Status() string {
+func (r PriceTargetConsensusGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7191,21 +10834,21 @@ func (r ETFListGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ETFListGetClientResponse) StatusCode() int {
+func (r PriceTargetConsensusGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ETFCountryWeightingsGetClientResponse struct {
+type PriceTargetLatestNewsGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]ETFCountryWeight
+	JSON200      *[]PriceTargetNews
 }
 
 // Status returns HTTPResponse.Status
-func (r ETFCountryWeightingsGetClientResponse) Status() string {
+func (r PriceTargetLatestNewsGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7213,21 +10856,21 @@ func (r ETFCountryWeightingsGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ETFCountryWeightingsGetClientResponse) StatusCode() int {
+func (r PriceTargetLatestNewsGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ETFHoldingsGetClientResponse struct {
+type PriceTargetNewsGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]ETFHolding
+	JSON200      *[]PriceTargetNews
 }
 
 // Status returns HTTPResponse.Status
-func (r ETFHoldingsGetClientResponse) Status() string {
+func (r PriceTargetNewsGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7235,21 +10878,21 @@ func (r ETFHoldingsGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ETFHoldingsGetClientResponse) StatusCode() int {
+func (r PriceTargetNewsGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ETFInfoGetClientResponse struct {
+type PriceTargetSummaryGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]ETFProfile
+	JSON200      *[]PriceTargetSummary
 }
 
 // Status returns HTTPResponse.Status
-func (r ETFInfoGetClientResponse) Status() string {
+func (r PriceTargetSummaryGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7257,21 +10900,22 @@ func (r ETFInfoGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ETFInfoGetClientResponse) StatusCode() int {
+func (r PriceTargetSummaryGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ETFSectorWeightingsGetClientResponse struct {
+type ProfileGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]ETFSectorWeight
+	JSON200      *ProfileGetResponse
+	JSON401      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r ETFSectorWeightingsGetClientResponse) Status() string {
+func (r ProfileGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7279,21 +10923,21 @@ func (r ETFSectorWeightingsGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ETFSectorWeightingsGetClientResponse) StatusCode() int {
+func (r ProfileGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ForexCurrencyPairsGetClientResponse struct {
+type ProfileBulkGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]ForexCurrencyPair
+	JSON200      *[]CompanyProfile
 }
 
 // Status returns HTTPResponse.Status
-func (r ForexCurrencyPairsGetClientResponse) Status() string {
+func (r ProfileBulkGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7301,21 +10945,21 @@ func (r ForexCurrencyPairsGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ForexCurrencyPairsGetClientResponse) StatusCode() int {
+func (r ProfileBulkGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GradesLatestNewsGetClientResponse struct {
+type QuoteGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]GradeNews
+	JSON200      *[]FullQuote
 }
 
 // Status returns HTTPResponse.Status
-func (r GradesLatestNewsGetClientResponse) Status() string {
+func (r QuoteGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7323,21 +10967,21 @@ func (r GradesLatestNewsGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GradesLatestNewsGetClientResponse) StatusCode() int {
+func (r QuoteGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type HistoricalChart15MinGetClientResponse struct {
+type QuoteShortGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]DetailedCandle
+	JSON200      *[]ShortQuote
 }
 
 // Status returns HTTPResponse.Status
-func (r HistoricalChart15MinGetClientResponse) Status() string {
+func (r QuoteShortGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7345,21 +10989,21 @@ func (r HistoricalChart15MinGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r HistoricalChart15MinGetClientResponse) StatusCode() int {
+func (r QuoteShortGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type HistoricalPriceEodFullGetClientResponse struct {
+type RatingBulkGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]FullCandle
+	JSON200      *[]StockRating
 }
 
 // Status returns HTTPResponse.Status
-func (r HistoricalPriceEodFullGetClientResponse) Status() string {
+func (r RatingBulkGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7367,21 +11011,21 @@ func (r HistoricalPriceEodFullGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r HistoricalPriceEodFullGetClientResponse) StatusCode() int {
+func (r RatingBulkGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type HistoricalPriceEodLightGetClientResponse struct {
+type RatingsSnapshotGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]LightCandle
+	JSON200      *[]StockRating
 }
 
 // Status returns HTTPResponse.Status
-func (r HistoricalPriceEodLightGetClientResponse) Status() string {
+func (r RatingsSnapshotGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7389,21 +11033,21 @@ func (r HistoricalPriceEodLightGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r HistoricalPriceEodLightGetClientResponse) StatusCode() int {
+func (r RatingsSnapshotGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type IncomeStatementGetClientResponse struct {
+type RatiosGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]IncomeStatement
+	JSON200      *[]FinancialRatios
 }
 
 // Status returns HTTPResponse.Status
-func (r IncomeStatementGetClientResponse) Status() string {
+func (r RatiosGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7411,20 +11055,21 @@ func (r IncomeStatementGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r IncomeStatementGetClientResponse) StatusCode() int {
+func (r RatiosGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type IncomeStatementBulkGetClientResponse struct {
+type RatiosTTMGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *[]RatiosTTM
 }
 
 // Status returns HTTPResponse.Status
-func (r IncomeStatementBulkGetClientResponse) Status() string {
+func (r RatiosTTMGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7432,21 +11077,21 @@ func (r IncomeStatementBulkGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r IncomeStatementBulkGetClientResponse) StatusCode() int {
+func (r RatiosTTMGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type IncomeStatementTTMGetClientResponse struct {
+type RatiosTTMBulkGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]IncomeStatementTTM
+	JSON200      *[]RatiosTTM
 }
 
 // Status returns HTTPResponse.Status
-func (r IncomeStatementTTMGetClientResponse) Status() string {
+func (r RatiosTTMBulkGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7454,21 +11099,21 @@ func (r IncomeStatementTTMGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r IncomeStatementTTMGetClientResponse) StatusCode() int {
+func (r RatiosTTMBulkGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type IndexConstituentListGetClientResponse struct {
+type RevenueGeographicSegmentationGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]IndexConstituent
+	JSON200      *[]RevenueSegmentation
 }
 
 // Status returns HTTPResponse.Status
-func (r IndexConstituentListGetClientResponse) Status() string {
+func (r RevenueGeographicSegmentationGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7476,21 +11121,21 @@ func (r IndexConstituentListGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r IndexConstituentListGetClientResponse) StatusCode() int {
+func (r RevenueGeographicSegmentationGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type IndexListGetClientResponse struct {
+type RevenueProductSegmentationGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]Index
+	JSON200      *[]RevenueSegmentation
 }
 
 // Status returns HTTPResponse.Status
-func (r IndexListGetClientResponse) Status() string {
+func (r RevenueProductSegmentationGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7498,21 +11143,21 @@ func (r IndexListGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r IndexListGetClientResponse) StatusCode() int {
+func (r RevenueProductSegmentationGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type InsiderTradingLatestGetClientResponse struct {
+type SearchCIKGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]InsiderTransaction
+	JSON200      *[]CIKSearchResult
 }
 
 // Status returns HTTPResponse.Status
-func (r InsiderTradingLatestGetClientResponse) Status() string {
+func (r SearchCIKGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7520,21 +11165,21 @@ func (r InsiderTradingLatestGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r InsiderTradingLatestGetClientResponse) StatusCode() int {
+func (r SearchCIKGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type InsiderTradingSearchGetClientResponse struct {
+type SearchCUSIPGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]InsiderTransaction
+	JSON200      *[]CUSIPSearchResult
 }
 
 // Status returns HTTPResponse.Status
-func (r InsiderTradingSearchGetClientResponse) Status() string {
+func (r SearchCUSIPGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7542,21 +11187,21 @@ func (r InsiderTradingSearchGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r InsiderTradingSearchGetClientResponse) StatusCode() int {
+func (r SearchCUSIPGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type KeyMetricsGetClientResponse struct {
+type SearchISINGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]KeyMetrics
+	JSON200      *[]ISINSearchResult
 }
 
 // Status returns HTTPResponse.Status
-func (r KeyMetricsGetClientResponse) Status() string {
+func (r SearchISINGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7564,21 +11209,21 @@ func (r KeyMetricsGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r KeyMetricsGetClientResponse) StatusCode() int {
+func (r SearchISINGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type KeyMetricsTTMGetClientResponse struct {
+type SearchNameGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]KeyMetricsTTM
+	JSON200      *[]SearchSymbol
 }
 
 // Status returns HTTPResponse.Status
-func (r KeyMetricsTTMGetClientResponse) Status() string {
+func (r SearchNameGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7586,21 +11231,21 @@ func (r KeyMetricsTTMGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r KeyMetricsTTMGetClientResponse) StatusCode() int {
+func (r SearchNameGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type KeyMetricsTTMBulkGetClientResponse struct {
+type SearchSymbolGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]KeyMetricsTTM
+	JSON200      *[]SearchSymbol
 }
 
 // Status returns HTTPResponse.Status
-func (r KeyMetricsTTMBulkGetClientResponse) Status() string {
+func (r SearchSymbolGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7608,21 +11253,21 @@ func (r KeyMetricsTTMBulkGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r KeyMetricsTTMBulkGetClientResponse) StatusCode() int {
+func (r SearchSymbolGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type MarketCapitalizationGetClientResponse struct {
+type SharesFloatGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]CompanyCapitalization
+	JSON200      *[]CompanySharesFloat
 }
 
 // Status returns HTTPResponse.Status
-func (r MarketCapitalizationGetClientResponse) Status() string {
+func (r SharesFloatGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7630,21 +11275,21 @@ func (r MarketCapitalizationGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r MarketCapitalizationGetClientResponse) StatusCode() int {
+func (r SharesFloatGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type MarketCapitalizationBatchGetClientResponse struct {
+type SP500ConstituentGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]CompanyCapitalization
+	JSON200      *[]IndexConstituent
 }
 
 // Status returns HTTPResponse.Status
-func (r MarketCapitalizationBatchGetClientResponse) Status() string {
+func (r SP500ConstituentGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7652,21 +11297,21 @@ func (r MarketCapitalizationBatchGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r MarketCapitalizationBatchGetClientResponse) StatusCode() int {
+func (r SP500ConstituentGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type NewsGeneralLatestGetClientResponse struct {
+type GetSplitsClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]NewsArticle
+	JSON200      *[]SplitEvent
 }
 
 // Status returns HTTPResponse.Status
-func (r NewsGeneralLatestGetClientResponse) Status() string {
+func (r GetSplitsClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7674,21 +11319,21 @@ func (r NewsGeneralLatestGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r NewsGeneralLatestGetClientResponse) StatusCode() int {
+func (r GetSplitsClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type NewsStockLatestGetClientResponse struct {
+type GetSplitsCalendarClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]NewsArticle
+	JSON200      *[]SplitEvent
 }
 
 // Status returns HTTPResponse.Status
-func (r NewsStockLatestGetClientResponse) Status() string {
+func (r GetSplitsCalendarClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7696,22 +11341,21 @@ func (r NewsStockLatestGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r NewsStockLatestGetClientResponse) StatusCode() int {
+func (r GetSplitsCalendarClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ProfileGetClientResponse struct {
+type StockListGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ProfileGetResponse
-	JSON401      *ErrorResponse
+	JSON200      *[]CompanySymbol
 }
 
 // Status returns HTTPResponse.Status
-func (r ProfileGetClientResponse) Status() string {
+func (r StockListGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7719,21 +11363,21 @@ func (r ProfileGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ProfileGetClientResponse) StatusCode() int {
+func (r StockListGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ProfileBulkGetClientResponse struct {
+type SymbolChangeGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]CompanyProfile
+	JSON200      *[]SymbolChange
 }
 
 // Status returns HTTPResponse.Status
-func (r ProfileBulkGetClientResponse) Status() string {
+func (r SymbolChangeGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7741,21 +11385,21 @@ func (r ProfileBulkGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ProfileBulkGetClientResponse) StatusCode() int {
+func (r SymbolChangeGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type QuoteGetClientResponse struct {
+type TechnicalIndicatorsRsiGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]FullQuote
+	JSON200      *[]TechnicalIndicator
 }
 
 // Status returns HTTPResponse.Status
-func (r QuoteGetClientResponse) Status() string {
+func (r TechnicalIndicatorsRsiGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7763,21 +11407,21 @@ func (r QuoteGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r QuoteGetClientResponse) StatusCode() int {
+func (r TechnicalIndicatorsRsiGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type QuoteShortGetClientResponse struct {
+type TreasuryRatesGetClientResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *[]ShortQuote
+	JSON200      *[]TreasuryRates
 }
 
 // Status returns HTTPResponse.Status
-func (r QuoteShortGetClientResponse) Status() string {
+func (r TreasuryRatesGetClientResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7785,944 +11429,1183 @@ func (r QuoteShortGetClientResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r QuoteShortGetClientResponse) StatusCode() int {
+func (r TreasuryRatesGetClientResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type RatingBulkGetClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]StockRating
+// AllExchangeMarketHoursGetWithResponse request returning *AllExchangeMarketHoursGetClientResponse
+func (c *ClientWithResponses) AllExchangeMarketHoursGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AllExchangeMarketHoursGetClientResponse, error) {
+	rsp, err := c.AllExchangeMarketHoursGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAllExchangeMarketHoursGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r RatingBulkGetClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// AnalystEstimatesGetWithResponse request returning *AnalystEstimatesGetClientResponse
+func (c *ClientWithResponses) AnalystEstimatesGetWithResponse(ctx context.Context, params *AnalystEstimatesGetParams, reqEditors ...RequestEditorFn) (*AnalystEstimatesGetClientResponse, error) {
+	rsp, err := c.AnalystEstimatesGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnalystEstimatesGetClientResponse(rsp)
+}
+
+// AvailableExchangesGetWithResponse request returning *AvailableExchangesGetClientResponse
+func (c *ClientWithResponses) AvailableExchangesGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AvailableExchangesGetClientResponse, error) {
+	rsp, err := c.AvailableExchangesGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAvailableExchangesGetClientResponse(rsp)
+}
+
+// BalanceSheetStatementGetWithResponse request returning *BalanceSheetStatementGetClientResponse
+func (c *ClientWithResponses) BalanceSheetStatementGetWithResponse(ctx context.Context, params *BalanceSheetStatementGetParams, reqEditors ...RequestEditorFn) (*BalanceSheetStatementGetClientResponse, error) {
+	rsp, err := c.BalanceSheetStatementGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBalanceSheetStatementGetClientResponse(rsp)
+}
+
+// BalanceSheetStatementBulkGetWithResponse request returning *BalanceSheetStatementBulkGetClientResponse
+func (c *ClientWithResponses) BalanceSheetStatementBulkGetWithResponse(ctx context.Context, params *BalanceSheetStatementBulkGetParams, reqEditors ...RequestEditorFn) (*BalanceSheetStatementBulkGetClientResponse, error) {
+	rsp, err := c.BalanceSheetStatementBulkGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBalanceSheetStatementBulkGetClientResponse(rsp)
+}
+
+// BalanceSheetStatementTTMGetWithResponse request returning *BalanceSheetStatementTTMGetClientResponse
+func (c *ClientWithResponses) BalanceSheetStatementTTMGetWithResponse(ctx context.Context, params *BalanceSheetStatementTTMGetParams, reqEditors ...RequestEditorFn) (*BalanceSheetStatementTTMGetClientResponse, error) {
+	rsp, err := c.BalanceSheetStatementTTMGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBalanceSheetStatementTTMGetClientResponse(rsp)
+}
+
+// BatchIndexQuotesGetWithResponse request returning *BatchIndexQuotesGetClientResponse
+func (c *ClientWithResponses) BatchIndexQuotesGetWithResponse(ctx context.Context, params *BatchIndexQuotesGetParams, reqEditors ...RequestEditorFn) (*BatchIndexQuotesGetClientResponse, error) {
+	rsp, err := c.BatchIndexQuotesGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchIndexQuotesGetClientResponse(rsp)
+}
+
+// BatchQuoteGetWithResponse request returning *BatchQuoteGetClientResponse
+func (c *ClientWithResponses) BatchQuoteGetWithResponse(ctx context.Context, params *BatchQuoteGetParams, reqEditors ...RequestEditorFn) (*BatchQuoteGetClientResponse, error) {
+	rsp, err := c.BatchQuoteGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchQuoteGetClientResponse(rsp)
+}
+
+// BatchQuoteShortGetWithResponse request returning *BatchQuoteShortGetClientResponse
+func (c *ClientWithResponses) BatchQuoteShortGetWithResponse(ctx context.Context, params *BatchQuoteShortGetParams, reqEditors ...RequestEditorFn) (*BatchQuoteShortGetClientResponse, error) {
+	rsp, err := c.BatchQuoteShortGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchQuoteShortGetClientResponse(rsp)
+}
+
+// CashFlowStatementGetWithResponse request returning *CashFlowStatementGetClientResponse
+func (c *ClientWithResponses) CashFlowStatementGetWithResponse(ctx context.Context, params *CashFlowStatementGetParams, reqEditors ...RequestEditorFn) (*CashFlowStatementGetClientResponse, error) {
+	rsp, err := c.CashFlowStatementGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCashFlowStatementGetClientResponse(rsp)
+}
+
+// CashFlowStatementBulkGetWithResponse request returning *CashFlowStatementBulkGetClientResponse
+func (c *ClientWithResponses) CashFlowStatementBulkGetWithResponse(ctx context.Context, params *CashFlowStatementBulkGetParams, reqEditors ...RequestEditorFn) (*CashFlowStatementBulkGetClientResponse, error) {
+	rsp, err := c.CashFlowStatementBulkGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCashFlowStatementBulkGetClientResponse(rsp)
+}
+
+// CashFlowStatementTTMGetWithResponse request returning *CashFlowStatementTTMGetClientResponse
+func (c *ClientWithResponses) CashFlowStatementTTMGetWithResponse(ctx context.Context, params *CashFlowStatementTTMGetParams, reqEditors ...RequestEditorFn) (*CashFlowStatementTTMGetClientResponse, error) {
+	rsp, err := c.CashFlowStatementTTMGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCashFlowStatementTTMGetClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RatingBulkGetClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CommoditiesListGetWithResponse request returning *CommoditiesListGetClientResponse
+func (c *ClientWithResponses) CommoditiesListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CommoditiesListGetClientResponse, error) {
+	rsp, err := c.CommoditiesListGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCommoditiesListGetClientResponse(rsp)
 }
 
-type RatingsSnapshotGetClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]StockRating
+// CompanyScreenerGetWithResponse request returning *CompanyScreenerGetClientResponse
+func (c *ClientWithResponses) CompanyScreenerGetWithResponse(ctx context.Context, params *CompanyScreenerGetParams, reqEditors ...RequestEditorFn) (*CompanyScreenerGetClientResponse, error) {
+	rsp, err := c.CompanyScreenerGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompanyScreenerGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r RatingsSnapshotGetClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CustomDiscountedCashFlowGetWithResponse request returning *CustomDiscountedCashFlowGetClientResponse
+func (c *ClientWithResponses) CustomDiscountedCashFlowGetWithResponse(ctx context.Context, params *CustomDiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*CustomDiscountedCashFlowGetClientResponse, error) {
+	rsp, err := c.CustomDiscountedCashFlowGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCustomDiscountedCashFlowGetClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RatingsSnapshotGetClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CustomLeveredDiscountedCashFlowGetWithResponse request returning *CustomLeveredDiscountedCashFlowGetClientResponse
+func (c *ClientWithResponses) CustomLeveredDiscountedCashFlowGetWithResponse(ctx context.Context, params *CustomLeveredDiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*CustomLeveredDiscountedCashFlowGetClientResponse, error) {
+	rsp, err := c.CustomLeveredDiscountedCashFlowGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCustomLeveredDiscountedCashFlowGetClientResponse(rsp)
 }
 
-type RatiosGetClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]FinancialRatios
+// DcfBulkGetWithResponse request returning *DcfBulkGetClientResponse
+func (c *ClientWithResponses) DcfBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DcfBulkGetClientResponse, error) {
+	rsp, err := c.DcfBulkGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDcfBulkGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r RatiosGetClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DelistedCompaniesWithResponse request returning *DelistedCompaniesClientResponse
+func (c *ClientWithResponses) DelistedCompaniesWithResponse(ctx context.Context, params *DelistedCompaniesParams, reqEditors ...RequestEditorFn) (*DelistedCompaniesClientResponse, error) {
+	rsp, err := c.DelistedCompanies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDelistedCompaniesClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RatiosGetClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DiscountedCashFlowGetWithResponse request returning *DiscountedCashFlowGetClientResponse
+func (c *ClientWithResponses) DiscountedCashFlowGetWithResponse(ctx context.Context, params *DiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*DiscountedCashFlowGetClientResponse, error) {
+	rsp, err := c.DiscountedCashFlowGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDiscountedCashFlowGetClientResponse(rsp)
 }
 
-type RatiosTTMGetClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]RatiosTTM
+// DividendsGetWithResponse request returning *DividendsGetClientResponse
+func (c *ClientWithResponses) DividendsGetWithResponse(ctx context.Context, params *DividendsGetParams, reqEditors ...RequestEditorFn) (*DividendsGetClientResponse, error) {
+	rsp, err := c.DividendsGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDividendsGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r RatiosTTMGetClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DividendsCalendarGetWithResponse request returning *DividendsCalendarGetClientResponse
+func (c *ClientWithResponses) DividendsCalendarGetWithResponse(ctx context.Context, params *DividendsCalendarGetParams, reqEditors ...RequestEditorFn) (*DividendsCalendarGetClientResponse, error) {
+	rsp, err := c.DividendsCalendarGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDividendsCalendarGetClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RatiosTTMGetClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DowJonesConstituentGetWithResponse request returning *DowJonesConstituentGetClientResponse
+func (c *ClientWithResponses) DowJonesConstituentGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DowJonesConstituentGetClientResponse, error) {
+	rsp, err := c.DowJonesConstituentGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDowJonesConstituentGetClientResponse(rsp)
 }
 
-type RatiosTTMBulkGetClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]RatiosTTM
+// EarningsGetWithResponse request returning *EarningsGetClientResponse
+func (c *ClientWithResponses) EarningsGetWithResponse(ctx context.Context, params *EarningsGetParams, reqEditors ...RequestEditorFn) (*EarningsGetClientResponse, error) {
+	rsp, err := c.EarningsGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEarningsGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r RatiosTTMBulkGetClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetEarningsCalendarWithResponse request returning *GetEarningsCalendarClientResponse
+func (c *ClientWithResponses) GetEarningsCalendarWithResponse(ctx context.Context, params *GetEarningsCalendarParams, reqEditors ...RequestEditorFn) (*GetEarningsCalendarClientResponse, error) {
+	rsp, err := c.GetEarningsCalendar(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetEarningsCalendarClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RatiosTTMBulkGetClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// EconomicCalendarGetWithResponse request returning *EconomicCalendarGetClientResponse
+func (c *ClientWithResponses) EconomicCalendarGetWithResponse(ctx context.Context, params *EconomicCalendarGetParams, reqEditors ...RequestEditorFn) (*EconomicCalendarGetClientResponse, error) {
+	rsp, err := c.EconomicCalendarGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEconomicCalendarGetClientResponse(rsp)
 }
 
-type RevenueGeographicSegmentationGetClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]RevenueSegmentation
+// EnterpriseValueGetWithResponse request returning *EnterpriseValueGetClientResponse
+func (c *ClientWithResponses) EnterpriseValueGetWithResponse(ctx context.Context, params *EnterpriseValueGetParams, reqEditors ...RequestEditorFn) (*EnterpriseValueGetClientResponse, error) {
+	rsp, err := c.EnterpriseValueGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnterpriseValueGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r RevenueGeographicSegmentationGetClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// EsgDisclosuresGetWithResponse request returning *EsgDisclosuresGetClientResponse
+func (c *ClientWithResponses) EsgDisclosuresGetWithResponse(ctx context.Context, params *EsgDisclosuresGetParams, reqEditors ...RequestEditorFn) (*EsgDisclosuresGetClientResponse, error) {
+	rsp, err := c.EsgDisclosuresGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseEsgDisclosuresGetClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RevenueGeographicSegmentationGetClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// EsgRatingsGetWithResponse request returning *EsgRatingsGetClientResponse
+func (c *ClientWithResponses) EsgRatingsGetWithResponse(ctx context.Context, params *EsgRatingsGetParams, reqEditors ...RequestEditorFn) (*EsgRatingsGetClientResponse, error) {
+	rsp, err := c.EsgRatingsGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEsgRatingsGetClientResponse(rsp)
 }
 
-type RevenueProductSegmentationGetClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]RevenueSegmentation
+// ETFListGetWithResponse request returning *ETFListGetClientResponse
+func (c *ClientWithResponses) ETFListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ETFListGetClientResponse, error) {
+	rsp, err := c.ETFListGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseETFListGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r RevenueProductSegmentationGetClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ETFCountryWeightingsGetWithResponse request returning *ETFCountryWeightingsGetClientResponse
+func (c *ClientWithResponses) ETFCountryWeightingsGetWithResponse(ctx context.Context, params *ETFCountryWeightingsGetParams, reqEditors ...RequestEditorFn) (*ETFCountryWeightingsGetClientResponse, error) {
+	rsp, err := c.ETFCountryWeightingsGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseETFCountryWeightingsGetClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RevenueProductSegmentationGetClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ETFHoldingsGetWithResponse request returning *ETFHoldingsGetClientResponse
+func (c *ClientWithResponses) ETFHoldingsGetWithResponse(ctx context.Context, params *ETFHoldingsGetParams, reqEditors ...RequestEditorFn) (*ETFHoldingsGetClientResponse, error) {
+	rsp, err := c.ETFHoldingsGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseETFHoldingsGetClientResponse(rsp)
 }
 
-type SearchNameGetClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]SearchSymbol
+// ETFInfoGetWithResponse request returning *ETFInfoGetClientResponse
+func (c *ClientWithResponses) ETFInfoGetWithResponse(ctx context.Context, params *ETFInfoGetParams, reqEditors ...RequestEditorFn) (*ETFInfoGetClientResponse, error) {
+	rsp, err := c.ETFInfoGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseETFInfoGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r SearchNameGetClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ETFSectorWeightingsGetWithResponse request returning *ETFSectorWeightingsGetClientResponse
+func (c *ClientWithResponses) ETFSectorWeightingsGetWithResponse(ctx context.Context, params *ETFSectorWeightingsGetParams, reqEditors ...RequestEditorFn) (*ETFSectorWeightingsGetClientResponse, error) {
+	rsp, err := c.ETFSectorWeightingsGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseETFSectorWeightingsGetClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SearchNameGetClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ExchangeMarketHoursGetWithResponse request returning *ExchangeMarketHoursGetClientResponse
+func (c *ClientWithResponses) ExchangeMarketHoursGetWithResponse(ctx context.Context, params *ExchangeMarketHoursGetParams, reqEditors ...RequestEditorFn) (*ExchangeMarketHoursGetClientResponse, error) {
+	rsp, err := c.ExchangeMarketHoursGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseExchangeMarketHoursGetClientResponse(rsp)
 }
 
-type SearchSymbolGetClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]SearchSymbol
+// FinancialScoresGetWithResponse request returning *FinancialScoresGetClientResponse
+func (c *ClientWithResponses) FinancialScoresGetWithResponse(ctx context.Context, params *FinancialScoresGetParams, reqEditors ...RequestEditorFn) (*FinancialScoresGetClientResponse, error) {
+	rsp, err := c.FinancialScoresGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFinancialScoresGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r SearchSymbolGetClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ForexCurrencyPairsGetWithResponse request returning *ForexCurrencyPairsGetClientResponse
+func (c *ClientWithResponses) ForexCurrencyPairsGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ForexCurrencyPairsGetClientResponse, error) {
+	rsp, err := c.ForexCurrencyPairsGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseForexCurrencyPairsGetClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SearchSymbolGetClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GradesGetWithResponse request returning *GradesGetClientResponse
+func (c *ClientWithResponses) GradesGetWithResponse(ctx context.Context, params *GradesGetParams, reqEditors ...RequestEditorFn) (*GradesGetClientResponse, error) {
+	rsp, err := c.GradesGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGradesGetClientResponse(rsp)
 }
 
-type SharesFloatGetClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]CompanySharesFloat
+// GradesHistoricalGetWithResponse request returning *GradesHistoricalGetClientResponse
+func (c *ClientWithResponses) GradesHistoricalGetWithResponse(ctx context.Context, params *GradesHistoricalGetParams, reqEditors ...RequestEditorFn) (*GradesHistoricalGetClientResponse, error) {
+	rsp, err := c.GradesHistoricalGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGradesHistoricalGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r SharesFloatGetClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GradesLatestNewsGetWithResponse request returning *GradesLatestNewsGetClientResponse
+func (c *ClientWithResponses) GradesLatestNewsGetWithResponse(ctx context.Context, params *GradesLatestNewsGetParams, reqEditors ...RequestEditorFn) (*GradesLatestNewsGetClientResponse, error) {
+	rsp, err := c.GradesLatestNewsGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGradesLatestNewsGetClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SharesFloatGetClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// HistoricalChart15MinGetWithResponse request returning *HistoricalChart15MinGetClientResponse
+func (c *ClientWithResponses) HistoricalChart15MinGetWithResponse(ctx context.Context, params *HistoricalChart15MinGetParams, reqEditors ...RequestEditorFn) (*HistoricalChart15MinGetClientResponse, error) {
+	rsp, err := c.HistoricalChart15MinGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseHistoricalChart15MinGetClientResponse(rsp)
 }
 
-type GetSplitsClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]SplitEvent
+// HistoricalDowJonesConstituentGetWithResponse request returning *HistoricalDowJonesConstituentGetClientResponse
+func (c *ClientWithResponses) HistoricalDowJonesConstituentGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HistoricalDowJonesConstituentGetClientResponse, error) {
+	rsp, err := c.HistoricalDowJonesConstituentGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHistoricalDowJonesConstituentGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetSplitsClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// HistoricalNasdaqConstituentGetWithResponse request returning *HistoricalNasdaqConstituentGetClientResponse
+func (c *ClientWithResponses) HistoricalNasdaqConstituentGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HistoricalNasdaqConstituentGetClientResponse, error) {
+	rsp, err := c.HistoricalNasdaqConstituentGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseHistoricalNasdaqConstituentGetClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSplitsClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// HistoricalPriceEodFullGetWithResponse request returning *HistoricalPriceEodFullGetClientResponse
+func (c *ClientWithResponses) HistoricalPriceEodFullGetWithResponse(ctx context.Context, params *HistoricalPriceEodFullGetParams, reqEditors ...RequestEditorFn) (*HistoricalPriceEodFullGetClientResponse, error) {
+	rsp, err := c.HistoricalPriceEodFullGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseHistoricalPriceEodFullGetClientResponse(rsp)
 }
 
-type GetSplitsCalendarClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]SplitEvent
+// HistoricalPriceEodLightGetWithResponse request returning *HistoricalPriceEodLightGetClientResponse
+func (c *ClientWithResponses) HistoricalPriceEodLightGetWithResponse(ctx context.Context, params *HistoricalPriceEodLightGetParams, reqEditors ...RequestEditorFn) (*HistoricalPriceEodLightGetClientResponse, error) {
+	rsp, err := c.HistoricalPriceEodLightGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHistoricalPriceEodLightGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetSplitsCalendarClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// HistoricalSP500ConstituentGetWithResponse request returning *HistoricalSP500ConstituentGetClientResponse
+func (c *ClientWithResponses) HistoricalSP500ConstituentGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HistoricalSP500ConstituentGetClientResponse, error) {
+	rsp, err := c.HistoricalSP500ConstituentGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseHistoricalSP500ConstituentGetClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSplitsCalendarClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// HolidaysByExchangeGetWithResponse request returning *HolidaysByExchangeGetClientResponse
+func (c *ClientWithResponses) HolidaysByExchangeGetWithResponse(ctx context.Context, params *HolidaysByExchangeGetParams, reqEditors ...RequestEditorFn) (*HolidaysByExchangeGetClientResponse, error) {
+	rsp, err := c.HolidaysByExchangeGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseHolidaysByExchangeGetClientResponse(rsp)
 }
 
-type StockListGetClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]CompanySymbol
+// IncomeStatementGetWithResponse request returning *IncomeStatementGetClientResponse
+func (c *ClientWithResponses) IncomeStatementGetWithResponse(ctx context.Context, params *IncomeStatementGetParams, reqEditors ...RequestEditorFn) (*IncomeStatementGetClientResponse, error) {
+	rsp, err := c.IncomeStatementGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIncomeStatementGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r StockListGetClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// IncomeStatementBulkGetWithResponse request returning *IncomeStatementBulkGetClientResponse
+func (c *ClientWithResponses) IncomeStatementBulkGetWithResponse(ctx context.Context, params *IncomeStatementBulkGetParams, reqEditors ...RequestEditorFn) (*IncomeStatementBulkGetClientResponse, error) {
+	rsp, err := c.IncomeStatementBulkGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseIncomeStatementBulkGetClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StockListGetClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// IncomeStatementTTMGetWithResponse request returning *IncomeStatementTTMGetClientResponse
+func (c *ClientWithResponses) IncomeStatementTTMGetWithResponse(ctx context.Context, params *IncomeStatementTTMGetParams, reqEditors ...RequestEditorFn) (*IncomeStatementTTMGetClientResponse, error) {
+	rsp, err := c.IncomeStatementTTMGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseIncomeStatementTTMGetClientResponse(rsp)
 }
 
-type TechnicalIndicatorsRsiGetClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]TechnicalIndicator
+// IndexConstituentListGetWithResponse request returning *IndexConstituentListGetClientResponse
+func (c *ClientWithResponses) IndexConstituentListGetWithResponse(ctx context.Context, params *IndexConstituentListGetParams, reqEditors ...RequestEditorFn) (*IndexConstituentListGetClientResponse, error) {
+	rsp, err := c.IndexConstituentListGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIndexConstituentListGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r TechnicalIndicatorsRsiGetClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// IndexListGetWithResponse request returning *IndexListGetClientResponse
+func (c *ClientWithResponses) IndexListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*IndexListGetClientResponse, error) {
+	rsp, err := c.IndexListGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseIndexListGetClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TechnicalIndicatorsRsiGetClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// InsiderTradingLatestGetWithResponse request returning *InsiderTradingLatestGetClientResponse
+func (c *ClientWithResponses) InsiderTradingLatestGetWithResponse(ctx context.Context, params *InsiderTradingLatestGetParams, reqEditors ...RequestEditorFn) (*InsiderTradingLatestGetClientResponse, error) {
+	rsp, err := c.InsiderTradingLatestGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseInsiderTradingLatestGetClientResponse(rsp)
 }
 
-type TreasuryRatesGetClientResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]TreasuryRates
+// InsiderTradingSearchGetWithResponse request returning *InsiderTradingSearchGetClientResponse
+func (c *ClientWithResponses) InsiderTradingSearchGetWithResponse(ctx context.Context, params *InsiderTradingSearchGetParams, reqEditors ...RequestEditorFn) (*InsiderTradingSearchGetClientResponse, error) {
+	rsp, err := c.InsiderTradingSearchGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInsiderTradingSearchGetClientResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r TreasuryRatesGetClientResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// KeyMetricsGetWithResponse request returning *KeyMetricsGetClientResponse
+func (c *ClientWithResponses) KeyMetricsGetWithResponse(ctx context.Context, params *KeyMetricsGetParams, reqEditors ...RequestEditorFn) (*KeyMetricsGetClientResponse, error) {
+	rsp, err := c.KeyMetricsGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseKeyMetricsGetClientResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TreasuryRatesGetClientResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// KeyMetricsTTMGetWithResponse request returning *KeyMetricsTTMGetClientResponse
+func (c *ClientWithResponses) KeyMetricsTTMGetWithResponse(ctx context.Context, params *KeyMetricsTTMGetParams, reqEditors ...RequestEditorFn) (*KeyMetricsTTMGetClientResponse, error) {
+	rsp, err := c.KeyMetricsTTMGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseKeyMetricsTTMGetClientResponse(rsp)
 }
 
-// AnalystEstimatesGetWithResponse request returning *AnalystEstimatesGetClientResponse
-func (c *ClientWithResponses) AnalystEstimatesGetWithResponse(ctx context.Context, params *AnalystEstimatesGetParams, reqEditors ...RequestEditorFn) (*AnalystEstimatesGetClientResponse, error) {
-	rsp, err := c.AnalystEstimatesGet(ctx, params, reqEditors...)
+// KeyMetricsTTMBulkGetWithResponse request returning *KeyMetricsTTMBulkGetClientResponse
+func (c *ClientWithResponses) KeyMetricsTTMBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*KeyMetricsTTMBulkGetClientResponse, error) {
+	rsp, err := c.KeyMetricsTTMBulkGet(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAnalystEstimatesGetClientResponse(rsp)
+	return ParseKeyMetricsTTMBulkGetClientResponse(rsp)
 }
 
-// AvailableExchangesGetWithResponse request returning *AvailableExchangesGetClientResponse
-func (c *ClientWithResponses) AvailableExchangesGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AvailableExchangesGetClientResponse, error) {
-	rsp, err := c.AvailableExchangesGet(ctx, reqEditors...)
+// LeveredDiscountedCashFlowGetWithResponse request returning *LeveredDiscountedCashFlowGetClientResponse
+func (c *ClientWithResponses) LeveredDiscountedCashFlowGetWithResponse(ctx context.Context, params *LeveredDiscountedCashFlowGetParams, reqEditors ...RequestEditorFn) (*LeveredDiscountedCashFlowGetClientResponse, error) {
+	rsp, err := c.LeveredDiscountedCashFlowGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseAvailableExchangesGetClientResponse(rsp)
+	return ParseLeveredDiscountedCashFlowGetClientResponse(rsp)
 }
 
-// BalanceSheetStatementGetWithResponse request returning *BalanceSheetStatementGetClientResponse
-func (c *ClientWithResponses) BalanceSheetStatementGetWithResponse(ctx context.Context, params *BalanceSheetStatementGetParams, reqEditors ...RequestEditorFn) (*BalanceSheetStatementGetClientResponse, error) {
-	rsp, err := c.BalanceSheetStatementGet(ctx, params, reqEditors...)
+// MarketCapitalizationGetWithResponse request returning *MarketCapitalizationGetClientResponse
+func (c *ClientWithResponses) MarketCapitalizationGetWithResponse(ctx context.Context, params *MarketCapitalizationGetParams, reqEditors ...RequestEditorFn) (*MarketCapitalizationGetClientResponse, error) {
+	rsp, err := c.MarketCapitalizationGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseBalanceSheetStatementGetClientResponse(rsp)
+	return ParseMarketCapitalizationGetClientResponse(rsp)
 }
 
-// BalanceSheetStatementBulkGetWithResponse request returning *BalanceSheetStatementBulkGetClientResponse
-func (c *ClientWithResponses) BalanceSheetStatementBulkGetWithResponse(ctx context.Context, params *BalanceSheetStatementBulkGetParams, reqEditors ...RequestEditorFn) (*BalanceSheetStatementBulkGetClientResponse, error) {
-	rsp, err := c.BalanceSheetStatementBulkGet(ctx, params, reqEditors...)
+// MarketCapitalizationBatchGetWithResponse request returning *MarketCapitalizationBatchGetClientResponse
+func (c *ClientWithResponses) MarketCapitalizationBatchGetWithResponse(ctx context.Context, params *MarketCapitalizationBatchGetParams, reqEditors ...RequestEditorFn) (*MarketCapitalizationBatchGetClientResponse, error) {
+	rsp, err := c.MarketCapitalizationBatchGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseBalanceSheetStatementBulkGetClientResponse(rsp)
+	return ParseMarketCapitalizationBatchGetClientResponse(rsp)
 }
 
-// BalanceSheetStatementTTMGetWithResponse request returning *BalanceSheetStatementTTMGetClientResponse
-func (c *ClientWithResponses) BalanceSheetStatementTTMGetWithResponse(ctx context.Context, params *BalanceSheetStatementTTMGetParams, reqEditors ...RequestEditorFn) (*BalanceSheetStatementTTMGetClientResponse, error) {
-	rsp, err := c.BalanceSheetStatementTTMGet(ctx, params, reqEditors...)
+// NasdaqConstituentGetWithResponse request returning *NasdaqConstituentGetClientResponse
+func (c *ClientWithResponses) NasdaqConstituentGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NasdaqConstituentGetClientResponse, error) {
+	rsp, err := c.NasdaqConstituentGet(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseBalanceSheetStatementTTMGetClientResponse(rsp)
+	return ParseNasdaqConstituentGetClientResponse(rsp)
 }
 
-// BatchIndexQuotesGetWithResponse request returning *BatchIndexQuotesGetClientResponse
-func (c *ClientWithResponses) BatchIndexQuotesGetWithResponse(ctx context.Context, params *BatchIndexQuotesGetParams, reqEditors ...RequestEditorFn) (*BatchIndexQuotesGetClientResponse, error) {
-	rsp, err := c.BatchIndexQuotesGet(ctx, params, reqEditors...)
+// NewsGeneralLatestGetWithResponse request returning *NewsGeneralLatestGetClientResponse
+func (c *ClientWithResponses) NewsGeneralLatestGetWithResponse(ctx context.Context, params *NewsGeneralLatestGetParams, reqEditors ...RequestEditorFn) (*NewsGeneralLatestGetClientResponse, error) {
+	rsp, err := c.NewsGeneralLatestGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseBatchIndexQuotesGetClientResponse(rsp)
+	return ParseNewsGeneralLatestGetClientResponse(rsp)
 }
 
-// BatchQuoteGetWithResponse request returning *BatchQuoteGetClientResponse
-func (c *ClientWithResponses) BatchQuoteGetWithResponse(ctx context.Context, params *BatchQuoteGetParams, reqEditors ...RequestEditorFn) (*BatchQuoteGetClientResponse, error) {
-	rsp, err := c.BatchQuoteGet(ctx, params, reqEditors...)
+// NewsStockLatestGetWithResponse request returning *NewsStockLatestGetClientResponse
+func (c *ClientWithResponses) NewsStockLatestGetWithResponse(ctx context.Context, params *NewsStockLatestGetParams, reqEditors ...RequestEditorFn) (*NewsStockLatestGetClientResponse, error) {
+	rsp, err := c.NewsStockLatestGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseBatchQuoteGetClientResponse(rsp)
+	return ParseNewsStockLatestGetClientResponse(rsp)
 }
 
-// BatchQuoteShortGetWithResponse request returning *BatchQuoteShortGetClientResponse
-func (c *ClientWithResponses) BatchQuoteShortGetWithResponse(ctx context.Context, params *BatchQuoteShortGetParams, reqEditors ...RequestEditorFn) (*BatchQuoteShortGetClientResponse, error) {
-	rsp, err := c.BatchQuoteShortGet(ctx, params, reqEditors...)
+// PriceTargetConsensusGetWithResponse request returning *PriceTargetConsensusGetClientResponse
+func (c *ClientWithResponses) PriceTargetConsensusGetWithResponse(ctx context.Context, params *PriceTargetConsensusGetParams, reqEditors ...RequestEditorFn) (*PriceTargetConsensusGetClientResponse, error) {
+	rsp, err := c.PriceTargetConsensusGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseBatchQuoteShortGetClientResponse(rsp)
+	return ParsePriceTargetConsensusGetClientResponse(rsp)
 }
 
-// CashFlowStatementGetWithResponse request returning *CashFlowStatementGetClientResponse
-func (c *ClientWithResponses) CashFlowStatementGetWithResponse(ctx context.Context, params *CashFlowStatementGetParams, reqEditors ...RequestEditorFn) (*CashFlowStatementGetClientResponse, error) {
-	rsp, err := c.CashFlowStatementGet(ctx, params, reqEditors...)
+// PriceTargetLatestNewsGetWithResponse request returning *PriceTargetLatestNewsGetClientResponse
+func (c *ClientWithResponses) PriceTargetLatestNewsGetWithResponse(ctx context.Context, params *PriceTargetLatestNewsGetParams, reqEditors ...RequestEditorFn) (*PriceTargetLatestNewsGetClientResponse, error) {
+	rsp, err := c.PriceTargetLatestNewsGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCashFlowStatementGetClientResponse(rsp)
+	return ParsePriceTargetLatestNewsGetClientResponse(rsp)
 }
 
-// CashFlowStatementBulkGetWithResponse request returning *CashFlowStatementBulkGetClientResponse
-func (c *ClientWithResponses) CashFlowStatementBulkGetWithResponse(ctx context.Context, params *CashFlowStatementBulkGetParams, reqEditors ...RequestEditorFn) (*CashFlowStatementBulkGetClientResponse, error) {
-	rsp, err := c.CashFlowStatementBulkGet(ctx, params, reqEditors...)
+// PriceTargetNewsGetWithResponse request returning *PriceTargetNewsGetClientResponse
+func (c *ClientWithResponses) PriceTargetNewsGetWithResponse(ctx context.Context, params *PriceTargetNewsGetParams, reqEditors ...RequestEditorFn) (*PriceTargetNewsGetClientResponse, error) {
+	rsp, err := c.PriceTargetNewsGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCashFlowStatementBulkGetClientResponse(rsp)
+	return ParsePriceTargetNewsGetClientResponse(rsp)
 }
 
-// CashFlowStatementTTMGetWithResponse request returning *CashFlowStatementTTMGetClientResponse
-func (c *ClientWithResponses) CashFlowStatementTTMGetWithResponse(ctx context.Context, params *CashFlowStatementTTMGetParams, reqEditors ...RequestEditorFn) (*CashFlowStatementTTMGetClientResponse, error) {
-	rsp, err := c.CashFlowStatementTTMGet(ctx, params, reqEditors...)
+// PriceTargetSummaryGetWithResponse request returning *PriceTargetSummaryGetClientResponse
+func (c *ClientWithResponses) PriceTargetSummaryGetWithResponse(ctx context.Context, params *PriceTargetSummaryGetParams, reqEditors ...RequestEditorFn) (*PriceTargetSummaryGetClientResponse, error) {
+	rsp, err := c.PriceTargetSummaryGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCashFlowStatementTTMGetClientResponse(rsp)
+	return ParsePriceTargetSummaryGetClientResponse(rsp)
 }
 
-// CommoditiesListGetWithResponse request returning *CommoditiesListGetClientResponse
-func (c *ClientWithResponses) CommoditiesListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CommoditiesListGetClientResponse, error) {
-	rsp, err := c.CommoditiesListGet(ctx, reqEditors...)
+// ProfileGetWithResponse request returning *ProfileGetClientResponse
+func (c *ClientWithResponses) ProfileGetWithResponse(ctx context.Context, params *ProfileGetParams, reqEditors ...RequestEditorFn) (*ProfileGetClientResponse, error) {
+	rsp, err := c.ProfileGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCommoditiesListGetClientResponse(rsp)
+	return ParseProfileGetClientResponse(rsp)
 }
 
-// DcfBulkGetWithResponse request returning *DcfBulkGetClientResponse
-func (c *ClientWithResponses) DcfBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DcfBulkGetClientResponse, error) {
-	rsp, err := c.DcfBulkGet(ctx, reqEditors...)
+// ProfileBulkGetWithResponse request returning *ProfileBulkGetClientResponse
+func (c *ClientWithResponses) ProfileBulkGetWithResponse(ctx context.Context, params *ProfileBulkGetParams, reqEditors ...RequestEditorFn) (*ProfileBulkGetClientResponse, error) {
+	rsp, err := c.ProfileBulkGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDcfBulkGetClientResponse(rsp)
+	return ParseProfileBulkGetClientResponse(rsp)
 }
 
-// DelistedCompaniesWithResponse request returning *DelistedCompaniesClientResponse
-func (c *ClientWithResponses) DelistedCompaniesWithResponse(ctx context.Context, params *DelistedCompaniesParams, reqEditors ...RequestEditorFn) (*DelistedCompaniesClientResponse, error) {
-	rsp, err := c.DelistedCompanies(ctx, params, reqEditors...)
+// QuoteGetWithResponse request returning *QuoteGetClientResponse
+func (c *ClientWithResponses) QuoteGetWithResponse(ctx context.Context, params *QuoteGetParams, reqEditors ...RequestEditorFn) (*QuoteGetClientResponse, error) {
+	rsp, err := c.QuoteGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDelistedCompaniesClientResponse(rsp)
+	return ParseQuoteGetClientResponse(rsp)
 }
 
-// DividendsGetWithResponse request returning *DividendsGetClientResponse
-func (c *ClientWithResponses) DividendsGetWithResponse(ctx context.Context, params *DividendsGetParams, reqEditors ...RequestEditorFn) (*DividendsGetClientResponse, error) {
-	rsp, err := c.DividendsGet(ctx, params, reqEditors...)
+// QuoteShortGetWithResponse request returning *QuoteShortGetClientResponse
+func (c *ClientWithResponses) QuoteShortGetWithResponse(ctx context.Context, params *QuoteShortGetParams, reqEditors ...RequestEditorFn) (*QuoteShortGetClientResponse, error) {
+	rsp, err := c.QuoteShortGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDividendsGetClientResponse(rsp)
+	return ParseQuoteShortGetClientResponse(rsp)
 }
 
-// DividendsCalendarGetWithResponse request returning *DividendsCalendarGetClientResponse
-func (c *ClientWithResponses) DividendsCalendarGetWithResponse(ctx context.Context, params *DividendsCalendarGetParams, reqEditors ...RequestEditorFn) (*DividendsCalendarGetClientResponse, error) {
-	rsp, err := c.DividendsCalendarGet(ctx, params, reqEditors...)
+// RatingBulkGetWithResponse request returning *RatingBulkGetClientResponse
+func (c *ClientWithResponses) RatingBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RatingBulkGetClientResponse, error) {
+	rsp, err := c.RatingBulkGet(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDividendsCalendarGetClientResponse(rsp)
+	return ParseRatingBulkGetClientResponse(rsp)
 }
 
-// EarningsGetWithResponse request returning *EarningsGetClientResponse
-func (c *ClientWithResponses) EarningsGetWithResponse(ctx context.Context, params *EarningsGetParams, reqEditors ...RequestEditorFn) (*EarningsGetClientResponse, error) {
-	rsp, err := c.EarningsGet(ctx, params, reqEditors...)
+// RatingsSnapshotGetWithResponse request returning *RatingsSnapshotGetClientResponse
+func (c *ClientWithResponses) RatingsSnapshotGetWithResponse(ctx context.Context, params *RatingsSnapshotGetParams, reqEditors ...RequestEditorFn) (*RatingsSnapshotGetClientResponse, error) {
+	rsp, err := c.RatingsSnapshotGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEarningsGetClientResponse(rsp)
+	return ParseRatingsSnapshotGetClientResponse(rsp)
 }
 
-// GetEarningsCalendarWithResponse request returning *GetEarningsCalendarClientResponse
-func (c *ClientWithResponses) GetEarningsCalendarWithResponse(ctx context.Context, params *GetEarningsCalendarParams, reqEditors ...RequestEditorFn) (*GetEarningsCalendarClientResponse, error) {
-	rsp, err := c.GetEarningsCalendar(ctx, params, reqEditors...)
+// RatiosGetWithResponse request returning *RatiosGetClientResponse
+func (c *ClientWithResponses) RatiosGetWithResponse(ctx context.Context, params *RatiosGetParams, reqEditors ...RequestEditorFn) (*RatiosGetClientResponse, error) {
+	rsp, err := c.RatiosGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetEarningsCalendarClientResponse(rsp)
+	return ParseRatiosGetClientResponse(rsp)
 }
 
-// EconomicCalendarGetWithResponse request returning *EconomicCalendarGetClientResponse
-func (c *ClientWithResponses) EconomicCalendarGetWithResponse(ctx context.Context, params *EconomicCalendarGetParams, reqEditors ...RequestEditorFn) (*EconomicCalendarGetClientResponse, error) {
-	rsp, err := c.EconomicCalendarGet(ctx, params, reqEditors...)
+// RatiosTTMGetWithResponse request returning *RatiosTTMGetClientResponse
+func (c *ClientWithResponses) RatiosTTMGetWithResponse(ctx context.Context, params *RatiosTTMGetParams, reqEditors ...RequestEditorFn) (*RatiosTTMGetClientResponse, error) {
+	rsp, err := c.RatiosTTMGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEconomicCalendarGetClientResponse(rsp)
+	return ParseRatiosTTMGetClientResponse(rsp)
 }
 
-// EnterpriseValueGetWithResponse request returning *EnterpriseValueGetClientResponse
-func (c *ClientWithResponses) EnterpriseValueGetWithResponse(ctx context.Context, params *EnterpriseValueGetParams, reqEditors ...RequestEditorFn) (*EnterpriseValueGetClientResponse, error) {
-	rsp, err := c.EnterpriseValueGet(ctx, params, reqEditors...)
+// RatiosTTMBulkGetWithResponse request returning *RatiosTTMBulkGetClientResponse
+func (c *ClientWithResponses) RatiosTTMBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RatiosTTMBulkGetClientResponse, error) {
+	rsp, err := c.RatiosTTMBulkGet(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEnterpriseValueGetClientResponse(rsp)
+	return ParseRatiosTTMBulkGetClientResponse(rsp)
 }
 
-// EsgDisclosuresGetWithResponse request returning *EsgDisclosuresGetClientResponse
-func (c *ClientWithResponses) EsgDisclosuresGetWithResponse(ctx context.Context, params *EsgDisclosuresGetParams, reqEditors ...RequestEditorFn) (*EsgDisclosuresGetClientResponse, error) {
-	rsp, err := c.EsgDisclosuresGet(ctx, params, reqEditors...)
+// RevenueGeographicSegmentationGetWithResponse request returning *RevenueGeographicSegmentationGetClientResponse
+func (c *ClientWithResponses) RevenueGeographicSegmentationGetWithResponse(ctx context.Context, params *RevenueGeographicSegmentationGetParams, reqEditors ...RequestEditorFn) (*RevenueGeographicSegmentationGetClientResponse, error) {
+	rsp, err := c.RevenueGeographicSegmentationGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEsgDisclosuresGetClientResponse(rsp)
+	return ParseRevenueGeographicSegmentationGetClientResponse(rsp)
 }
 
-// EsgRatingsGetWithResponse request returning *EsgRatingsGetClientResponse
-func (c *ClientWithResponses) EsgRatingsGetWithResponse(ctx context.Context, params *EsgRatingsGetParams, reqEditors ...RequestEditorFn) (*EsgRatingsGetClientResponse, error) {
-	rsp, err := c.EsgRatingsGet(ctx, params, reqEditors...)
+// RevenueProductSegmentationGetWithResponse request returning *RevenueProductSegmentationGetClientResponse
+func (c *ClientWithResponses) RevenueProductSegmentationGetWithResponse(ctx context.Context, params *RevenueProductSegmentationGetParams, reqEditors ...RequestEditorFn) (*RevenueProductSegmentationGetClientResponse, error) {
+	rsp, err := c.RevenueProductSegmentationGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseEsgRatingsGetClientResponse(rsp)
+	return ParseRevenueProductSegmentationGetClientResponse(rsp)
 }
 
-// ETFListGetWithResponse request returning *ETFListGetClientResponse
-func (c *ClientWithResponses) ETFListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ETFListGetClientResponse, error) {
-	rsp, err := c.ETFListGet(ctx, reqEditors...)
+// SearchCIKGetWithResponse request returning *SearchCIKGetClientResponse
+func (c *ClientWithResponses) SearchCIKGetWithResponse(ctx context.Context, params *SearchCIKGetParams, reqEditors ...RequestEditorFn) (*SearchCIKGetClientResponse, error) {
+	rsp, err := c.SearchCIKGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseETFListGetClientResponse(rsp)
+	return ParseSearchCIKGetClientResponse(rsp)
 }
 
-// ETFCountryWeightingsGetWithResponse request returning *ETFCountryWeightingsGetClientResponse
-func (c *ClientWithResponses) ETFCountryWeightingsGetWithResponse(ctx context.Context, params *ETFCountryWeightingsGetParams, reqEditors ...RequestEditorFn) (*ETFCountryWeightingsGetClientResponse, error) {
-	rsp, err := c.ETFCountryWeightingsGet(ctx, params, reqEditors...)
+// SearchCUSIPGetWithResponse request returning *SearchCUSIPGetClientResponse
+func (c *ClientWithResponses) SearchCUSIPGetWithResponse(ctx context.Context, params *SearchCUSIPGetParams, reqEditors ...RequestEditorFn) (*SearchCUSIPGetClientResponse, error) {
+	rsp, err := c.SearchCUSIPGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseETFCountryWeightingsGetClientResponse(rsp)
+	return ParseSearchCUSIPGetClientResponse(rsp)
 }
 
-// ETFHoldingsGetWithResponse request returning *ETFHoldingsGetClientResponse
-func (c *ClientWithResponses) ETFHoldingsGetWithResponse(ctx context.Context, params *ETFHoldingsGetParams, reqEditors ...RequestEditorFn) (*ETFHoldingsGetClientResponse, error) {
-	rsp, err := c.ETFHoldingsGet(ctx, params, reqEditors...)
+// SearchISINGetWithResponse request returning *SearchISINGetClientResponse
+func (c *ClientWithResponses) SearchISINGetWithResponse(ctx context.Context, params *SearchISINGetParams, reqEditors ...RequestEditorFn) (*SearchISINGetClientResponse, error) {
+	rsp, err := c.SearchISINGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseETFHoldingsGetClientResponse(rsp)
+	return ParseSearchISINGetClientResponse(rsp)
 }
 
-// ETFInfoGetWithResponse request returning *ETFInfoGetClientResponse
-func (c *ClientWithResponses) ETFInfoGetWithResponse(ctx context.Context, params *ETFInfoGetParams, reqEditors ...RequestEditorFn) (*ETFInfoGetClientResponse, error) {
-	rsp, err := c.ETFInfoGet(ctx, params, reqEditors...)
+// SearchNameGetWithResponse request returning *SearchNameGetClientResponse
+func (c *ClientWithResponses) SearchNameGetWithResponse(ctx context.Context, params *SearchNameGetParams, reqEditors ...RequestEditorFn) (*SearchNameGetClientResponse, error) {
+	rsp, err := c.SearchNameGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseETFInfoGetClientResponse(rsp)
+	return ParseSearchNameGetClientResponse(rsp)
 }
 
-// ETFSectorWeightingsGetWithResponse request returning *ETFSectorWeightingsGetClientResponse
-func (c *ClientWithResponses) ETFSectorWeightingsGetWithResponse(ctx context.Context, params *ETFSectorWeightingsGetParams, reqEditors ...RequestEditorFn) (*ETFSectorWeightingsGetClientResponse, error) {
-	rsp, err := c.ETFSectorWeightingsGet(ctx, params, reqEditors...)
+// SearchSymbolGetWithResponse request returning *SearchSymbolGetClientResponse
+func (c *ClientWithResponses) SearchSymbolGetWithResponse(ctx context.Context, params *SearchSymbolGetParams, reqEditors ...RequestEditorFn) (*SearchSymbolGetClientResponse, error) {
+	rsp, err := c.SearchSymbolGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseETFSectorWeightingsGetClientResponse(rsp)
+	return ParseSearchSymbolGetClientResponse(rsp)
 }
 
-// ForexCurrencyPairsGetWithResponse request returning *ForexCurrencyPairsGetClientResponse
-func (c *ClientWithResponses) ForexCurrencyPairsGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ForexCurrencyPairsGetClientResponse, error) {
-	rsp, err := c.ForexCurrencyPairsGet(ctx, reqEditors...)
+// SharesFloatGetWithResponse request returning *SharesFloatGetClientResponse
+func (c *ClientWithResponses) SharesFloatGetWithResponse(ctx context.Context, params *SharesFloatGetParams, reqEditors ...RequestEditorFn) (*SharesFloatGetClientResponse, error) {
+	rsp, err := c.SharesFloatGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseForexCurrencyPairsGetClientResponse(rsp)
+	return ParseSharesFloatGetClientResponse(rsp)
 }
 
-// GradesLatestNewsGetWithResponse request returning *GradesLatestNewsGetClientResponse
-func (c *ClientWithResponses) GradesLatestNewsGetWithResponse(ctx context.Context, params *GradesLatestNewsGetParams, reqEditors ...RequestEditorFn) (*GradesLatestNewsGetClientResponse, error) {
-	rsp, err := c.GradesLatestNewsGet(ctx, params, reqEditors...)
+// SP500ConstituentGetWithResponse request returning *SP500ConstituentGetClientResponse
+func (c *ClientWithResponses) SP500ConstituentGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SP500ConstituentGetClientResponse, error) {
+	rsp, err := c.SP500ConstituentGet(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGradesLatestNewsGetClientResponse(rsp)
+	return ParseSP500ConstituentGetClientResponse(rsp)
 }
 
-// HistoricalChart15MinGetWithResponse request returning *HistoricalChart15MinGetClientResponse
-func (c *ClientWithResponses) HistoricalChart15MinGetWithResponse(ctx context.Context, params *HistoricalChart15MinGetParams, reqEditors ...RequestEditorFn) (*HistoricalChart15MinGetClientResponse, error) {
-	rsp, err := c.HistoricalChart15MinGet(ctx, params, reqEditors...)
+// GetSplitsWithResponse request returning *GetSplitsClientResponse
+func (c *ClientWithResponses) GetSplitsWithResponse(ctx context.Context, params *GetSplitsParams, reqEditors ...RequestEditorFn) (*GetSplitsClientResponse, error) {
+	rsp, err := c.GetSplits(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseHistoricalChart15MinGetClientResponse(rsp)
+	return ParseGetSplitsClientResponse(rsp)
 }
 
-// HistoricalPriceEodFullGetWithResponse request returning *HistoricalPriceEodFullGetClientResponse
-func (c *ClientWithResponses) HistoricalPriceEodFullGetWithResponse(ctx context.Context, params *HistoricalPriceEodFullGetParams, reqEditors ...RequestEditorFn) (*HistoricalPriceEodFullGetClientResponse, error) {
-	rsp, err := c.HistoricalPriceEodFullGet(ctx, params, reqEditors...)
+// GetSplitsCalendarWithResponse request returning *GetSplitsCalendarClientResponse
+func (c *ClientWithResponses) GetSplitsCalendarWithResponse(ctx context.Context, params *GetSplitsCalendarParams, reqEditors ...RequestEditorFn) (*GetSplitsCalendarClientResponse, error) {
+	rsp, err := c.GetSplitsCalendar(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseHistoricalPriceEodFullGetClientResponse(rsp)
+	return ParseGetSplitsCalendarClientResponse(rsp)
 }
 
-// HistoricalPriceEodLightGetWithResponse request returning *HistoricalPriceEodLightGetClientResponse
-func (c *ClientWithResponses) HistoricalPriceEodLightGetWithResponse(ctx context.Context, params *HistoricalPriceEodLightGetParams, reqEditors ...RequestEditorFn) (*HistoricalPriceEodLightGetClientResponse, error) {
-	rsp, err := c.HistoricalPriceEodLightGet(ctx, params, reqEditors...)
+// StockListGetWithResponse request returning *StockListGetClientResponse
+func (c *ClientWithResponses) StockListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StockListGetClientResponse, error) {
+	rsp, err := c.StockListGet(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseHistoricalPriceEodLightGetClientResponse(rsp)
+	return ParseStockListGetClientResponse(rsp)
 }
 
-// IncomeStatementGetWithResponse request returning *IncomeStatementGetClientResponse
-func (c *ClientWithResponses) IncomeStatementGetWithResponse(ctx context.Context, params *IncomeStatementGetParams, reqEditors ...RequestEditorFn) (*IncomeStatementGetClientResponse, error) {
-	rsp, err := c.IncomeStatementGet(ctx, params, reqEditors...)
+// SymbolChangeGetWithResponse request returning *SymbolChangeGetClientResponse
+func (c *ClientWithResponses) SymbolChangeGetWithResponse(ctx context.Context, params *SymbolChangeGetParams, reqEditors ...RequestEditorFn) (*SymbolChangeGetClientResponse, error) {
+	rsp, err := c.SymbolChangeGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseIncomeStatementGetClientResponse(rsp)
+	return ParseSymbolChangeGetClientResponse(rsp)
 }
 
-// IncomeStatementBulkGetWithResponse request returning *IncomeStatementBulkGetClientResponse
-func (c *ClientWithResponses) IncomeStatementBulkGetWithResponse(ctx context.Context, params *IncomeStatementBulkGetParams, reqEditors ...RequestEditorFn) (*IncomeStatementBulkGetClientResponse, error) {
-	rsp, err := c.IncomeStatementBulkGet(ctx, params, reqEditors...)
+// TechnicalIndicatorsRsiGetWithResponse request returning *TechnicalIndicatorsRsiGetClientResponse
+func (c *ClientWithResponses) TechnicalIndicatorsRsiGetWithResponse(ctx context.Context, params *TechnicalIndicatorsRsiGetParams, reqEditors ...RequestEditorFn) (*TechnicalIndicatorsRsiGetClientResponse, error) {
+	rsp, err := c.TechnicalIndicatorsRsiGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseIncomeStatementBulkGetClientResponse(rsp)
+	return ParseTechnicalIndicatorsRsiGetClientResponse(rsp)
 }
 
-// IncomeStatementTTMGetWithResponse request returning *IncomeStatementTTMGetClientResponse
-func (c *ClientWithResponses) IncomeStatementTTMGetWithResponse(ctx context.Context, params *IncomeStatementTTMGetParams, reqEditors ...RequestEditorFn) (*IncomeStatementTTMGetClientResponse, error) {
-	rsp, err := c.IncomeStatementTTMGet(ctx, params, reqEditors...)
+// TreasuryRatesGetWithResponse request returning *TreasuryRatesGetClientResponse
+func (c *ClientWithResponses) TreasuryRatesGetWithResponse(ctx context.Context, params *TreasuryRatesGetParams, reqEditors ...RequestEditorFn) (*TreasuryRatesGetClientResponse, error) {
+	rsp, err := c.TreasuryRatesGet(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseIncomeStatementTTMGetClientResponse(rsp)
+	return ParseTreasuryRatesGetClientResponse(rsp)
 }
 
-// IndexConstituentListGetWithResponse request returning *IndexConstituentListGetClientResponse
-func (c *ClientWithResponses) IndexConstituentListGetWithResponse(ctx context.Context, params *IndexConstituentListGetParams, reqEditors ...RequestEditorFn) (*IndexConstituentListGetClientResponse, error) {
-	rsp, err := c.IndexConstituentListGet(ctx, params, reqEditors...)
+// ParseAllExchangeMarketHoursGetClientResponse parses an HTTP response from a AllExchangeMarketHoursGetWithResponse call
+func ParseAllExchangeMarketHoursGetClientResponse(rsp *http.Response) (*AllExchangeMarketHoursGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseIndexConstituentListGetClientResponse(rsp)
+
+	response := &AllExchangeMarketHoursGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ExchangeMarketHours
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// IndexListGetWithResponse request returning *IndexListGetClientResponse
-func (c *ClientWithResponses) IndexListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*IndexListGetClientResponse, error) {
-	rsp, err := c.IndexListGet(ctx, reqEditors...)
+// ParseAnalystEstimatesGetClientResponse parses an HTTP response from a AnalystEstimatesGetWithResponse call
+func ParseAnalystEstimatesGetClientResponse(rsp *http.Response) (*AnalystEstimatesGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseIndexListGetClientResponse(rsp)
+
+	response := &AnalystEstimatesGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []FinancialEstimates
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// InsiderTradingLatestGetWithResponse request returning *InsiderTradingLatestGetClientResponse
-func (c *ClientWithResponses) InsiderTradingLatestGetWithResponse(ctx context.Context, params *InsiderTradingLatestGetParams, reqEditors ...RequestEditorFn) (*InsiderTradingLatestGetClientResponse, error) {
-	rsp, err := c.InsiderTradingLatestGet(ctx, params, reqEditors...)
+// ParseAvailableExchangesGetClientResponse parses an HTTP response from a AvailableExchangesGetWithResponse call
+func ParseAvailableExchangesGetClientResponse(rsp *http.Response) (*AvailableExchangesGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseInsiderTradingLatestGetClientResponse(rsp)
+
+	response := &AvailableExchangesGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Exchange
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// InsiderTradingSearchGetWithResponse request returning *InsiderTradingSearchGetClientResponse
-func (c *ClientWithResponses) InsiderTradingSearchGetWithResponse(ctx context.Context, params *InsiderTradingSearchGetParams, reqEditors ...RequestEditorFn) (*InsiderTradingSearchGetClientResponse, error) {
-	rsp, err := c.InsiderTradingSearchGet(ctx, params, reqEditors...)
+// ParseBalanceSheetStatementGetClientResponse parses an HTTP response from a BalanceSheetStatementGetWithResponse call
+func ParseBalanceSheetStatementGetClientResponse(rsp *http.Response) (*BalanceSheetStatementGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseInsiderTradingSearchGetClientResponse(rsp)
+
+	response := &BalanceSheetStatementGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BalanceSheetStatement
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// KeyMetricsGetWithResponse request returning *KeyMetricsGetClientResponse
-func (c *ClientWithResponses) KeyMetricsGetWithResponse(ctx context.Context, params *KeyMetricsGetParams, reqEditors ...RequestEditorFn) (*KeyMetricsGetClientResponse, error) {
-	rsp, err := c.KeyMetricsGet(ctx, params, reqEditors...)
+// ParseBalanceSheetStatementBulkGetClientResponse parses an HTTP response from a BalanceSheetStatementBulkGetWithResponse call
+func ParseBalanceSheetStatementBulkGetClientResponse(rsp *http.Response) (*BalanceSheetStatementBulkGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseKeyMetricsGetClientResponse(rsp)
-}
 
-// KeyMetricsTTMGetWithResponse request returning *KeyMetricsTTMGetClientResponse
-func (c *ClientWithResponses) KeyMetricsTTMGetWithResponse(ctx context.Context, params *KeyMetricsTTMGetParams, reqEditors ...RequestEditorFn) (*KeyMetricsTTMGetClientResponse, error) {
-	rsp, err := c.KeyMetricsTTMGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &BalanceSheetStatementBulkGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseKeyMetricsTTMGetClientResponse(rsp)
+
+	return response, nil
 }
 
-// KeyMetricsTTMBulkGetWithResponse request returning *KeyMetricsTTMBulkGetClientResponse
-func (c *ClientWithResponses) KeyMetricsTTMBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*KeyMetricsTTMBulkGetClientResponse, error) {
-	rsp, err := c.KeyMetricsTTMBulkGet(ctx, reqEditors...)
+// ParseBalanceSheetStatementTTMGetClientResponse parses an HTTP response from a BalanceSheetStatementTTMGetWithResponse call
+func ParseBalanceSheetStatementTTMGetClientResponse(rsp *http.Response) (*BalanceSheetStatementTTMGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseKeyMetricsTTMBulkGetClientResponse(rsp)
-}
 
-// MarketCapitalizationGetWithResponse request returning *MarketCapitalizationGetClientResponse
-func (c *ClientWithResponses) MarketCapitalizationGetWithResponse(ctx context.Context, params *MarketCapitalizationGetParams, reqEditors ...RequestEditorFn) (*MarketCapitalizationGetClientResponse, error) {
-	rsp, err := c.MarketCapitalizationGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &BalanceSheetStatementTTMGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseMarketCapitalizationGetClientResponse(rsp)
-}
 
-// MarketCapitalizationBatchGetWithResponse request returning *MarketCapitalizationBatchGetClientResponse
-func (c *ClientWithResponses) MarketCapitalizationBatchGetWithResponse(ctx context.Context, params *MarketCapitalizationBatchGetParams, reqEditors ...RequestEditorFn) (*MarketCapitalizationBatchGetClientResponse, error) {
-	rsp, err := c.MarketCapitalizationBatchGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BalanceSheetStatementTTM
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseMarketCapitalizationBatchGetClientResponse(rsp)
+
+	return response, nil
 }
 
-// NewsGeneralLatestGetWithResponse request returning *NewsGeneralLatestGetClientResponse
-func (c *ClientWithResponses) NewsGeneralLatestGetWithResponse(ctx context.Context, params *NewsGeneralLatestGetParams, reqEditors ...RequestEditorFn) (*NewsGeneralLatestGetClientResponse, error) {
-	rsp, err := c.NewsGeneralLatestGet(ctx, params, reqEditors...)
+// ParseBatchIndexQuotesGetClientResponse parses an HTTP response from a BatchIndexQuotesGetWithResponse call
+func ParseBatchIndexQuotesGetClientResponse(rsp *http.Response) (*BatchIndexQuotesGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseNewsGeneralLatestGetClientResponse(rsp)
-}
 
-// NewsStockLatestGetWithResponse request returning *NewsStockLatestGetClientResponse
-func (c *ClientWithResponses) NewsStockLatestGetWithResponse(ctx context.Context, params *NewsStockLatestGetParams, reqEditors ...RequestEditorFn) (*NewsStockLatestGetClientResponse, error) {
-	rsp, err := c.NewsStockLatestGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &BatchIndexQuotesGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseNewsStockLatestGetClientResponse(rsp)
-}
 
-// ProfileGetWithResponse request returning *ProfileGetClientResponse
-func (c *ClientWithResponses) ProfileGetWithResponse(ctx context.Context, params *ProfileGetParams, reqEditors ...RequestEditorFn) (*ProfileGetClientResponse, error) {
-	rsp, err := c.ProfileGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ShortQuote
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseProfileGetClientResponse(rsp)
+
+	return response, nil
 }
 
-// ProfileBulkGetWithResponse request returning *ProfileBulkGetClientResponse
-func (c *ClientWithResponses) ProfileBulkGetWithResponse(ctx context.Context, params *ProfileBulkGetParams, reqEditors ...RequestEditorFn) (*ProfileBulkGetClientResponse, error) {
-	rsp, err := c.ProfileBulkGet(ctx, params, reqEditors...)
+// ParseBatchQuoteGetClientResponse parses an HTTP response from a BatchQuoteGetWithResponse call
+func ParseBatchQuoteGetClientResponse(rsp *http.Response) (*BatchQuoteGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseProfileBulkGetClientResponse(rsp)
-}
 
-// QuoteGetWithResponse request returning *QuoteGetClientResponse
-func (c *ClientWithResponses) QuoteGetWithResponse(ctx context.Context, params *QuoteGetParams, reqEditors ...RequestEditorFn) (*QuoteGetClientResponse, error) {
-	rsp, err := c.QuoteGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &BatchQuoteGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseQuoteGetClientResponse(rsp)
-}
 
-// QuoteShortGetWithResponse request returning *QuoteShortGetClientResponse
-func (c *ClientWithResponses) QuoteShortGetWithResponse(ctx context.Context, params *QuoteShortGetParams, reqEditors ...RequestEditorFn) (*QuoteShortGetClientResponse, error) {
-	rsp, err := c.QuoteShortGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []FullQuote
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseQuoteShortGetClientResponse(rsp)
+
+	return response, nil
 }
 
-// RatingBulkGetWithResponse request returning *RatingBulkGetClientResponse
-func (c *ClientWithResponses) RatingBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RatingBulkGetClientResponse, error) {
-	rsp, err := c.RatingBulkGet(ctx, reqEditors...)
+// ParseBatchQuoteShortGetClientResponse parses an HTTP response from a BatchQuoteShortGetWithResponse call
+func ParseBatchQuoteShortGetClientResponse(rsp *http.Response) (*BatchQuoteShortGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRatingBulkGetClientResponse(rsp)
-}
 
-// RatingsSnapshotGetWithResponse request returning *RatingsSnapshotGetClientResponse
-func (c *ClientWithResponses) RatingsSnapshotGetWithResponse(ctx context.Context, params *RatingsSnapshotGetParams, reqEditors ...RequestEditorFn) (*RatingsSnapshotGetClientResponse, error) {
-	rsp, err := c.RatingsSnapshotGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &BatchQuoteShortGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRatingsSnapshotGetClientResponse(rsp)
-}
 
-// RatiosGetWithResponse request returning *RatiosGetClientResponse
-func (c *ClientWithResponses) RatiosGetWithResponse(ctx context.Context, params *RatiosGetParams, reqEditors ...RequestEditorFn) (*RatiosGetClientResponse, error) {
-	rsp, err := c.RatiosGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ShortQuote
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseRatiosGetClientResponse(rsp)
+
+	return response, nil
 }
 
-// RatiosTTMGetWithResponse request returning *RatiosTTMGetClientResponse
-func (c *ClientWithResponses) RatiosTTMGetWithResponse(ctx context.Context, params *RatiosTTMGetParams, reqEditors ...RequestEditorFn) (*RatiosTTMGetClientResponse, error) {
-	rsp, err := c.RatiosTTMGet(ctx, params, reqEditors...)
+// ParseCashFlowStatementGetClientResponse parses an HTTP response from a CashFlowStatementGetWithResponse call
+func ParseCashFlowStatementGetClientResponse(rsp *http.Response) (*CashFlowStatementGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRatiosTTMGetClientResponse(rsp)
-}
 
-// RatiosTTMBulkGetWithResponse request returning *RatiosTTMBulkGetClientResponse
-func (c *ClientWithResponses) RatiosTTMBulkGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RatiosTTMBulkGetClientResponse, error) {
-	rsp, err := c.RatiosTTMBulkGet(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CashFlowStatementGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRatiosTTMBulkGetClientResponse(rsp)
-}
 
-// RevenueGeographicSegmentationGetWithResponse request returning *RevenueGeographicSegmentationGetClientResponse
-func (c *ClientWithResponses) RevenueGeographicSegmentationGetWithResponse(ctx context.Context, params *RevenueGeographicSegmentationGetParams, reqEditors ...RequestEditorFn) (*RevenueGeographicSegmentationGetClientResponse, error) {
-	rsp, err := c.RevenueGeographicSegmentationGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CashFlowStatement
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseRevenueGeographicSegmentationGetClientResponse(rsp)
+
+	return response, nil
 }
 
-// RevenueProductSegmentationGetWithResponse request returning *RevenueProductSegmentationGetClientResponse
-func (c *ClientWithResponses) RevenueProductSegmentationGetWithResponse(ctx context.Context, params *RevenueProductSegmentationGetParams, reqEditors ...RequestEditorFn) (*RevenueProductSegmentationGetClientResponse, error) {
-	rsp, err := c.RevenueProductSegmentationGet(ctx, params, reqEditors...)
+// ParseCashFlowStatementBulkGetClientResponse parses an HTTP response from a CashFlowStatementBulkGetWithResponse call
+func ParseCashFlowStatementBulkGetClientResponse(rsp *http.Response) (*CashFlowStatementBulkGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRevenueProductSegmentationGetClientResponse(rsp)
-}
 
-// SearchNameGetWithResponse request returning *SearchNameGetClientResponse
-func (c *ClientWithResponses) SearchNameGetWithResponse(ctx context.Context, params *SearchNameGetParams, reqEditors ...RequestEditorFn) (*SearchNameGetClientResponse, error) {
-	rsp, err := c.SearchNameGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CashFlowStatementBulkGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSearchNameGetClientResponse(rsp)
+
+	return response, nil
 }
 
-// SearchSymbolGetWithResponse request returning *SearchSymbolGetClientResponse
-func (c *ClientWithResponses) SearchSymbolGetWithResponse(ctx context.Context, params *SearchSymbolGetParams, reqEditors ...RequestEditorFn) (*SearchSymbolGetClientResponse, error) {
-	rsp, err := c.SearchSymbolGet(ctx, params, reqEditors...)
+// ParseCashFlowStatementTTMGetClientResponse parses an HTTP response from a CashFlowStatementTTMGetWithResponse call
+func ParseCashFlowStatementTTMGetClientResponse(rsp *http.Response) (*CashFlowStatementTTMGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSearchSymbolGetClientResponse(rsp)
-}
 
-// SharesFloatGetWithResponse request returning *SharesFloatGetClientResponse
-func (c *ClientWithResponses) SharesFloatGetWithResponse(ctx context.Context, params *SharesFloatGetParams, reqEditors ...RequestEditorFn) (*SharesFloatGetClientResponse, error) {
-	rsp, err := c.SharesFloatGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CashFlowStatementTTMGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSharesFloatGetClientResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CashFlowStatementTTM
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetSplitsWithResponse request returning *GetSplitsClientResponse
-func (c *ClientWithResponses) GetSplitsWithResponse(ctx context.Context, params *GetSplitsParams, reqEditors ...RequestEditorFn) (*GetSplitsClientResponse, error) {
-	rsp, err := c.GetSplits(ctx, params, reqEditors...)
+// ParseCommoditiesListGetClientResponse parses an HTTP response from a CommoditiesListGetWithResponse call
+func ParseCommoditiesListGetClientResponse(rsp *http.Response) (*CommoditiesListGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSplitsClientResponse(rsp)
-}
 
-// GetSplitsCalendarWithResponse request returning *GetSplitsCalendarClientResponse
-func (c *ClientWithResponses) GetSplitsCalendarWithResponse(ctx context.Context, params *GetSplitsCalendarParams, reqEditors ...RequestEditorFn) (*GetSplitsCalendarClientResponse, error) {
-	rsp, err := c.GetSplitsCalendar(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CommoditiesListGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CommoditySymbol
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetSplitsCalendarClientResponse(rsp)
+
+	return response, nil
 }
 
-// StockListGetWithResponse request returning *StockListGetClientResponse
-func (c *ClientWithResponses) StockListGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*StockListGetClientResponse, error) {
-	rsp, err := c.StockListGet(ctx, reqEditors...)
+// ParseCompanyScreenerGetClientResponse parses an HTTP response from a CompanyScreenerGetWithResponse call
+func ParseCompanyScreenerGetClientResponse(rsp *http.Response) (*CompanyScreenerGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseStockListGetClientResponse(rsp)
-}
 
-// TechnicalIndicatorsRsiGetWithResponse request returning *TechnicalIndicatorsRsiGetClientResponse
-func (c *ClientWithResponses) TechnicalIndicatorsRsiGetWithResponse(ctx context.Context, params *TechnicalIndicatorsRsiGetParams, reqEditors ...RequestEditorFn) (*TechnicalIndicatorsRsiGetClientResponse, error) {
-	rsp, err := c.TechnicalIndicatorsRsiGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CompanyScreenerGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseTechnicalIndicatorsRsiGetClientResponse(rsp)
-}
 
-// TreasuryRatesGetWithResponse request returning *TreasuryRatesGetClientResponse
-func (c *ClientWithResponses) TreasuryRatesGetWithResponse(ctx context.Context, params *TreasuryRatesGetParams, reqEditors ...RequestEditorFn) (*TreasuryRatesGetClientResponse, error) {
-	rsp, err := c.TreasuryRatesGet(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CompanyScreenerResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseTreasuryRatesGetClientResponse(rsp)
+
+	return response, nil
 }
 
-// ParseAnalystEstimatesGetClientResponse parses an HTTP response from a AnalystEstimatesGetWithResponse call
-func ParseAnalystEstimatesGetClientResponse(rsp *http.Response) (*AnalystEstimatesGetClientResponse, error) {
+// ParseCustomDiscountedCashFlowGetClientResponse parses an HTTP response from a CustomDiscountedCashFlowGetWithResponse call
+func ParseCustomDiscountedCashFlowGetClientResponse(rsp *http.Response) (*CustomDiscountedCashFlowGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AnalystEstimatesGetClientResponse{
+	response := &CustomDiscountedCashFlowGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []FinancialEstimates
+		var dest []CustomDiscountedCashFlow
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8733,22 +12616,22 @@ func ParseAnalystEstimatesGetClientResponse(rsp *http.Response) (*AnalystEstimat
 	return response, nil
 }
 
-// ParseAvailableExchangesGetClientResponse parses an HTTP response from a AvailableExchangesGetWithResponse call
-func ParseAvailableExchangesGetClientResponse(rsp *http.Response) (*AvailableExchangesGetClientResponse, error) {
+// ParseCustomLeveredDiscountedCashFlowGetClientResponse parses an HTTP response from a CustomLeveredDiscountedCashFlowGetWithResponse call
+func ParseCustomLeveredDiscountedCashFlowGetClientResponse(rsp *http.Response) (*CustomLeveredDiscountedCashFlowGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AvailableExchangesGetClientResponse{
+	response := &CustomLeveredDiscountedCashFlowGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Exchange
+		var dest []CustomLeveredDiscountedCashFlow
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8759,22 +12642,22 @@ func ParseAvailableExchangesGetClientResponse(rsp *http.Response) (*AvailableExc
 	return response, nil
 }
 
-// ParseBalanceSheetStatementGetClientResponse parses an HTTP response from a BalanceSheetStatementGetWithResponse call
-func ParseBalanceSheetStatementGetClientResponse(rsp *http.Response) (*BalanceSheetStatementGetClientResponse, error) {
+// ParseDcfBulkGetClientResponse parses an HTTP response from a DcfBulkGetWithResponse call
+func ParseDcfBulkGetClientResponse(rsp *http.Response) (*DcfBulkGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BalanceSheetStatementGetClientResponse{
+	response := &DcfBulkGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []BalanceSheetStatement
+		var dest []DCF
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8785,38 +12668,48 @@ func ParseBalanceSheetStatementGetClientResponse(rsp *http.Response) (*BalanceSh
 	return response, nil
 }
 
-// ParseBalanceSheetStatementBulkGetClientResponse parses an HTTP response from a BalanceSheetStatementBulkGetWithResponse call
-func ParseBalanceSheetStatementBulkGetClientResponse(rsp *http.Response) (*BalanceSheetStatementBulkGetClientResponse, error) {
+// ParseDelistedCompaniesClientResponse parses an HTTP response from a DelistedCompaniesWithResponse call
+func ParseDelistedCompaniesClientResponse(rsp *http.Response) (*DelistedCompaniesClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BalanceSheetStatementBulkGetClientResponse{
+	response := &DelistedCompaniesClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DelistedCompany
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseBalanceSheetStatementTTMGetClientResponse parses an HTTP response from a BalanceSheetStatementTTMGetWithResponse call
-func ParseBalanceSheetStatementTTMGetClientResponse(rsp *http.Response) (*BalanceSheetStatementTTMGetClientResponse, error) {
+// ParseDiscountedCashFlowGetClientResponse parses an HTTP response from a DiscountedCashFlowGetWithResponse call
+func ParseDiscountedCashFlowGetClientResponse(rsp *http.Response) (*DiscountedCashFlowGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BalanceSheetStatementTTMGetClientResponse{
+	response := &DiscountedCashFlowGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []BalanceSheetStatementTTM
+		var dest []DiscountedCashFlow
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8827,22 +12720,22 @@ func ParseBalanceSheetStatementTTMGetClientResponse(rsp *http.Response) (*Balanc
 	return response, nil
 }
 
-// ParseBatchIndexQuotesGetClientResponse parses an HTTP response from a BatchIndexQuotesGetWithResponse call
-func ParseBatchIndexQuotesGetClientResponse(rsp *http.Response) (*BatchIndexQuotesGetClientResponse, error) {
+// ParseDividendsGetClientResponse parses an HTTP response from a DividendsGetWithResponse call
+func ParseDividendsGetClientResponse(rsp *http.Response) (*DividendsGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BatchIndexQuotesGetClientResponse{
+	response := &DividendsGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ShortQuote
+		var dest []DividendEvent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8853,22 +12746,22 @@ func ParseBatchIndexQuotesGetClientResponse(rsp *http.Response) (*BatchIndexQuot
 	return response, nil
 }
 
-// ParseBatchQuoteGetClientResponse parses an HTTP response from a BatchQuoteGetWithResponse call
-func ParseBatchQuoteGetClientResponse(rsp *http.Response) (*BatchQuoteGetClientResponse, error) {
+// ParseDividendsCalendarGetClientResponse parses an HTTP response from a DividendsCalendarGetWithResponse call
+func ParseDividendsCalendarGetClientResponse(rsp *http.Response) (*DividendsCalendarGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BatchQuoteGetClientResponse{
+	response := &DividendsCalendarGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []FullQuote
+		var dest []DividendEvent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8879,22 +12772,22 @@ func ParseBatchQuoteGetClientResponse(rsp *http.Response) (*BatchQuoteGetClientR
 	return response, nil
 }
 
-// ParseBatchQuoteShortGetClientResponse parses an HTTP response from a BatchQuoteShortGetWithResponse call
-func ParseBatchQuoteShortGetClientResponse(rsp *http.Response) (*BatchQuoteShortGetClientResponse, error) {
+// ParseDowJonesConstituentGetClientResponse parses an HTTP response from a DowJonesConstituentGetWithResponse call
+func ParseDowJonesConstituentGetClientResponse(rsp *http.Response) (*DowJonesConstituentGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BatchQuoteShortGetClientResponse{
+	response := &DowJonesConstituentGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ShortQuote
+		var dest []IndexConstituent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8905,22 +12798,22 @@ func ParseBatchQuoteShortGetClientResponse(rsp *http.Response) (*BatchQuoteShort
 	return response, nil
 }
 
-// ParseCashFlowStatementGetClientResponse parses an HTTP response from a CashFlowStatementGetWithResponse call
-func ParseCashFlowStatementGetClientResponse(rsp *http.Response) (*CashFlowStatementGetClientResponse, error) {
+// ParseEarningsGetClientResponse parses an HTTP response from a EarningsGetWithResponse call
+func ParseEarningsGetClientResponse(rsp *http.Response) (*EarningsGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CashFlowStatementGetClientResponse{
+	response := &EarningsGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []CashFlowStatement
+		var dest []EarningEvent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8931,38 +12824,48 @@ func ParseCashFlowStatementGetClientResponse(rsp *http.Response) (*CashFlowState
 	return response, nil
 }
 
-// ParseCashFlowStatementBulkGetClientResponse parses an HTTP response from a CashFlowStatementBulkGetWithResponse call
-func ParseCashFlowStatementBulkGetClientResponse(rsp *http.Response) (*CashFlowStatementBulkGetClientResponse, error) {
+// ParseGetEarningsCalendarClientResponse parses an HTTP response from a GetEarningsCalendarWithResponse call
+func ParseGetEarningsCalendarClientResponse(rsp *http.Response) (*GetEarningsCalendarClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CashFlowStatementBulkGetClientResponse{
+	response := &GetEarningsCalendarClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []EarningEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseCashFlowStatementTTMGetClientResponse parses an HTTP response from a CashFlowStatementTTMGetWithResponse call
-func ParseCashFlowStatementTTMGetClientResponse(rsp *http.Response) (*CashFlowStatementTTMGetClientResponse, error) {
+// ParseEconomicCalendarGetClientResponse parses an HTTP response from a EconomicCalendarGetWithResponse call
+func ParseEconomicCalendarGetClientResponse(rsp *http.Response) (*EconomicCalendarGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CashFlowStatementTTMGetClientResponse{
+	response := &EconomicCalendarGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []CashFlowStatementTTM
+		var dest []EconomicEvent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8973,22 +12876,22 @@ func ParseCashFlowStatementTTMGetClientResponse(rsp *http.Response) (*CashFlowSt
 	return response, nil
 }
 
-// ParseCommoditiesListGetClientResponse parses an HTTP response from a CommoditiesListGetWithResponse call
-func ParseCommoditiesListGetClientResponse(rsp *http.Response) (*CommoditiesListGetClientResponse, error) {
+// ParseEnterpriseValueGetClientResponse parses an HTTP response from a EnterpriseValueGetWithResponse call
+func ParseEnterpriseValueGetClientResponse(rsp *http.Response) (*EnterpriseValueGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CommoditiesListGetClientResponse{
+	response := &EnterpriseValueGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []CommoditySymbol
+		var dest []EnterpriseValue
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8999,22 +12902,22 @@ func ParseCommoditiesListGetClientResponse(rsp *http.Response) (*CommoditiesList
 	return response, nil
 }
 
-// ParseDcfBulkGetClientResponse parses an HTTP response from a DcfBulkGetWithResponse call
-func ParseDcfBulkGetClientResponse(rsp *http.Response) (*DcfBulkGetClientResponse, error) {
+// ParseEsgDisclosuresGetClientResponse parses an HTTP response from a EsgDisclosuresGetWithResponse call
+func ParseEsgDisclosuresGetClientResponse(rsp *http.Response) (*EsgDisclosuresGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DcfBulkGetClientResponse{
+	response := &EsgDisclosuresGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []DCF
+		var dest []EconomicEsgDisclosure
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9025,22 +12928,22 @@ func ParseDcfBulkGetClientResponse(rsp *http.Response) (*DcfBulkGetClientRespons
 	return response, nil
 }
 
-// ParseDelistedCompaniesClientResponse parses an HTTP response from a DelistedCompaniesWithResponse call
-func ParseDelistedCompaniesClientResponse(rsp *http.Response) (*DelistedCompaniesClientResponse, error) {
+// ParseEsgRatingsGetClientResponse parses an HTTP response from a EsgRatingsGetWithResponse call
+func ParseEsgRatingsGetClientResponse(rsp *http.Response) (*EsgRatingsGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DelistedCompaniesClientResponse{
+	response := &EsgRatingsGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []DelistedCompany
+		var dest []EconomicEsgRating
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9051,22 +12954,22 @@ func ParseDelistedCompaniesClientResponse(rsp *http.Response) (*DelistedCompanie
 	return response, nil
 }
 
-// ParseDividendsGetClientResponse parses an HTTP response from a DividendsGetWithResponse call
-func ParseDividendsGetClientResponse(rsp *http.Response) (*DividendsGetClientResponse, error) {
+// ParseETFListGetClientResponse parses an HTTP response from a ETFListGetWithResponse call
+func ParseETFListGetClientResponse(rsp *http.Response) (*ETFListGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DividendsGetClientResponse{
+	response := &ETFListGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []DividendEvent
+		var dest []ETFSymbol
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9077,22 +12980,22 @@ func ParseDividendsGetClientResponse(rsp *http.Response) (*DividendsGetClientRes
 	return response, nil
 }
 
-// ParseDividendsCalendarGetClientResponse parses an HTTP response from a DividendsCalendarGetWithResponse call
-func ParseDividendsCalendarGetClientResponse(rsp *http.Response) (*DividendsCalendarGetClientResponse, error) {
+// ParseETFCountryWeightingsGetClientResponse parses an HTTP response from a ETFCountryWeightingsGetWithResponse call
+func ParseETFCountryWeightingsGetClientResponse(rsp *http.Response) (*ETFCountryWeightingsGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DividendsCalendarGetClientResponse{
+	response := &ETFCountryWeightingsGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []DividendEvent
+		var dest []ETFCountryWeight
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9103,22 +13006,22 @@ func ParseDividendsCalendarGetClientResponse(rsp *http.Response) (*DividendsCale
 	return response, nil
 }
 
-// ParseEarningsGetClientResponse parses an HTTP response from a EarningsGetWithResponse call
-func ParseEarningsGetClientResponse(rsp *http.Response) (*EarningsGetClientResponse, error) {
+// ParseETFHoldingsGetClientResponse parses an HTTP response from a ETFHoldingsGetWithResponse call
+func ParseETFHoldingsGetClientResponse(rsp *http.Response) (*ETFHoldingsGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EarningsGetClientResponse{
+	response := &ETFHoldingsGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []EarningEvent
+		var dest []ETFHolding
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9129,22 +13032,22 @@ func ParseEarningsGetClientResponse(rsp *http.Response) (*EarningsGetClientRespo
 	return response, nil
 }
 
-// ParseGetEarningsCalendarClientResponse parses an HTTP response from a GetEarningsCalendarWithResponse call
-func ParseGetEarningsCalendarClientResponse(rsp *http.Response) (*GetEarningsCalendarClientResponse, error) {
+// ParseETFInfoGetClientResponse parses an HTTP response from a ETFInfoGetWithResponse call
+func ParseETFInfoGetClientResponse(rsp *http.Response) (*ETFInfoGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEarningsCalendarClientResponse{
+	response := &ETFInfoGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []EarningEvent
+		var dest []ETFProfile
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9155,22 +13058,22 @@ func ParseGetEarningsCalendarClientResponse(rsp *http.Response) (*GetEarningsCal
 	return response, nil
 }
 
-// ParseEconomicCalendarGetClientResponse parses an HTTP response from a EconomicCalendarGetWithResponse call
-func ParseEconomicCalendarGetClientResponse(rsp *http.Response) (*EconomicCalendarGetClientResponse, error) {
+// ParseETFSectorWeightingsGetClientResponse parses an HTTP response from a ETFSectorWeightingsGetWithResponse call
+func ParseETFSectorWeightingsGetClientResponse(rsp *http.Response) (*ETFSectorWeightingsGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EconomicCalendarGetClientResponse{
+	response := &ETFSectorWeightingsGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []EconomicEvent
+		var dest []ETFSectorWeight
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9181,22 +13084,22 @@ func ParseEconomicCalendarGetClientResponse(rsp *http.Response) (*EconomicCalend
 	return response, nil
 }
 
-// ParseEnterpriseValueGetClientResponse parses an HTTP response from a EnterpriseValueGetWithResponse call
-func ParseEnterpriseValueGetClientResponse(rsp *http.Response) (*EnterpriseValueGetClientResponse, error) {
+// ParseExchangeMarketHoursGetClientResponse parses an HTTP response from a ExchangeMarketHoursGetWithResponse call
+func ParseExchangeMarketHoursGetClientResponse(rsp *http.Response) (*ExchangeMarketHoursGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EnterpriseValueGetClientResponse{
+	response := &ExchangeMarketHoursGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []EnterpriseValue
+		var dest []ExchangeMarketHours
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9207,22 +13110,22 @@ func ParseEnterpriseValueGetClientResponse(rsp *http.Response) (*EnterpriseValue
 	return response, nil
 }
 
-// ParseEsgDisclosuresGetClientResponse parses an HTTP response from a EsgDisclosuresGetWithResponse call
-func ParseEsgDisclosuresGetClientResponse(rsp *http.Response) (*EsgDisclosuresGetClientResponse, error) {
+// ParseFinancialScoresGetClientResponse parses an HTTP response from a FinancialScoresGetWithResponse call
+func ParseFinancialScoresGetClientResponse(rsp *http.Response) (*FinancialScoresGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EsgDisclosuresGetClientResponse{
+	response := &FinancialScoresGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []EconomicEsgDisclosure
+		var dest []FinancialScores
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9233,22 +13136,22 @@ func ParseEsgDisclosuresGetClientResponse(rsp *http.Response) (*EsgDisclosuresGe
 	return response, nil
 }
 
-// ParseEsgRatingsGetClientResponse parses an HTTP response from a EsgRatingsGetWithResponse call
-func ParseEsgRatingsGetClientResponse(rsp *http.Response) (*EsgRatingsGetClientResponse, error) {
+// ParseForexCurrencyPairsGetClientResponse parses an HTTP response from a ForexCurrencyPairsGetWithResponse call
+func ParseForexCurrencyPairsGetClientResponse(rsp *http.Response) (*ForexCurrencyPairsGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EsgRatingsGetClientResponse{
+	response := &ForexCurrencyPairsGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []EconomicEsgRating
+		var dest []ForexCurrencyPair
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9259,22 +13162,22 @@ func ParseEsgRatingsGetClientResponse(rsp *http.Response) (*EsgRatingsGetClientR
 	return response, nil
 }
 
-// ParseETFListGetClientResponse parses an HTTP response from a ETFListGetWithResponse call
-func ParseETFListGetClientResponse(rsp *http.Response) (*ETFListGetClientResponse, error) {
+// ParseGradesGetClientResponse parses an HTTP response from a GradesGetWithResponse call
+func ParseGradesGetClientResponse(rsp *http.Response) (*GradesGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ETFListGetClientResponse{
+	response := &GradesGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ETFSymbol
+		var dest []Grade
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9285,22 +13188,22 @@ func ParseETFListGetClientResponse(rsp *http.Response) (*ETFListGetClientRespons
 	return response, nil
 }
 
-// ParseETFCountryWeightingsGetClientResponse parses an HTTP response from a ETFCountryWeightingsGetWithResponse call
-func ParseETFCountryWeightingsGetClientResponse(rsp *http.Response) (*ETFCountryWeightingsGetClientResponse, error) {
+// ParseGradesHistoricalGetClientResponse parses an HTTP response from a GradesHistoricalGetWithResponse call
+func ParseGradesHistoricalGetClientResponse(rsp *http.Response) (*GradesHistoricalGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ETFCountryWeightingsGetClientResponse{
+	response := &GradesHistoricalGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ETFCountryWeight
+		var dest []HistoricalGrade
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9311,22 +13214,22 @@ func ParseETFCountryWeightingsGetClientResponse(rsp *http.Response) (*ETFCountry
 	return response, nil
 }
 
-// ParseETFHoldingsGetClientResponse parses an HTTP response from a ETFHoldingsGetWithResponse call
-func ParseETFHoldingsGetClientResponse(rsp *http.Response) (*ETFHoldingsGetClientResponse, error) {
+// ParseGradesLatestNewsGetClientResponse parses an HTTP response from a GradesLatestNewsGetWithResponse call
+func ParseGradesLatestNewsGetClientResponse(rsp *http.Response) (*GradesLatestNewsGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ETFHoldingsGetClientResponse{
+	response := &GradesLatestNewsGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ETFHolding
+		var dest []GradeNews
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9337,22 +13240,22 @@ func ParseETFHoldingsGetClientResponse(rsp *http.Response) (*ETFHoldingsGetClien
 	return response, nil
 }
 
-// ParseETFInfoGetClientResponse parses an HTTP response from a ETFInfoGetWithResponse call
-func ParseETFInfoGetClientResponse(rsp *http.Response) (*ETFInfoGetClientResponse, error) {
+// ParseHistoricalChart15MinGetClientResponse parses an HTTP response from a HistoricalChart15MinGetWithResponse call
+func ParseHistoricalChart15MinGetClientResponse(rsp *http.Response) (*HistoricalChart15MinGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ETFInfoGetClientResponse{
+	response := &HistoricalChart15MinGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ETFProfile
+		var dest []DetailedCandle
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9363,22 +13266,22 @@ func ParseETFInfoGetClientResponse(rsp *http.Response) (*ETFInfoGetClientRespons
 	return response, nil
 }
 
-// ParseETFSectorWeightingsGetClientResponse parses an HTTP response from a ETFSectorWeightingsGetWithResponse call
-func ParseETFSectorWeightingsGetClientResponse(rsp *http.Response) (*ETFSectorWeightingsGetClientResponse, error) {
+// ParseHistoricalDowJonesConstituentGetClientResponse parses an HTTP response from a HistoricalDowJonesConstituentGetWithResponse call
+func ParseHistoricalDowJonesConstituentGetClientResponse(rsp *http.Response) (*HistoricalDowJonesConstituentGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ETFSectorWeightingsGetClientResponse{
+	response := &HistoricalDowJonesConstituentGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ETFSectorWeight
+		var dest []HistoricalIndexConstituent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9389,22 +13292,22 @@ func ParseETFSectorWeightingsGetClientResponse(rsp *http.Response) (*ETFSectorWe
 	return response, nil
 }
 
-// ParseForexCurrencyPairsGetClientResponse parses an HTTP response from a ForexCurrencyPairsGetWithResponse call
-func ParseForexCurrencyPairsGetClientResponse(rsp *http.Response) (*ForexCurrencyPairsGetClientResponse, error) {
+// ParseHistoricalNasdaqConstituentGetClientResponse parses an HTTP response from a HistoricalNasdaqConstituentGetWithResponse call
+func ParseHistoricalNasdaqConstituentGetClientResponse(rsp *http.Response) (*HistoricalNasdaqConstituentGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ForexCurrencyPairsGetClientResponse{
+	response := &HistoricalNasdaqConstituentGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ForexCurrencyPair
+		var dest []HistoricalIndexConstituent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9415,22 +13318,22 @@ func ParseForexCurrencyPairsGetClientResponse(rsp *http.Response) (*ForexCurrenc
 	return response, nil
 }
 
-// ParseGradesLatestNewsGetClientResponse parses an HTTP response from a GradesLatestNewsGetWithResponse call
-func ParseGradesLatestNewsGetClientResponse(rsp *http.Response) (*GradesLatestNewsGetClientResponse, error) {
+// ParseHistoricalPriceEodFullGetClientResponse parses an HTTP response from a HistoricalPriceEodFullGetWithResponse call
+func ParseHistoricalPriceEodFullGetClientResponse(rsp *http.Response) (*HistoricalPriceEodFullGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GradesLatestNewsGetClientResponse{
+	response := &HistoricalPriceEodFullGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []GradeNews
+		var dest []FullCandle
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9441,22 +13344,22 @@ func ParseGradesLatestNewsGetClientResponse(rsp *http.Response) (*GradesLatestNe
 	return response, nil
 }
 
-// ParseHistoricalChart15MinGetClientResponse parses an HTTP response from a HistoricalChart15MinGetWithResponse call
-func ParseHistoricalChart15MinGetClientResponse(rsp *http.Response) (*HistoricalChart15MinGetClientResponse, error) {
+// ParseHistoricalPriceEodLightGetClientResponse parses an HTTP response from a HistoricalPriceEodLightGetWithResponse call
+func ParseHistoricalPriceEodLightGetClientResponse(rsp *http.Response) (*HistoricalPriceEodLightGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &HistoricalChart15MinGetClientResponse{
+	response := &HistoricalPriceEodLightGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []DetailedCandle
+		var dest []LightCandle
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9467,22 +13370,22 @@ func ParseHistoricalChart15MinGetClientResponse(rsp *http.Response) (*Historical
 	return response, nil
 }
 
-// ParseHistoricalPriceEodFullGetClientResponse parses an HTTP response from a HistoricalPriceEodFullGetWithResponse call
-func ParseHistoricalPriceEodFullGetClientResponse(rsp *http.Response) (*HistoricalPriceEodFullGetClientResponse, error) {
+// ParseHistoricalSP500ConstituentGetClientResponse parses an HTTP response from a HistoricalSP500ConstituentGetWithResponse call
+func ParseHistoricalSP500ConstituentGetClientResponse(rsp *http.Response) (*HistoricalSP500ConstituentGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &HistoricalPriceEodFullGetClientResponse{
+	response := &HistoricalSP500ConstituentGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []FullCandle
+		var dest []HistoricalIndexConstituent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9493,22 +13396,22 @@ func ParseHistoricalPriceEodFullGetClientResponse(rsp *http.Response) (*Historic
 	return response, nil
 }
 
-// ParseHistoricalPriceEodLightGetClientResponse parses an HTTP response from a HistoricalPriceEodLightGetWithResponse call
-func ParseHistoricalPriceEodLightGetClientResponse(rsp *http.Response) (*HistoricalPriceEodLightGetClientResponse, error) {
+// ParseHolidaysByExchangeGetClientResponse parses an HTTP response from a HolidaysByExchangeGetWithResponse call
+func ParseHolidaysByExchangeGetClientResponse(rsp *http.Response) (*HolidaysByExchangeGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &HistoricalPriceEodLightGetClientResponse{
+	response := &HolidaysByExchangeGetClientResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []LightCandle
+		var dest []ExchangeHoliday
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9769,6 +13672,32 @@ func ParseKeyMetricsTTMBulkGetClientResponse(rsp *http.Response) (*KeyMetricsTTM
 	return response, nil
 }
 
+// ParseLeveredDiscountedCashFlowGetClientResponse parses an HTTP response from a LeveredDiscountedCashFlowGetWithResponse call
+func ParseLeveredDiscountedCashFlowGetClientResponse(rsp *http.Response) (*LeveredDiscountedCashFlowGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LeveredDiscountedCashFlowGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DiscountedCashFlow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseMarketCapitalizationGetClientResponse parses an HTTP response from a MarketCapitalizationGetWithResponse call
 func ParseMarketCapitalizationGetClientResponse(rsp *http.Response) (*MarketCapitalizationGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -9821,6 +13750,32 @@ func ParseMarketCapitalizationBatchGetClientResponse(rsp *http.Response) (*Marke
 	return response, nil
 }
 
+// ParseNasdaqConstituentGetClientResponse parses an HTTP response from a NasdaqConstituentGetWithResponse call
+func ParseNasdaqConstituentGetClientResponse(rsp *http.Response) (*NasdaqConstituentGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NasdaqConstituentGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []IndexConstituent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseNewsGeneralLatestGetClientResponse parses an HTTP response from a NewsGeneralLatestGetWithResponse call
 func ParseNewsGeneralLatestGetClientResponse(rsp *http.Response) (*NewsGeneralLatestGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -9873,6 +13828,110 @@ func ParseNewsStockLatestGetClientResponse(rsp *http.Response) (*NewsStockLatest
 	return response, nil
 }
 
+// ParsePriceTargetConsensusGetClientResponse parses an HTTP response from a PriceTargetConsensusGetWithResponse call
+func ParsePriceTargetConsensusGetClientResponse(rsp *http.Response) (*PriceTargetConsensusGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PriceTargetConsensusGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PriceTargetConsensus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePriceTargetLatestNewsGetClientResponse parses an HTTP response from a PriceTargetLatestNewsGetWithResponse call
+func ParsePriceTargetLatestNewsGetClientResponse(rsp *http.Response) (*PriceTargetLatestNewsGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PriceTargetLatestNewsGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PriceTargetNews
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePriceTargetNewsGetClientResponse parses an HTTP response from a PriceTargetNewsGetWithResponse call
+func ParsePriceTargetNewsGetClientResponse(rsp *http.Response) (*PriceTargetNewsGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PriceTargetNewsGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PriceTargetNews
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePriceTargetSummaryGetClientResponse parses an HTTP response from a PriceTargetSummaryGetWithResponse call
+func ParsePriceTargetSummaryGetClientResponse(rsp *http.Response) (*PriceTargetSummaryGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PriceTargetSummaryGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PriceTargetSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseProfileGetClientResponse parses an HTTP response from a ProfileGetWithResponse call
 func ParseProfileGetClientResponse(rsp *http.Response) (*ProfileGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -10166,6 +14225,84 @@ func ParseRevenueProductSegmentationGetClientResponse(rsp *http.Response) (*Reve
 	return response, nil
 }
 
+// ParseSearchCIKGetClientResponse parses an HTTP response from a SearchCIKGetWithResponse call
+func ParseSearchCIKGetClientResponse(rsp *http.Response) (*SearchCIKGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchCIKGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CIKSearchResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchCUSIPGetClientResponse parses an HTTP response from a SearchCUSIPGetWithResponse call
+func ParseSearchCUSIPGetClientResponse(rsp *http.Response) (*SearchCUSIPGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchCUSIPGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CUSIPSearchResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchISINGetClientResponse parses an HTTP response from a SearchISINGetWithResponse call
+func ParseSearchISINGetClientResponse(rsp *http.Response) (*SearchISINGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchISINGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ISINSearchResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseSearchNameGetClientResponse parses an HTTP response from a SearchNameGetWithResponse call
 func ParseSearchNameGetClientResponse(rsp *http.Response) (*SearchNameGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -10244,6 +14381,32 @@ func ParseSharesFloatGetClientResponse(rsp *http.Response) (*SharesFloatGetClien
 	return response, nil
 }
 
+// ParseSP500ConstituentGetClientResponse parses an HTTP response from a SP500ConstituentGetWithResponse call
+func ParseSP500ConstituentGetClientResponse(rsp *http.Response) (*SP500ConstituentGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SP500ConstituentGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []IndexConstituent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetSplitsClientResponse parses an HTTP response from a GetSplitsWithResponse call
 func ParseGetSplitsClientResponse(rsp *http.Response) (*GetSplitsClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -10322,6 +14485,32 @@ func ParseStockListGetClientResponse(rsp *http.Response) (*StockListGetClientRes
 	return response, nil
 }
 
+// ParseSymbolChangeGetClientResponse parses an HTTP response from a SymbolChangeGetWithResponse call
+func ParseSymbolChangeGetClientResponse(rsp *http.Response) (*SymbolChangeGetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SymbolChangeGetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SymbolChange
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseTechnicalIndicatorsRsiGetClientResponse parses an HTTP response from a TechnicalIndicatorsRsiGetWithResponse call
 func ParseTechnicalIndicatorsRsiGetClientResponse(rsp *http.Response) (*TechnicalIndicatorsRsiGetClientResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -10377,316 +14566,394 @@ func ParseTreasuryRatesGetClientResponse(rsp *http.Response) (*TreasuryRatesGetC
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9+XLjOJY3+ioIf3NjqiIkGdzJnL+cTrsqp3Nxp1Vd09/cuhGwCEnopAgVAdqpnqiI",
-	"eY0v4t6Xmye5gYU7SJGSM2vL/qMjyyIPsfzOioNz/utiRXd7muKUs4sX/3WRYbanKcPyP26yjGYf9F/E",
-	"H1Y05Tjl4p9ov0/ICnFC08t/MJqKv7HVFu+Q/DWOifgJJXcZ3eOME0GRZzmeXfDDHl+8uKAP/8ArfvHL",
-	"L7/MLmLMVhnZizcuXqjPgmIkF7/MLu4yuiYJ/g7zk0ZDON7JCf1LhtcXLy7+12U16Uv1GLu8prs9Sg/6",
-	"U+KreqAoy9DBNM5iLGCd0R2oxnghHtVkxVdfogSlK3y/xZjfc8TxTo96X1ub/7pAqxXecxy/QlxOD39C",
-	"u30iRmBD251b1hxaAPovoPXC8S/K4TGekXQjxotWK5qn/A4d0EOiaJY0/DDyofrf7GJNsx3iFy8uYpo/",
-	"JLiilea7B5zVaLEPeIXJY5ee47jWRHpZjuObT3tcoKukNZpCvssTxHH8nm9xJvYrw1ucMvKIX6crusNv",
-	"KGtSngdWYE8ZZQVbROLX6TXaE46SE0a7Um++wYjh9w8J2UhsNkdn2a4zZQ17aF7nWaYBVZH2HXsSZba9",
-	"SuNrxLY3P+fkESWFPCgp2lFUjHYKyfstzfgSZ7vX6SNmfNeh63tWYE2hSz42mUO86djQihwTT6zobkfT",
-	"e05XzddCxw78CZ+NzUwJo7kdXtQpiOcM44jxGmcZjj/gR5zmTVKh7UZThtIk9Y6mRgTAIApPoLpEn94Q",
-	"9EASIuRSD/FxJNckIelmSJyNWbk1YSuU/B2jrEvF9PyG0viJJKcwbfHqVRq/TjlKN+QhwVeMYX6KwCLP",
-	"QeIRp5xmh8a7gR1OgW5C043gwFf4obmLoRd48ARCfawcWW4wBcg7ktKM8MPrlOMMs1MglmLemVbg+5PW",
-	"JxVWhVnL+b49SehRoZhO3m35tmY3AxHLtcNg6mA0uRpLN2h6EE6SvpJmH7EJJCq5Ypio5zmON3VQFcW+",
-	"4TkejOBUskZjyvZ9OHnVei0pO3QmY2xJOUqkVtvSJMYZE2qbH07Yjj3OCI2bovX27ybBus+0iuhq05Gf",
-	"yvAekZid9Kq0lA93CUq5mOteiJ93uMn5rueHU3Y4w3uacRwr4Kyay3fxw/0r0zJkmCOS4vgGZSlJNy2D",
-	"04osz50wBFbYRx0xZsNwkjRlxywtx7PtKRYBO+weaFONXlxd3b0xLQpHn0wSK3KjKVPg6NOz8BsX3GEY",
-	"j+O70SSASEIDEtmzo0kiuU6vT0xZge9E9lSiHfhY0PftaCoZgxjx/MibvGZ9GLRsP4CTV6xXosMQOvAM",
-	"aldpvOyZ9mlYGdRrtmVFkXM6yV7EOJbvhFPpGjkt8jx/Mqcd0UOTAZRhxPLscJqakRL655xkOL548Z+F",
-	"V6FlmUHkK4+y4azMmqGYhgtSKsxep7lHEg97xB0r1ByBMZgSdSehpmKN1qRRoA0p15o3ZfBnhh0ms79Q",
-	"VxV9tmAfLzXt66aYb4O6Gw1rG3TdmFRbGx+NuDQ1VtfJ77fB+1VBy18bcvdHOO2DxvGggDHY+v1RLYOc",
-	"bvNxx35sxmYMdlV/OG58MPCIudwvwJr60OCrHlUmBk1Y19WV7/pTJyQ+M4eMl8u3E6PG3hxac6eIGtvB",
-	"5KixFVVR3skxYjvynWjo7aGI8PPEf/0gHBjBuGjvSbFdeF78Fp4WpHWgHfXPd2xI1nOCwOun8kwBWDfw",
-	"w96P9IRbLfs5wq1uZeKcGFyFzxBAnRIu1Xx8ZrjUmxIuPSc4OjkUOjLw6UdW/871hzmdyPOPvtbHDGHg",
-	"VTb75BDmqICl7xf2MZwWnhQj65/ZQDBycujRsd1w+ENHfBTfsjxrmMJAWPGEIGIQWKEz/MHjnpUbwWML",
-	"bNSbcGoQ0I58Pxj+0MiQ36gA31+t6QG+0eG8U4N3PvT7ddfnCNVZtt0Pyf7AnGWHrnP8vT6BYjtu0I+p",
-	"84NucEJgDU4JnrkuDPvNgmOhMsexXTjq7d4wh+s6/pEBdDYr8oMBi6gv5uX7gRcOv9Ub4bKsIRwPxrPs",
-	"IHDsYPS7Q9GrEZt1JFYFQ3c0gf7IlB359jCVExyPMVGnI1s4FGP6GlH6GlH6GlH6GlH6Q0WUhFC5TejT",
-	"F0hAZGaRDu3nTECce5brTqL3c06Y3HzWNj0npd9J5o4Jz7PmIs0j1w2mJsjxl3hDUoHO9+u7rqHuwMCZ",
-	"TvMmjY3UJqfwbVG6wa/TH2n2kaQbU3jM8b3Pnrz3ijySGKcxEyzbMuE925kCgpp8eM1YjtIVPgUIFZUP",
-	"eJ9nqy1iOG6DIZqW1PdM+YVKUi3RpxPmFeN9hldESuCrNL7a0YyTfyKV7dwwcV13Sn4OXq/xir9f39IM",
-	"f7qWoGLvUyGQfjdJhesM40KCto7Zw3DSgTYpNgh38Wz7FrQn0VKqo0PonOzCuQVdf9IgSnX0Or2rG35X",
-	"aVzafmeJysJ+eaf0m5F151HkhdOy/sSG3mVUSJf45eGWpChdkXRzteLksevLzC3bikLnnE8ozd3/CTua",
-	"lPfWof9+jzM0QN+yQntSdpL4glYCHXadB9FUUkek72ShmQ7hwYuiiXh4RkWTYq4kcTMY4QTOxMzUu4aJ",
-	"e4bSSjF/xpWnBdLMQnEqzqRJfZQBvXCSdJREj7Hc3HJgeEquKWLb18W1ooqabfv+VGpD5lVoTzGvTsjl",
-	"7Mf8yE9q84e9X/dFxOZu6Hv+507LZCjB7C3ieSY3uX84vu1M0m+lBz+kfZxpt6uY4MSXwmoUbiZOmcnI",
-	"8sPPk6355eJZlRQcNi5N5mvvIvX6JX0Brno0q+OhmnnQxOcj9e1og6jrkvZy0xF0D4i6kUZIV5X2G10D",
-	"HGHQMr2K3+yM9TpXQ8rQoLzNvmOv3BvQQCMtxUEvp2tImVz1/pCASd8a4xEtR6XrbbR8hlGBos+admSO",
-	"FTlhZD1nrCjyoPfbihVFkffMsSLXigL32WJFjUyi54kVzUPbdn/dYJHv/TaDRaFrPcNl1MnZUV8mWOQH",
-	"wW8wWPQZU6p6g0VROI2phmJFTuBO8m8/T6zInxQDOClUNE1SjgoVWTDyg88bK3Jg4EefM1ZkFW7rZwsW",
-	"wdCOni1YJMSA99zhooliM31mTDyjrumJF/mWB3+b8aKJS38kXjQVauPiRb5lW88fL/Lt4PniRc60q/zH",
-	"4kVzK5q0LSfkBn6RgFGZD/ubCBgFrm0/d8DoGeNE0ST76muc6Guc6Guc6Guc6Pw4kVi4mPDDfSlRmiGi",
-	"VY80/g+TNMafFLeKhzs/pqhlGF28wSgF39MNA7e5mBEzSniDqPv+pmcAPEMxfktT3jRcL17h1VHRWMpE",
-	"OdAGrVm1DD2LuEfpQcuTmv/cXMq4x4O159Ad48HuUPZRoHPfLogCQ9ux7M+vPMoV0iOsBjSwKkUpvm7w",
-	"MY4z3Lrqd/E+xeBqv08wuEPZR/AjOhjDjo84Qxv8N5rkLUz5VuT6i2DUOjxgjppaeGG748JBmDaH/TZb",
-	"gCXZUb49gFcLcE3pR2N0q+SOKuC3CKIJsbk7nK1wylGLir2wIAw/S0CunZJ9cZ3LTUxpT/xObPq7Dq+r",
-	"TX2drhbm1/KUZx0hY3x0inm4yhnZt6brBKHjWBD2xNRQnvDXu/YCr1HCqqqXD5QmGKUX7WKS5gmDGDOy",
-	"SdkM7FCar9FKyroZQGkMFAsxwHYo4/stTcUPe5wxmqIEiNXMOc7YDHBhwXA2A08YZdKaUQSEacYYzQhm",
-	"4IlmSfxEYrwAyy0Gei8AXa9xxgC5E+RnAIGEpBjQdf2j/wbeolX9t+4Y/g2QOxTXn9nlCSfzfZ7tKcPF",
-	"CP9NDqs2yi3d4e5YBdmMMJJuwBXJ7mjMZprxl38r/vUj4qvtDLzEiDOwz2icr7ie9vd0h+9ovACvOUAJ",
-	"o+JnobCZevUaZRiwfC+MW/n8KqF5DBjOHskK6zEqNYsZeEQZoTkD+wRxwUFsBki6SvJYjI5vpUQC95xm",
-	"GPAtEh9M6BNY5YzTnVhZTkFM2Io+LhaLY2qxAsi7q/tXV38dev42T5IuJ6n3wHcJfUAJuMeJkLemAGae",
-	"JEuywze7fUIPuOUCX1i+8NVNL5IO+i+2nO/Zi8tL+RNbrJVNhJIdjbHwE/YZ3i9WdHepVMSlUCeLfbox",
-	"kk/jnHWY/ZqmLN/hDNyI+WQ0JSujOUD2tIgEd39j0kbDyWGZIbF5jU806tbWOJiwqzgbx+yE3fD12Edv",
-	"8zQe+yxJ2+KsFFPG4HSCWGkDNz3SRTROnTyXMSHFR3Pw37gw/BZEkT+3oAXNcQjS8qRtx16M019Zl5Ms",
-	"313AcG77cGEMezC84rQV9l/i1TalCd0YDQzGO7ba9dVY67Tvpt9j11yp7ikJS32jZviEHxjhPRz49PS0",
-	"QELECXYzfeWfbYUXedByx9t2am/qANGWUgt1xU6UVo3BRCnn3DbZmoZCTakX4QfJEoX+NkjEmlCtSZRq",
-	"7ZqKWVlsJRAqe8MkJQtMz0oLVVtBBSzUGhdyshJJLfOhEBcmuVRInVJODBjQ91uUYXabUMSn+RTACuQt",
-	"D/PxlqCnSLeqoUHbtSMYQCM2hcdZDqUKcUeLCEbeuOhnzhlHqVgG8+cdXTDP9Plnc12qeTSXwjS+ob3p",
-	"85r7zODrLUkRuEryHUnzHbhGKQPf00R8jYE3ZEe4DH+MkjN+GIWL7/8yfu71UZkm9er6djzCgjmMjCb0",
-	"at2SzkG08H3P9cPQCSIv8MwCl64+3nXUwoW/8Nyx6yFRYy/u//dkNIgxN8ZgXB2cEMZVfBKlhwmb/pJm",
-	"PzNQahwirOI+TyjWX3nVz9jOmGCB2ea8e/eXI0ZV/XtWMBdqdVRwwrQhLz/89f72NHg25XtNwNZWx7xJ",
-	"HJEEx9cojU1Bh1VCWdfyGOmCDwtb74XrvTBb1Fuy2bY+6iwsZ+RZ+FNnvFY49rwu7bxrjxPTBmMl8KAX",
-	"wUVku1YIIbROruMnx6Vmptdmpjem/O5PYi+1qXHzaL7oGP+jxwQeNbTZ2fG4GK8SlMlgX5d7jC+cNdy1",
-	"WMlu5OMe78j8Kk1zeZLRNbXRYYdTPm6AGV7RLB73rInhrVdwcf/a9PSB4KQ5b38cEo9I7tqIm3Ptbs+s",
-	"gZjabhSjqy+xSbbcLG+vld34IyabLTdpAFMYKxVKHchMTKNL+ySp9UT2LqJgYUf/11EpWlm0HXI9c9Fm",
-	"h4GxGGulS/a6NNM9V+VW/A0lrXperhu4bhA5bhgs3HHx2+6BwtXd3Zsb8PrddY8XmGeEH64nxgSZtALf",
-	"qc82bNUwtKAPQ+vkqPv9nfFeR74X6I77VU30AtovHGvg3StuzpWzfAA94RWYjbdBKAYLywnOYFmFq/J8",
-	"Rbt4zW1pLbdhRE0I1SdcLVwP4vuPIsTIrhPUPo2orux3TiBkeYkf0hhnb1GKNriT8+Y7jmVDqSlH5is/",
-	"bgwHGq7vRL47uv1HTzB6ucVgmeWMA4bxRxmxRKstwY8YEM5AdYgN1IKRRwweDmCrRARAYE8zvqYJoYCu",
-	"ZUhUnbgCaTMzHRbNsI6b4hiQVD5G0hh/At+If/7Pf/+/dwWV//nv/+/bGXgifCufUpssSGO02iqiBYXy",
-	"HcDyB+GZcYKS5ABWNFPNpVSUlrYIsbxNSA7FbHPTHVlpYBw7fsB8XXMA6qz86oPZDpdVRD4IPdTW+5Fb",
-	"14Br7Y52dlXvApPKp9X4wTF5ySRd4b3ZLLGiyBFywLbHWDinBlS7SiEIXd++taBjFLFdSS6WE9z/3zmE",
-	"tn8HPAjBzfJWQdhM4LFVpsJZ+O5ILfI4LeNIxpDYG6LqBJZdyZoSBX/aU9a+MGAtomDMhpsj5C8RIyvw",
-	"FnGcEZSwoxZBLSpWjsYkGJsN0gb1ZLmLYw3CYQVnUFIy/x06Sxu+cOwXbrgIA+d/t6E652SHzerrSOSU",
-	"sQ2S5xQ5u8TpJUkZJzxXtWQuMV+zS7aPsznbzz0I55iv51xAbs72h8n5A80ApNZ2NU3TVX2lHKrHMWsC",
-	"pyVNzIFFs2aqqxfFLk3kt6VMU6/WMd+jW+/lI32GsSkCfxTPkyA1aLm0GG+q4VKGjcea1n1hwa6c+5Gw",
-	"mO6WGcbgLYnjBIMbxDgo3BQgg8MjV+a7H96Yoy3dIapaSj2OddxflmOUX4z3ApZ5+xb0wrHH3R3ZsxvG",
-	"ya5jArvjdlGdU/zQY0MrATPqgk2mKmsZ5uJZlnQXxqe2Sko903L9UN/5OtmR+MvN9eLd/eS4Z7VTrWVv",
-	"T94wheYyGxlhRVMh0W7Y5hVhq6RUiE243dx/d7+iLVXp2YuRftWIO4xwzG5Pv6h2SqLLuZe+cPpIMpoK",
-	"mY4S47J547hMPLKUf6wPJpz/xVxe+hFnqaxl3/mkDxfBOEuL0RUxDdr1xgY0p5y25lkyYAfg1WJDHy+v",
-	"stWWPGJ2ieMNyi5jxNGl2vPLavttlcge1P40F8iSf5wrt2LLd/XtyzMyNhW7lVtdRcPJx05MvNw0IxCa",
-	"K9zdtVnFamp5jjDtB5mDamTYD4R9rH6u6fRfj7N67vcJ9jL7SMYslPfv7n94e/MB3Ly5uV5+eP/u9fX9",
-	"UA7LB5S25uYCmksH1DsvXaD3kMQAi5ql30jNb25Ua9SDm98Tce9qwrlwY/0JCYyd2Ld/fvaj5S7scCQd",
-	"U4T23+/GJxr+u9n87Kv85shqi84Lp+9oCGuFKl7ujj/NkwQ9dJztmrH02I47XThzmbQMXpIkAVf5Sjsg",
-	"hjSvPVq13n0jj2RM94YeCc1Ze+styz75EKgKWKs5NJJA9NgM+26Ebcpxts8Iw2VcuZNqvHye/mrnVvjD",
-	"3aHWkq8CK3R9x468AE5L4mrlndcqSEee5cMpJEmas+vn7ySt/vV+bcz5cFwnkEXZjDkf5tQA2w7Gnts+",
-	"W9ZIbSidGfXsxvCKzprQ7OLDCPfa0b7x/OmaxqNimfrxrqZtHFkJZXa1wxlZoZ6EBdSSkR8wSnpjNObE",
-	"hKu3N/8xLjL4Dj+Bv9PsI5DXgkCxGOAqM49P7eF9vl6TTy1Kl1dHEVDLQtBhnfqizRor3vpWsTSmLbwt",
-	"EmgLf4qNdMOjCaLmgfCrx01LU0LfDl3Xske60oLI9+3sBUXFC/3QDXreedO+HCxfEWLIHf/hGHXG73mR",
-	"Zzt24Lr+BDLdGUg6bhiG3hQ6nVlJMpbvQ8c3RzLaE4gWfjg2CtIdNVzY0HJHE2gPN1pAD4684lLe5uxC",
-	"KLI86HiRD6NplAy7EFih5wR2FMGhFzvL7kSu60deEIxMnUjz3VWKkgPj7GbfuhdsUjm15029pyzjS0XE",
-	"pLVebujASf1CNZ3OailC3mRC7dWTdOwJdNgG6Vr47bk5lh+5fuj5I634ilJndpKUsH/sqYNqz08NyrND",
-	"y/6y1kFtvZu72MBGXZY05FNd5lVCtCaCK5He4o02j7W4t71YnX1ob3EpuSoxVMoTI3N0OOynuo6TpxWs",
-	"59R9mWcpfWxnVixgYDlh4AaOHfo2HBlgeqD0ozSb7nAmrbJWADqwLB/CKAgDy/OtsTQ5p7s3JMUye4C/",
-	"RdmmdbwJF7YTBZbteYEvrOLQD8aWF8OfjEOFC9/y/DCyoCNwbI1Mre7eEb6mKu2+e/xs2QvPCnzb9V07",
-	"cJ3ADkfXGzMO2V3YbuCEIbScQJC0RpMzHY1bfhR4thf5bhj5jh84I537lJM0pznTd/Vpyp5/11RPDNOo",
-	"Qz9wLNsLfM9xYTjyuOP8mvEP/F7da+vfbW8BbTfwrcC2HegGI6+KCtJLqjqpmOZrR7blQdtzQxi6oT1y",
-	"ARVV7SaZyPqeFXrQCuTGh7Y1gapK2DGgfREGtmP7PrTt0A6gO4HmW+NNKbiADvRg4FuO40chdN2ROcT6",
-	"ZO8OkVg6hHv8qX/f3EUQWXbkR24EA2g5rjfxIweaczOD2Z4NbT/yI8eHgRdOovv3TjYnXEDoOl7oRaFY",
-	"j8j3rMCbTrMnwAcXbdrjrXYj6zuWZ0HbtsMAwsDz/ClOgJmg6zoBDGDoiWVwRproD/wOZzcPhLe1H7St",
-	"0LG8EDqWa7vuhJp75BEv0acPbaECF7YLI8sKPct3rTCKwpGHsc14xNs84WTfypSy/YVnu4EduFEQWL4b",
-	"BiPL+mn74A3ug7+/cGEYBJEvxKo3nvDEthDkE46veo2RcOH50LFg6IWWK5hl7PnaE8piGSxa0qJ50XcZ",
-	"feIGjTd3vUXkBJEdQBjCMBqpO+r1P963i4aY2D6yoWXZvhOGkRc5XjT5K0bdHyxgZNmBF0UW9F17ZOLV",
-	"JqNsSD27vg19x41Cy7MdJ3ImFUjsF6nTCi2+UjzanbO/iNzIsSPbjTwYBL43rQCjEWl2uAgDGFgw8mzP",
-	"svyRROuNwYYVK4Sy965tQVtw1DT3XYiql8sWycCLYBhabuh4oQXHuuM1kqaVtWAEPRcGMPLcKLLHV817",
-	"ZlOvU4dnwJyWgxYKxQmgbY/UT50P9LBXAP3I92DkepHthM5ptI2ICKALXc9z3MgVpvZplO9Rgo0GogNt",
-	"1xIGlxv5tjfyZnpJfmA/T9Pfe10HzMh8zgJ60HFDaAeha9t2YD9/twaOPg1PKoig5fq+lHzj2HOvFMxL",
-	"Sj8a9Ke1cALbdYLAdUPfiawpJD+TzmpR79J1goUdBmIXLM/3/MDxp9C9RSTrHq2dtRC3Nf1nGK69sGzb",
-	"80M/sP3I86MppEcobTtaeJ7vhJZrS3E4CRY9nBkuIie07TAMQt8PrXEkf87J6qPR7bV9CP3QDZ3QEegd",
-	"GZwsq/YZ2dFbRNCBThhawqKEtvP5akjq4JxR9trewg3dyHJ9J3L8MBoZPZeXWBqdI58xJFVW5Hs/Xj95",
-	"C993AlljUiBp5JYzmjyKZTTKdlcsihs5oeV5ztgUsEmNsVXf2JefIar31KgAWQDQIOMcawGjKHKEAeFB",
-	"2w/d8+8smstnGipxdi3khkvbckj7NKdR84wIlnVtqt5oqJmdDQrXZAEbHbBZKzrcirs1pFEbp/WwYo++",
-	"GVRyY5w3g+I1Cd0BHXJMB5jCb6YwlzGgNugU9Lrew3zRa1IOW4SjvNSBMGafTzdeDh415I9HzsfE7cxh",
-	"t3bQrD/g1VVEJk+pxzVtReZNZyFDEnVQYw35Ke1TjJ5oQdeNbES/DNZbN/pqiHD1x6eMSReyVqsWr3eI",
-	"ZN0jqXVGd2bb4eaHD+YuEXTXzZ+5yTM6Nv3z5ocPPXYJp5PMGE4NiTz34BVNEqlpRh5mNpagMYjabMuv",
-	"Gdc5T5Le+hvd3B9vcUIKaKv6peu7Y8toGguAhF+kYMSzlgGx/cXI+FC3DIgdLOxnt9cMFUNc1w0ja+TR",
-	"4uNT64jFduBiVFrvEXtLlxvRhUZ0OeVmuZG+ImYXelg/aVj/Nad8HKq/eF3XGB2+PwNfMTq8OR1iUytc",
-	"Plf1wXTiJYEzWKHIgb7uChA7HFvD5ZzCh/Ldq8eNDWGTghUtojDwvElUvBYRFy5sO3j+azic7DDjaNfc",
-	"aStwwgDaoVi2bibVuZLkgFHW5QUfLqzRr3dSzmSJyTNkkc4eLaorGkollhKolEmaJyverk2tGmazVGNt",
-	"f5uQaVTU0iKxien6Zpn0+ncZivE7/MSMN0M6NSeEMWm8TKauKhurKPz74m4B3tJsg4xXFlL8JAfRqhn+",
-	"iDN1M7fnHfYSMfzDhzfN1x5w+k+SqvvgfS/e5Q8JYVvcvrqsX+17bUl4u5rEHTrcoQQsM5QytVpAOc9M",
-	"1iK+U6WLgLqiDV5l5BED5XDOwM0BMyBMDf0X8CPhW3DPM5puwPLtK3C1xSgGdA1UuweagVfo8ALojKy+",
-	"UXZWpH5Drr46l7Z3Ce1L13EDD4bwco8Oe5TMHzDibP6zOy/usLA5YnNezXC+UzOcozSe6+JMc4XueSxm",
-	"ON/I+czxAbO5tKX0H54I386ZnOCc7+KjV+sqKE/Gh+SRH7c4vaOsfTU3CBaB7Rn73yhcDJXpc5dW9MIK",
-	"X0B3ASEcX8TAJF3v/j4lH7E5umqz6+BsMkYb7TVWay9th4FnBfd311KYTcrrK/uQTmxCqq6dF01IHd+0",
-	"YFVQ6t2zNGyffF+RyV4vKn+ydUvGD0JrUgdOyvj7tSnp2JbJgF/wqtL43pCuO6VLGu7ku9iOPanhlQp7",
-	"tlLCXd+f0icMt/PAF5Y19sVXJMnbosJfjLTTB7pZarCf2c3SmOOywSnOUCL2Md6RlDCeIU4esRG1cGr+",
-	"RqssGvRDZ3KDzJd4TbNOG1PLdtzQO6Xbpp5Z6ywtcKMT2m2aaE2jYBBLZ/UUnCjSSiqvcKxuhLJzxnKb",
-	"0d21Oj8g6aY6P3ieMQrqrwjTBxQ47qE/geq5e0DTMsSsiNx80u0hCtonUC2DqkYe9AJ3UidL2hzgeQJW",
-	"9se6iv+R66ZjS2rWrBOonSFoxqdYnHj8yzDKVturNH6FH3FCZcc243gdywng9Ks8TRoyq39Krz+cCI1x",
-	"lcYqFt6HmEnUvpuiD2wfRp+nO+HsglOOkveqo53iLDWAdsd2e1IjXmXu4/hKHdDcb9n7nPdfOj6V4iuS",
-	"tIi6MISy1fHJ9+I/R2vGrLyn07Qvm0r8KCuMMCOG4Toefm2pYRKXXdPbJO47Orir1o/1q9T2pjZdx+mD",
-	"rkQexnrXDDLYMmNU8DhFOizjm808TcaD2etSZnXDRu7jxQGOMsWeWl7kcvl2oiOpO8VrR9IOTnIkJ3Zy",
-	"fk5HMoBwkh085Ehavhec70iOrlk13pH0JzXBNTiS3rT+5EZHMpjmZncdSedMR9I514/UWD/Tj/R+dT/S",
-	"teDz+ZHCoH4+P9KL/D+EHzm9N/2X9yNPHeNXP1L4kaFrh8/mR06Ur799P9LcJ//z+5GRa5/tR3qBD39n",
-	"fqQb+r+yHxlYz+xGwtAKbPeZ3UhB1v/qRn51I7+6kc/sRsb409gm99dXr8YXMFvej6xfVjQ1uFze/we4",
-	"Rvs9jsFrVZiToIQBNcSR58L/z3L5+t3kyvS1RJDBvvZyKNdUFck3nt1qD9dYDvOWZIxfxbHyb7qeB83T",
-	"vt+2GMV/zVHGVeJF76oOdHrt/pQ/3A/8Wi7utKUsS8NX5JvDV9LWvLqMxDirZYSYQho/54QR8eP77BVh",
-	"e6r+o6UTB8roXhtiEMKz8n3f3KIswyv+Pnudqn81331ldiGHHNGxubHmetTuuO7BsPcqGEk3xhVwQggd",
-	"t9/2I+mmm039PT1w8AE/4NUKgauBHlMEs/dPacuxt6DrRCMT7EoyBTpatBzXg1MomTqRqgY+sori6FLF",
-	"d8ZZ11J+BmAwKh5RI9VFw9v5zSe82xvTeMQf3q/FqrciGXS9JiucvQD32QL87W4GrrcEr8HVasXBe/Xb",
-	"s5YP18x1KVAWuRH0oaq15ni1PxX1wx3v9PrhVcZ+3ZBr70WLERpyobvcXQS3GaK50rN+CWWQJY0C5kaM",
-	"VwmSDeD2lyv/Cz68xTwjK1NSorIIXhc3zpphtrCIp45sziVp3ekLbU1SXhB602l9qK7MtRqH+ZY7JdKL",
-	"9vjTkr6q2Z6dC7qea3uRB63I98ZeYdVkO3er2mV/gigMA8e3LOhC37UmETeFp+EC2q7lhZFvB57tR24Q",
-	"jC4ldk3TR5wxQtPrw6qVgTmXbQYcx/Uj23MdG9q/z3Jf6MDer0tMv6+6VbfLu/mu7QXQc0PXcuHI0jmK",
-	"eoHyXuJWtPC90A0C24osJxhblUoSl1cD+yj71iJ0bM+LAtezI8sZWflIX9A0lqWy/dAKgyh07MCGke0E",
-	"p9Q9OrNON35c0puXr5evrp6jcJKgVr9P2i5HENqB4wVh6Pu2G7mjSQ5zugMXNnRD3w8jB7pOMLLeviAs",
-	"d7xViNZyXMeJbGiJsbr256nrVFuj4s5sa60se0o4qEnwlmS7FlsEVmSH0LPDcAEja2QlhDpVI4Idy3Jg",
-	"4MPAjWwr8MZWV0JbtHuHeTsANrfshSNgF3q2By3PH1lfQxPstkG17YUXBtAKbD/wLT90nAkHLH/NUdLe",
-	"Fmth+5bnu07k+JZvjZUCJC3ut7IllTXV1dXpM05bXuZZ3LowdGJxNtVhE8f6ZnVrAe1JUfWey1OTS/2n",
-	"mKugIJcL1RV0c8vz4LQq/5irC+cGaQcXntDmruuFMHR8x55ax6ij0gN34Qae5UA7coSmm0jwA+Z5lr5P",
-	"TTBZOK7j2hEMBLItOLYGzZfOVFPJEH12VAht246iIHItxx9bRDcbWhXb80Pbg47lwdALvNCZRFKD/2a3",
-	"T+gBx52yn44Ype/Zvg9DO5hE2iDdrYXvepHjQQcGdhjAkZVzCoqvBzgWLlzXgQEMLStw7SAaWaO0IL3U",
-	"cuoZl5gJHdsXDu8ByDjCnK4+vkQMx9d0t8cpkw5Gv+kehWEUwcDzHOjYzuerCtMjsjw/mnRYzNEng5A/",
-	"sa5ds3pGU5TajgvHC/nnqiBTvxnYNmnrxlmfBWgwNhvWbFfidwq1NPV8y45o2Sn1/eho4c76drVqR3r1",
-	"S/tebuzIlH6R0C/Z2j6JycobcLHNTn3HbR7N9iNUx3FOH7CwjDGNbtBk1g3J9HqFQ77ooBfcsRjMoYEe",
-	"98Bo5BulTo/9NBykMuctthZFP1SZOTA6I1TVpuZ77qT08+7OdijaQeB6ZwWs2iThIvS8yPMtz7Fsy7Ot",
-	"s0JWXeowsu3Ik/6sE9r2yCYwTfYzULU92/Ns6FmBG3qO654cuWrTnrtwYbmhb4UhhH4IvbEdAWqyuDte",
-	"sQhO6Iih2gEMpoSITKzX/oBlLSIriJwgCl3Pj8bWiOvl+w596C8C34G+G/hRGAaWfUYYqk3ccxey5qoY",
-	"vO8EIwvmNaS+ASAOdKEbOJEsKRhFI6OlLc3dpuvYlu84TiTzvCbGo9q0bGfhWn5gu07oR4HvOSdFpLoj",
-	"XARWFAl+s/xodAuoxxG8bEeLQJ6r+B60LcudGJZqkwsXlu1HIQwt3/d874QK2YUq6ayBFUTRWcGmzlg9",
-	"GTeEQRQsIm9k7Y6OHWJCqWXDwA+kSx25oTc54NQRX5ZwxlzHC11HuNOeNTnk1Nl3b2FFIbQjGEV2aEfQ",
-	"mx516ggUsfkOtH3PtqAPw5FxyT6zqLOyJ8SeukOEEHq+G7mRHTiRPfLScctQ7mDTjVz3/AiU5UbhJDlk",
-	"sqC62HFdGAanB6G6AHdD1/ICN3Isz7cDCL0TAlEdI8hf+Jbrul7kRzYcKzh7vJPukB3PdUMYQRhBN7Bs",
-	"b2x0ZNjqN/B+CAMLeo7lO57juJ53QsyoS9UOItfxA18YGRG0J8Z2Ws5Vl7xvR7YwF0NH6K7QPSFy1OUz",
-	"13NcT2iCIPCsIAzOCR4ZIOjZMLRU3WzX9yL3jADSc633OGfyNKl2xLk0mkq279gRDEPH8R1/JBLPCyV1",
-	"mTrwwlOiSd35BL7rO77vhm5ohT50TilT3NWqln1+TKkU5oK+0dhs2UsDlpnZFGwbnEYR3XVWDNq6axN0",
-	"DY/WNpg0qmlljUrSJNoGpfYQj5pkzqDIGBSBBmejx7obdox7/XGTvztFSIxTP6NEw7CN1RejMIZCZsZw",
-	"y5BXeMQjPeYRmwyHXp+/35Xocwj6xFi/cWWKUL0hmy3vq9l6btnT3qKHn6/caLum4JF4epF0pmn+9Mvs",
-	"4h1+YlcZJyvTipBdu1BnmR4of2KLssL2jsY4Ielmn+G9rKWW4id2ucoOe07n8rtszmmMDvM1fpi78we+",
-	"mqOEryhJ2TzDK/qIsznakVjWR4M2dBZP+GE/piLaiBplDrCdF479woqGKGTtzFGSbtDeXLyMtL+10k/3",
-	"ldkz7fnL5XVfkWP8qZWTfC2XEqilBHIpwRY9YsC29CkFjGxS2cJcr+UBIAZ+WNwvwF2GGYlxysErmqIk",
-	"Bsss3+3/lYEUPyUHgNKU5ukKx4Ds9jTjgKOMrNcM0BRcoxTFSBbue4s/kRUFTzjDYI9yhmOwphlwIBBi",
-	"YQFeErmX4JuXy+tv1ShBhjeIpIIyZ+BR8OUMbAkXIgIg8C8WtP8CtmSzBWLfwUNGUYwzoPR0OY+Fudpm",
-	"p/CgXp87tT5LuT63+AG4L8DL5TVQtxHAlYYc+KDIgyvx6TsxIfA+LZdmqdZgUsJsHQAnAV8u65ymcy5G",
-	"weZ6Iy6nJsy2y+Lta+XuuC6Kpzhb41jDrT/79K48748xW2Vkr5IvLz4UKbOgPJzDab4Tg5AXDv9qi/9z",
-	"xP8JCSpTBFCa5vJM6Wd9c+AnwyKrVrrmU4R6vwT9xCk9c6e8amoGMf79RuX4Sa8N1eqfQqiqmD/trakf",
-	"OtJqYwKlbjx/ZB9TU2+FaRQarSimvlpvQDH13Vq3i6mvvq37NiNfPNbo4RRSZT+I6S/Xg7STWp5Oe6do",
-	"IzPlraJ/w4R3Wh0cJrxpbvRwMoHxL5qbpUx5v93dZsK7xxrRTCB1tAvLabSmS9BOW6PxrxpbwUx/vd46",
-	"ZcrbrdZF41/t78Yznkarg8ppL06ccqsD1PgXhzvunEFn+hzomWAfaG50ApHTlrPdSGvCm53eX1Pebba4",
-	"mvzmWQLL1Lhr8ttlQ6Hpb7Y7dk2mcK6U7bQTG/9q1R1t/DuGFm5TXm60rhr/Yn/fqSk0RjUCm0Cw3k9u",
-	"wmvP0d1w/OcGmrWNJdIXFzOq6LZx2TUcB+RcnyQa5x0ZNdGQB9oLZ7Ms7VHufcbbrOttm44vmlxogFXL",
-	"m+yXecck6khL0SzSeyTNsCQcIeV63MYet67PUzxmPA0Z6Ed5ZMg6OKr5x9rUww74gFE7SbKNsblGBU9G",
-	"usC97q3Bde05VTQojR5rtd9074ZxemJaR0TuMU10xBQ1RLP63SSjLd92qM0mjDGwYfarB31mUzRTH7jd",
-	"480OpxyZK5LEiMuCmSiO5T1/lNw1fje0BtOq8L8u3qLVxQs7isIy10gzhSzyVxV4/hGjTIroGfie7rCM",
-	"s1+tVpgxmsnPOAGsLlOQOxRfvLB9P3KrP21pii9e2NCyilYEvxhmfO5l7J4bqYKIqa/VedfD0jxJxKpc",
-	"vOBZjsdW7Lia0jhm9L0SvSASDCYo3ctz3/tyQGPKLfWc+ExtLVc8f5snSbfqiXoPfJfQB5SAe5youhQj",
-	"6jYNN5Y7f+11QaFVtcidmdQWw7joQll8hg6F5zSt++Inus0Ga7UzXdOC7RPCbx6Nla0GDr2dcUWPU7oj",
-	"KdIFp6qk3ZE5jPlOaJvWy9bJa37z95sJdcL0pKpBNOdjXEtOla2rKjq0FrNm8N2vaKuFu1FWxoStaJ7K",
-	"hBhtfHVedEwvSjslSUZ+pmYPd9+wBt4oLOzuW7bpraxcmRofzM2iv55T1CXvGck3EotGvnS+zNKzai16",
-	"/+aZB2qe88yAmp7VN2zjT7/MLpZ4tU3JCiWv05isCmZqycVnbQc82Nft99f9N2OkNWBrYY+8CXNeD09z",
-	"TdMRjXxNomlJdnidaYXePKgXPwH1W3VEb8XoYDx9X2YYsTwTPitmI1WGK1SGHY1RGTua8q3V6o09MpFc",
-	"vmu33x3/qtN61Z3wrt961xndZbU9WTi+QasFW8aM7Y1+12696rvjX21/1hs/ZKf1qjt+oZz2Z53xnXC9",
-	"9kL5o18N2q+ezrsa3CVSS9yVICogUexRsWjFLIohlftf7ki5Rl3ur5VAvF9t8U5x6tWe/AUfrnIuBTIR",
-	"kuDnHMvryMrwv0B78hEfqskh+cbFL7/Ic8C1LAWWkFVRzV+/9fb1UmcLVelPO8Ln+skFzTaXtTStC2OW",
-	"ILi6ey0EmkoNlRJCun1alqM9uXhx4SzgwpHBTL6VU7pEqtlr1YxV/HWjiv/QIrD6OhY6Xj15Uzz4nbx5",
-	"v0dCDHKcsYsX/2lelFpN6WJ/lSvKxNIiY/VUM6Wab9lH6V8yvL54cfG/Lld0t6cpTjm7VL+yS5131U9e",
-	"J3G1h1XzHswvJmQnSzMPvPmTzGve06K6uW7HvaIp144E2u8TYW8Qml7+g6kgSkWQcLxjxyZ4WwCj3KSL",
-	"Kn6BsgxpKDa12RVICOOAroEGAygBBnCdkPvpU1cXXqUAZxnNAF1JJzRW3JPvdig7yKQ2nhH8iIeIy9xH",
-	"mdANFFgYeCJ8C/gWg9u3d6CcFygnJuC+0OEdsM+o4FscgzXZ5BlmICEfMdCBwhkoUt7BHmdABuzANzd3",
-	"99/OZIxI1qIGH/GhNrSdungPkBwbXiEmqD8cAFE1lw/FdBjgFAjWznbgQPMMqOsAsitzjFdEMCOTvj/a",
-	"CBa50O9d/CSW6RI9IiLDM/PCRx9gwOLZm+JRxYKfH1fFByeiqRgvqOZ2Kor0RiMgRpdgjsuvsHyv4kwa",
-	"QOW3QM5IuikxVK4eKJdPYWi5JfJfAkWPJMbFVzK8xSkjjxgIN+WR4CfxuY0KAbW+NQMoSeiT+F7OcKZA",
-	"EeOUk/UBPG1xhkFVuBQI/PEMxTiW+FuThOMMxIgjgTC2xyuyJqvaTAR7rPNM4lThhzQwpQql0uygUfWA",
-	"EpSu8JxtMeZzVm+ibETWS/X8vXi8bJb1K8j3EXL0mGaYqAm+iFg2Lu80XtI7CuSOAlYncg4/xZgjkgjJ",
-	"ZqavoCcznlfJoQCtqgUskFxKaT1FIOcIXgkoKwEt0PpPDOTBJ5uBhKAHkkg2UMK3dnwCsPTZBe9sEEkB",
-	"SRnZbDkDJOVUMyVKD//KanJ6i1HCt3VeqNZmkBfmD3nycRpDvMyTj6OZ4jObKgcVbD/KcVMMEI4/8csV",
-	"e2wivM26XxCkSwGsPPnYQle5IU2p3ZTZSAGc09aopJRFq4wyBnb6ZKsC9AK85gCn8hSpkuRIg7iCXVGu",
-	"mgmBnSkLRwh/ZUQoE4Tlq62wH2TfjX4GUKBfgNcxRolkNzmarNBdbawrruGKykEse0zWa5yJ9agYk6YA",
-	"gQRlGwzYCiW4ziES+cPMwfluGm8sl29/m/ri1xPwy+XbZ5HxQGzGqSz0HeYSRTxDstw74E84ecRAOs8M",
-	"fLNcvv2278PDUpWvtqoI/fznnA75jS/Fo7IniDxkMvuNMV6jPOEFEoyw2dLMuNkPlCYYpV9qs2vnZZO2",
-	"Vy4W0It1jki8ShLV7gWoBW2KwQyjRAaT9aekSEHgicQYZMKclEazNF/liIQcWmd0B3boH7S8LvaA09VW",
-	"/FsKwJSstrh4vGYxS6O3Jih5hlYfCxJ7nMmgj0BWW+BqUoAIMSXM9ER4az/nmPEZ2JBHLfx2AKl7bKAw",
-	"v5sSUX2pYQpryg2Y/lwcbvbjU67kRAnGJomwLxMAyJPkBGiu8yRRcDnXoKzQpyCmMdjw5uWZn8KuMhKF",
-	"kMr3c07nfIvnO5LmHOubmTNQeVhpDFS4XilxgWuSyqShvPDIGlBQE+oAYa4EyXE4SEb//WPiVHn1GUAx",
-	"A3Lt5zJaUhNPpVxQm2iCi9wWDRo5owo6CMiMScBStGdbKgcvDDGFQImVwhbTyZYKXAW2GtCSkSiZEJjU",
-	"zCmSApqK4WUY7RJ5CVeLqz7ArRDbztcJfRrhfpennV9d7+cGf2dpp/GA2EUgdvEZvJnvhn3a6lNoxcmj",
-	"ihSVjCDmAcREKu+HNT1s+b7qkMh1YEldKxe6vcQbm9UClFqoan2abupf5hTgR5TkiOMBNyRnHJG05osg",
-	"8JAzkmLGhqxHA3MM++Odbfzqi28mI/c8q9OAQOWcN0OnpdtdhpaqkaiMQLNFWnPBS/Oy44hnxVmCAItp",
-	"ilLaz8AWJ3tpQla+e0n/f/77/4AyJ7fgBvnPXl6QlQ2E/urxok1wHvKgO2j+c3vPpuU4W0x/fq/Z8NFB",
-	"mUd3OxpLQM3FuPvRUT34hjD+pc54is8edPrttA2oxnz2EU8K8Ceuo3gFfelYqshz8Z3Cp3xEGaE5A6ql",
-	"ptRvuhsuEMpwc5iBHeYo0coObTKyyhOeCwNvn9E4X3Epc5SxWVt8IFa/Kd0wYzjlQglKq5KmMiIuD5Vq",
-	"Iys9WCVbqJBdWwwKLSk066d9QjNceMpULlFDYxbkihOdeLUe1pCvVutKJ35+tLy6vp2GkFfXt0DZE7KD",
-	"7zmaSOyTIFdqn46ekP5Acqj0RZVZWGPbb15d335bG1TLFdEH0dr0L8z94shZ7CjZ7ROCY12eZ0cfleQR",
-	"MNvjbIVTjja4DM+uilhMkshVqR+k9CiWGKsH5+WD/fuvH70unxxnGv3eUx6a8z5MA2WxvtVGnAzMe44O",
-	"Og8Ax00HthgiKPemkbzQPm2uyVT9NN8iropTPWCcVqOWpv0P97WDYmHwPFISS7mkRBDICPt4KDxrgc3y",
-	"YHpPuRZnlXXPM5mf1RZGcm01KPU1rQEsFk/8iY2aYg3UPYFpqNSvAizeZYtnwiRNQb5f0Z2AResTFV6L",
-	"YYMb9cM1SnAao2wQsWLOcZ7g+uDnGU6kIxqXCT5C8CkY1pV0hlc004/NwB4dCjte/GeMVwlSyCr+JBBc",
-	"Dv9AcBI3saoH3AbrvPzhKGqLOY9Gr+DDBuSO5Oz2YZfTSVS+4vjPg+Mig60XveXV7T+vyNVLcAJS9eqe",
-	"DdQy15Gk8xjv+bbKPFTwVQhoWAfFxgFV/U+HtRFJa7GUZm5iefqBqlyxevJkwwUqqKvYSwG+m7v7KvtS",
-	"YVEnSxa5lNIg5lSGUsCB5sLJPUiPh+4r6+RQP+UbAd/jUvg7zIsVKXj2qxD+9ZHdL4Klt4MYr6BWlEDd",
-	"NUVyA+xGeSxgrgJ4FYDrxAr0FsiNjyT2ohXPUSLBPpjGNgTcFU3pjqyOA/dGP/nVeqgDVy/KKcjVryro",
-	"fg6/rBicSlf8gBOMGB5pKhRwECMtWaEcspTQWUFQlhnGewHOdZVhQXZ7tOLKI9uhj7ga5LEM8uIzRZJD",
-	"Va9hLqsCDxgJzcoOn9NW+DLwavWmnAaw8mWgl+1ZktPVaV6beC0ZvRo0kKOup6GraweArtc464KuSIKp",
-	"f0hlF2pMqU89HMTvD0QK/gfKt7JgtE6u/UY/qiu6kH/KDfhWmaP4gc90tFMVlX7AnOMM5GmMs6I6vcxl",
-	"EoKdZsOZt5ht5jFhq4SyPBtCJdu8qh77/YOykHn1aU2EJtuAuPHuabhMyCZt30rRGln+VeG+dVHi5v47",
-	"8LoSQaomh8KomI88DKtiU/LQLE9jBh4QU6bBTfpIMprKajDJDNxTYbUqjfydeDuVKWHf3Nx/9y1gK5pJ",
-	"f6kyI2dA7EdG5U22wjotTpXFRGiiA62rjHCcETQoHNlmrs76BgGoShD8ocCnqyr8KsBTArEpvQSu9E5U",
-	"ac4tDDX18/13QO+Lgt/bo2qyAiFugpDVQLhpg7CeqShU9yCe+Hr4+O5mefslj+1ulrenHNjdLG+LE46T",
-	"d/mv+pRlTdIYcLL6KGxwfWoi1rlwEQXLqC0vbl6BpbLAb+Wmf3OzvGXftqXQ8haoiTUkkNSSnNIEMHn6",
-	"spbZWTqgLd4vPWJBVKhCvsUkk2MANNPDbGwwX1dbeylPibLD/AmTzfaI1FjeXqunfywf/v3Lj9akpqNK",
-	"ryBQK8ieJV1KZUtt6ZPaVWky59K3U2IjJmKVHnJeXDQqDofrVyPEsEg7F1WMWPeyEGAEevLgKkmoWsk2",
-	"7srD4DLBpR7aoakkXTv+E/aaHpOiiWPhERTH1hneqLSsImflQPOp/kADwVuaxMdw+71+5o8AVz2X6UAt",
-	"F+qcLBFUu0KXYfQxpk9pkaeut32LE+V4krQHvl3JV4dksVkNf7TKsy4zEba4Fg6sXTYVwlnKQM2RgGiM",
-	"0oyvaUJo3eRv5SeKGUg5ru87laoayN5deNOKnjShWFQ86IPh63RN/wgQlFVkEzwdgnv1InteQ6tAhBls",
-	"feLvrXpIQu51TaJJ1JWB7VoGjMR97Zpb3QCYKZCIrZsB/GmPU4al8UdnBVtInxLsUIo20ppXttmOZrgf",
-	"UCrHx6ycWxV66maEfAuUWrp92bvDuC3pLVfyX1kxcHkajzIMisZ/gqEwWm11CtIC3NIM6PInYlZlHhDY",
-	"oQN4QilXNQPEH+UNGPmB4pRfjEh2ccKf9tIKlxdrthhwvNqmNKGbg/4QIHK4B/CAE7k5kkLPowzsKSna",
-	"TG1kfd9FVZ204km1WH8si6Y+pxPMZLWCNdBN49YulNc0w5/aXkSL1HBuCEoSUJR8BHtEMlYEtbUmkJ8o",
-	"wkLNWhryl6IoJ7iTLzfvaqexvsmlmaF0jui681U60lhpAk0OohiDHMKX8pc6X554KUauXnMRTsSDJKUR",
-	"sRGbx+YJ4pjxeYqf+s237+Sjb+ST7/DTeAb93ad6yZmLKf/6RW06x2GCU9Tu6aNYFekobq81WVBOBKgt",
-	"BGJCfYVIxAs7ymT3PsFR+V5lIUhDTM2qHlEpExMKN7x+kJaQVF3mFERpRjYkRQkQUAOM5tlKZt5Lrlck",
-	"mkmNbAY2OqVsTbKdzI6Xbos6vpBiRloGkvPZlqyVoSnsVKCvfsmv6Kw1nuVSdZbN9AZq5GyJUJ5khZL5",
-	"aosyfml5O5L2Msj35ePX4mnLe0vSXyEr4lc61uuhktIUxf+Qa/4buEL9SvtMunXsJHau0AAsb74jqUaq",
-	"LCp9ph1dR377ummDgy1v/lZdU32dcpw9okRfU5SQa9nMpYdYu5BIUj16QYNoGg1+pXuczqQlOAMJfZoB",
-	"WbKyvBarlbR8VA2zJkCIjIkV5SQyrGQRSWW2+gFILlKugboeI37d4ZjkuznH2U6vAM+wDK7kZS2K4oMx",
-	"OjTOzAW9LrNKKnNM48t1niQj+FV2obih8W2eJH8ejv1iF8PP5Lab969qrAa+EZv67bkcJy8ZmxlOarT0",
-	"0NBorVDJdcM4Vhx4VxL7myJWFSP6DvN61E5FOibwXJPfZnrc5QX1mttouLSuvVYcA91JXL/+zd9+vLr7",
-	"dhI7JdKRGc9Psk33V4Z6Voaqtz5/Lo6S+3o2S5UHI4XCkXhSn2iyz0vEyKqruLp2aBX1kbSUMilDRHUO",
-	"ElsyK67Xd3XUTHiL8nVe01DS2ZSHN0r/1jxOqXV3JCW7IvFRniwVKu1BzqAjP5SC6+MpIluojLiXr3qt",
-	"fL2V/+zM01rYqXV0xMvPVwOvCqW3KVd6SKUOVkfWEuKPiOP6nwQAZcy1YSp+EORlwXA1684FfuV0yVBw",
-	"UdVrpmuAKfKYE3WzsXF5p8yI0Gaa/KiqoiKYsJUvPJSn0+aH4YuGrb37ehF/Mw2u7PxyeG0gGWsxZR33",
-	"wwxvksrb9E3vIcaMbFIdLZZl5OayjFwhglUJ0vrBUTNOOaZ0YyPlp36PQhftlc0ENV+UhwiaC1LM9XR6",
-	"bk52MD10H78F6T/3bfzuYpwnnj//Rfz2F4dlXYw/zVc0ZZzwXABjMJlHllm7rp6uMntGYEOu2W/uPKQ9",
-	"pVOK2NXW7zkKg/cccihrsEiX1hXjdGKHLsPcV+xZ2bSq252qlVe7zz9s4dazOcqzzS0uDzaLM8167ajy",
-	"PECIS3kUKIdbJXWUxcKlCi/LAnzEeK+PWui6TAnplODrr2+n4Hwcwl8yI01+cBqqTDv9FVe/Kq4YiXE2",
-	"197bpTraGMCYfHypnlYHG6PFpPbiz44S/O7Pt6pVTJk6X5kqm+X7YuPrBM7xjWrHWjXqca0+0qHGIfpE",
-	"S88DaDjUvZynLVlty8RQTVIVxH/IZf6mLL+XJGU0oB6mxjqRqTbBFoYbqO3BMpPppCOxrHJPf41WJ58R",
-	"zT1vqiuiJN1ck48XJwy5ti1LZfr86qbOb4ehdBJzLxepSzuSLWjWjXbr1+usVVwcuiVpXGX+cfXLDsX6",
-	"IlC2pxniuGS2xu3gT3iVc/Ko0wSLNhIjmOojPsx1j5ReTvoLPrxVj3yNnD0XpKs1nQblj/hQ9LQ5VydU",
-	"Jk23XY66lV4AuaeHz1/wAehJKATfFIUmS9+/8u9n4O7ypkwd1BmCQOemMtasMl1cOsgwIDLJrhY+G/IK",
-	"a2geDBRUq/+ZYwRfGEuTnfwanIB++XltdYaLum91v38u/X7t9osx1He/GE+JuuXybRdrReMTfUO3SMev",
-	"h6jaoVh1UxLg9ZqsiDDHFRAT8nNOYvlI2fxH4L+MtxVhsuLCZKeEqqorucXq/vwBo2wCSIejtI29/ZKF",
-	"4X4DoFpucWPfBQ7KWnG9YdLBEFN1IJ3Uy0JXHa50Tr147gExrAoKNtuTZITLU78KBnK/dIq2Hmt1fw1V",
-	"hvL//Pf/YYUZXntbmmszdVm4m7tvKNBRr3Bq7J1TC6AqZ3HevCbcCzflEV83Hv7dy0ddUq45qzGQfmu6",
-	"Yl1hSABFlxPEsTb1zpegMlfQ+OFWqZjSzExlesWGPGJVlMiQXCEe0LNpLsPxeIecbqWnZaiD6OxidU+9",
-	"fpO9YKB6bKYKcyhu3aB8g6Uk1X2jy8RDfTO9v2adEc1z2RRgEqZlFfqeBiaNXRPQkLEmfR+SU7DGfLXt",
-	"2aICG7JX/z6hMR7shDK91cDsgvGDah5Ks93FH4+BniFeOLw1hm5RpvYlTaNXdS0Yw0FtvVTYsTW2luxT",
-	"i+dV42BEDA6lmOYsOcyqPgUFP9dNkYLjqsKn/XyT4id2qYrKJ/MjQUCVjC4fnRgB/G2lyv7u44hiI64y",
-	"TlZTU5NkMvh55Xa6cUONHkUcqWExlQSOJJSx6hygk9BbmfL65SpJ/v0DRyQVtkuckBSzGWAp2e9x0cxA",
-	"ZWooDvvhw5uq1EDlW8iRyDoWaNM4P5b3LmrAl8poDOzlscBX0P9ZQd9TY6pxH0TLcPk105mSRPgtxnHD",
-	"TTXCvA1xnWjXLMBQ6Mn69ZHj3NfDDfqeai8P6Auwv4LFb4JE+dxlNa4P+m9yj6F1/NUbseHVW4PNU0tX",
-	"S33OcF+gsKX1gGo5yV0TulnsUTaoLha7dYw4sgakGEQV3TBaObP61YdZ2eRaR9xyYbe2LuY27QQ98+GY",
-	"hJ78tIwxlPHfrGd40r3vFljOL4VfYOp4iKNVws6E2jIbrBPsbST8lv20NA19GbwFowpqM32LtgMtWhTF",
-	"G2Vc98Qqhvv7ndLa72tnv99NZ78xPf1Obuf3x+nmJxfoTCSI3Wy22pMO5VRk1Nr31Qq/1i7HJeQjbrfq",
-	"K+4PSBu/fu9Go4VxlPIqqUdFQvswoy7NDmsrVXnsS4bO5RqdUrWtqG93riZR3zZ09VKqRG5oU4foW87d",
-	"GInO3TDJ8OpiZNPSKW4yq2LUK7rb4TTWnVkKfVME/vSzM9kHpvXwcc2COKCtetU1baJpzwuMH0EIu9fP",
-	"/Ylzhs9FbilPzq5FV4s2GzsWtqtZlJho3tnQwyq2ts9U75xa1jqQdoEtW4vWr+fL3kJ5Vp4azarjnyLG",
-	"12UROnBTXv0+CFj6NRnj+WyyYmPUwk60zFq7erp9VpRtOXbLoh53KH9XQ+8DeOnits7E62ffaVw7GteT",
-	"mameXNXZTXUQVB9m3bE1nU22e7zpU9KhU3L1/cEsDjXlP0IGRzmT86B31ml7q1XFUMZG57uNVI1hJPZl",
-	"e3RzKOqhj4mwHQes43Yj/dJZF78FIAgr8kNJprIii2LmabneHDyhw/jki84oS0XKFuA1N5qpnWv+rZto",
-	"MqWiHx31XJ8Eq5OCWXl0raJvhaQT9mipt9sh13a6Rp/NqRLf5htMNxnab8lqzvBGFi8ezLv4oN77rnzt",
-	"vvbWr5G1fKK67iHHeJaveJ79BnKJ9UrX13eiqav767AWhXNiMiXKC+JlKUNZ+rhCky4zWwlcPR1QIQfo",
-	"qbWKwW3pU62Krq5Wqyq0q4K7reQ51Y+gGE/jxrLM75IOOmtVIeyVuZotdJ/WSTxxp975yhBfGaJkCI0j",
-	"kJAUd3lBIwbUp6SY4Yey/4W+wVI0DgZxRh6bpnetDVIMNrVglNJAhpKKtVBnMUC9JoMGibrHMlfI6OEF",
-	"dW3gHdpNCIArsr8F56+4DPfrg12t4ymF9neIr7bVbaZz74+syxr2VY2/Rp19bdkWhfaLKr3yAqHqANOq",
-	"DS0rVuiGsrXAQ3UDshEZEVDqFuTHabynJOWASCNsnau6Ltq8k3Zd83T6aYtlYxLwMaVPKlqTJwmgGdij",
-	"TBWaqS7DqCrUsoL/Q85BSnnt4mShXkizqr++5NXgFA3pYV5RezyaW4r//MolvwkuuUGMlB0ptrgJO1UM",
-	"tDhpMBxPGPpN6H/XbmcVnSQ0TR2hKKPM+gKxtnP6IblFGWbzdUJRf4D5Xj50K575oyRT16Y00VUVr6gW",
-	"f6fDo6bHZYJO4fAVJ5CIqxb9MjPZAJMijUPOAshZyFfflITqp1oSgNIcTvPdA5a94to9CNWEylp3BchG",
-	"l1U2p2OwfUI4G+qxea+e+LOeV4jZn9CTUK7r2b0027Zqq3+IPiKQG9SXu2/KIJOTAq+0wh+Vn9/OElLz",
-	"k3cBCh5Rf9L375r5+FVXuPIsVp73Vbaw6shZOi0ArTnOStaSpAd6b6olGNUyVsH5a8PY3wbAm/mQ6IHm",
-	"vGqS2YC3SQmrX5pdOI9eNKl3pG18oa2fa/XaBrHfQrywVVVBjRrUW/Bm4AGvqcrZP6i1GUK3Si4eKt2i",
-	"0oq/YOmWQkmfYMEVkunc2xjagsuOFXGpAqulT2HU1PrHqkZL2VnhIaNI7HOq2nw0T2Zl2iXf6pv68opf",
-	"9UWSij1XwTKZSls4Te3qMU38yJs9+kqHdHfKb1f3CCvnrA6d4mJ+od9lfw9ZFJaksdhzmrHLjJFeJC2L",
-	"F16Xz39g5FeLh73B6YZvB+np/ikXLyw4G+0KcbLDazGbk0sMLksKf8qqtl2cTJMCBpydXfvtAxZ2uazv",
-	"nEncqMJL33y4f/0tKDkBlJygbSZ1r7Hw0mSC/E6G3giN67xl4KWCyzKMWJ4d5pnsFdHLW/qxD+Kpr12/",
-	"BYrqKzINQMWag+ys/hydnFUBgFrl5WXjM+XN7h3iRWisoU3Kx+WM6gWNPsr2S0VBLZ2LyHGGVWuOqrCY",
-	"IKV6iR5624uKKSj5f5C4udqTv+DDVc63Fy/+8yexdwxnjwWq8iy5eHGx5XzPXlxeltppR2OckHSzz/B+",
-	"saK7S8aFZrn45adf/v8AAAD//xccYLDw0QEA",
+	"H4sIAAAAAAAC/+y96XIbR5oo+ioZPOfG2BEEWPui+UVRpM2xFlqE29Mz7XsjWZUAslWogjKzSKEnOmJe",
+	"40Tc+3LzJDdyqT2rUAVAlGyrf3TIROWX27fnt/zXWZRttlmKUkbPXvzXGUF0m6UUif+4JiQj79Vf+B+i",
+	"LGUoZfyfcLtNcAQZztKLv9Ms5X+j0RptoPg1jjH/CSZ3JNsiwjCHyEiOzs/YbovOXpxlD39HETv75z//",
+	"eX4WIxoRvOUjzl7IaUGxkrN/np/dkWyJE/QDYgetBjO0ERv63wQtz16c/a+LatMX8jN6cZVttjDdqanO",
+	"/lkuFBICd7p1FmsBS5JtQLXGM/6pAstnfQkTmEbofo0Qu2eQoY1a9bZ2Nv91BqMIbRmKX0Emtoc+wc02",
+	"4SuwDMuZmebMMIHhvTDMF7Z3Vi6PMoLTFV8vjKIsT9kd3MGHBNEGDC8IPUP+7/xsmZENZGcvzuIsf0hQ",
+	"BSvNNw+I1GDR9yhC+LELz7YdcyI8kqP4+tMWpbQFazSEfJMnkKH4HVsjwu+LoDVKKX5Et2mUbdDrjDYh",
+	"z3zTt6asskJbiOPb9ApuMYPJAauN5MjXCFL07iHBK4GbzdWZlmNPOcMemFc5IQqhKtCebU2CTNeXaXwF",
+	"6fr6Y44fYVLwgxKiFYaOPR3k/TojbIHI5jZ9RJRtOnA91/TNKXDxh8b4Mz7StgwztHU0EWWbTZbesyxq",
+	"Dgtsy/cmTBvridIIZ1ZwVocAWW18tY4YLREhKH6PHlGaN0EFlhNOWUoT1Nss1WKA4YfBAVAX8NNrDB9w",
+	"gjlf6gE+DuQSJzhdDbGzMSe3xDSCyV8RJF0ouu9XWRY/4eQQoi2GXqbxbcpgusIPCbqkFLFDGBY+BYhH",
+	"lLKM7BpjfSuYgrpJlq44Bb5CD81bDFzfNQ4A1EfKoen4UxB5g9OMYLa7TRkiiB6CYilinW35njfpfFLE",
+	"+qSc51mTmF7GBdPBty1GK3LTADEdK/CnLkaBq5F0A6ZrGL45FWYfsAkgKr6i2ajr2rY7dVEVxL7l2a4R",
+	"GlPBapUpy/OMyafWq0lZgT0ZxxYZg4mQaussiRGhXGyz3QHXsUUEZ3Fj4NnNX3WMdUuUiOhK05FTEbSF",
+	"OKYHDRWa8u4ugSnje91y9vMWNSnfcb1gyg0TtM0IQ7FEnKh5fGe/3L/SHQNBDOIUxdeQpDhdtRROMzRd",
+	"Z8ISaKEfddiYZQR+eAikPvZsu5Y1RSOgu81D1hSjZ5eXd691h8LgJx3HCp1wyhYY/HQSemOcOjTrsT0n",
+	"DIypgAY4smuFgX8gvD42ZfqeHVpTgXbQxzQ8zwqngtGwEdcL3cln1oeDpuX5xuQT6+XoRmDYxhHQLtN4",
+	"0bPtw3BlUK5ZphmG9uEgezHGNj07mApXS2mh63qTKW2PHJqMQARBmpPdYWJGcOiPOSYoPnvxn4VVoXiZ",
+	"huVLi7JhrJw3XTENE6QUmL1Gcw8nHraIO1qo3gOjUSXqRkJNxGq1SS1DGxKuNWtKY88MG0x6e6EuKvp0",
+	"wT5aaurXTTbfRuquN6yt0HV9Um1pvNfj0pRYXSO/XwfvFwUte23I3B9htA8qx4MMRqPr957HmYZPt+m4",
+	"oz82fTMavarfHTfeGbhHXe5nYE15qLFV9woTjSSsy+rKdv2t4xI/17uMF4s3E73G7swwZ3bhNbb8yV5j",
+	"M6y8vJN9xFbo2eHQ6CGP8Gn8v54fDKxgnLf3IN+ucZz/1jjMSWsbVti/37EuWdf2fbcfyokcsI7vBb2T",
+	"9LhbTesU7lanUnEOdK4aJ3CgTnGXKjo+0l3qTnGXHuMcnewKHen49EKz/+b63Zx26Hp7h/URQ+C7od07",
+	"ep8Lc5TD0vMK/diY5p7kK+vf2YAzcrLr0bacYHiiPTaKZ5quOQxhwK14gBPR983AHp5wv2XF32X2HLBW",
+	"bhpTnYBW6Hn+8EQjXX6jHHw/m9MdfKPdeYc67zzD65ddn8NVZ1pWP0r2O+ZMK3Ds/eP6GIplO34/Th3v",
+	"dDMmONaMKc4zxzGCfrVgn6vMti3HGDW6183hOLa3ZwGdywo9PwyHx2joyPN8Nxge1evhMs0hPB70Z1m+",
+	"b1v+6LFD3qsRl7XHV2UEzmgA/Z4pK/SsYSgHGB5jvE57rnDIx/TNo/TNo/TNo/TNo/SH8ihd3f50jyCJ",
+	"1u8RzRNN+OF0u56HS76Fm5a5erndJgjcptFcO2yK/oY+RWuYrloTvL28f3X589D3N3mSdBcmx4EfkuwB",
+	"JuAeJfxkNFDGq2AtyVCKhPrZFNKgs7bqT2e1Y9Fe3S/3t3d7Lu/Q26B427p12w9s2zQM3fcbSD4gdgW3",
+	"rYdlx3Jd1wpd33FO/LQ88ojFPurr054jpOubJHt6hhhcqtdqDOuUMbgz13ScSfA+5pgK/kfb1tekCFQh",
+	"32LMctI8pFnoOP7UGFH2Eq1wyhn0u+Vd11a1Dd+eDvM6jbXQJkexCgK9TX/NyAecrnQeYttzP3v86iv8",
+	"iGOUxpRLrZYV61r2FCSoichbSnOYRugQRKigvEfbnERrSFHcRoZwWlzriUJspbBewE8H7CtGW4IiLJSQ",
+	"yzS+3GSE4X+I/2xZeY4zJUQNLZcoYu+WNxlBn64EUtF3KWdIv5u42iVBqOCgzbMwgmBSTAcuLgh18dny",
+	"TMOaBEtqTx1AxwTYzkzD8SYtotTIbtO7uu1zmcal+XMUqyxU+LdSxdOS7iwM3WBa4Cu/0DuSce4Sv9zd",
+	"4BSmEU5XlxHDj11zfmZaZhjYx0whldf+KazQdo+B/26LCByAb5qB5ToTZ1BCoEOuMz+cCmoP953MNNMh",
+	"fHDDcCI+nFDQpIhJTtz0x9m+PTE4+65h5R0htFLE+kFMPvmswDQ9U5yKZ8Kq3EuAbmBYU4HuI7mZyePo",
+	"Dgi3hnR9W2TWVdAsHq05EdqQehVYU9SrA8KZ+3F+5JRK/aHvln1O4ZkTeK73uSOTKUwQfQNZTsQl9y/H",
+	"s+xJ8q10Yg1JH3tagiHllPiSa43c04JSqlOyvODzBCw/n0u34oLDyqVOfe09pF67pM/HW3fodixUPQ3q",
+	"6HykvB2tEHVN0l5q2oPdA6xupBLSFaX9StcARWikTK/g1xtjvcbVkDDUCG+97djL9wYk0EhNcdDK6SpS",
+	"OlO93yWgk7daf0TLUOlaGy2bYZSj6LNG3ul9RXYQmqf0FYWu4X5dvqIwdE/sK3LM0HdO5itqBNOdxlc0",
+	"CyzL+bLOIs/9Op1FgWOeIB97coDg8ziLPN//Cp1FnzGqsNdZFAbTiGrIV2T7ziT79vP4irxJPoCDXEXT",
+	"OOUoV5FphJ7/eX1FtuF74ef0FZmF2frZnEVGYIUncxZxNuCe2l00kW2mJ8aJE8qaHn+RZ7rG1+kvmnj0",
+	"e/xFU1FtnL/IMy3z9P4iz/JP5y+yp1Wz2Ocv4hnY3pEOoz3hsc/iMCpDwr8Kh5HvWNapHUYn9BOFk/Sr",
+	"b36ib36ib36ib36i4/1E/OBizHb3JUdphWX1cON/3xft1vkx7UR2vUYwBT9mKwpucr4jOjaW7cfrngUw",
+	"AmP0JktZU3E9e4Wi8YFZqYzIqsHaF90mI7kUP6nZz82jjHssWGtmOGMs2L7QNcMILNu0Pr/wKE9IrXBP",
+	"rFqzGmXnOGAcE9TKdj17lyIgA/7uIPkAfoU73UnAR0TgCv0lS/IWTnlm6Hhzf9Q5PCAGm1J4bo0L/otQ",
+	"1lz2GzIHC7zJ2HoHXs3BVZZ90Hq3urGgztwPJ/jm7hCJUMpgC4o1Nw0j+CwOuXZWwtlVLi4xzU4ZU8vl",
+	"NOkwmaPDb6dGh8ZoCfOE3W7aB7yECa0Kvz5kWYJgetaup6rfMIgRxauUnoMNTPMljASvOwcwjYEkIQro",
+	"BhK2XWcp/2GLCM1SmAB+mjlDhJ4DxjUYRs/BE4KE/1sB4KoZpRnBiIKnjCTxE47RHCzWCKi7ANlyiQgF",
+	"+I6DPwcQJDhFIFvWJ/1X8AZG9d+6a/hXgO9gXP9mkycMz7Y52WYUFSv8V7Gs2irX2QZ118rBEkxxugKX",
+	"mNxlMT1XhL/4S/GvXyGL1ufgJYKMgi3J4jxiats/Zht0l8VzcMsATGgGtlJgUzn0ChIEaL7lyq34Pkqy",
+	"PAYUkUccIbVGKWYRBY+Q4CynYJtAximIngOcRkke89WxteBI4J5lBAG2hnzCJHsCUU5ZtuEnyzIQYxpl",
+	"j/P5/OsJAl/mSbLAG3S92SbZDrVM4DPT47a6biDuYP/ZmrEtfXFxIX6i86XUiWCyyWLE7YQtQdt5lG0u",
+	"pIi44OJkvk1XWvBpnNMOsV9lKc03iIBrvh+SpTjSqgN4mxWe4O5vVOhoKNktCOSX15iiUbq5RsGYXsZk",
+	"HLFjes2WYz+9ydN47Lc4bbOzkk1pndMJpKUO3BhpzMNx4uRUyoRgH83Ff+cYwfcgDL2ZaZiG3g+BW5a0",
+	"ZVvzcfKLdCnJ9Jy5Ecwsz5hr3R4URSxrXvHZAkXrNEuylVbBoKyjq11dHpdpcX722FVXqlQ9nDK0kjt8",
+	"Qg8Usx4KfHp6mkPO4ji56Wb5R1vgha5hOuN1O3k3dQRRmlIL64qbKLUajYpS7rmtsnWzH1ruB0ESVVbE",
+	"cOpJyVGqs2sKZqmxlYhQ6Rs6Llng9HmpoSotqEALecYFn6xYUkt9KNiFji8VXKfkEwMK9H1EEEoR6Uuc",
+	"OUKN/ezK2pDk2y/BitEiO7RPEH4GEXOgGPkssoHT3GWa5jDR8nvzGGbv2K7hWbZpj2b2Xb5thfNgnLl1",
+	"ABc+jrk6hmGaoXFAyb2eHK06SyxZSY35KDZZcFDN1dUYYo1/dfG8zqEqLiKwRoefQ9xjDQmiN0kG2TSP",
+	"BDB9kSOmfxzn8CToVjlRw3KskOdS6CTbkiBULqUcFIbz0AjdcW8nOaMMpnzX+ultVXFWN/3JHB/VPppH",
+	"oVvf0N30+dz6+PLVGqcQXCb5Bqf5BlzBlIIfs4TPRsFrvMFMOE9HEZIXhMH8x58Oy1fUbkrYQ6+4IZSn",
+	"/Emh9kjY3B9cMkQW8NNVRtm7Zbds8Nz3DnfgOCcJ7TJDO7T9wDEc+0B4PZ6amTUfieqROB1NXYhw7pqj",
+	"IWTd87XnnjMyOuiB/Yrwas3aQtCaB+HkAKMmBP4Ea7qO7duTAfUcrT23Ri4KJzlDsSTQdxXFtniJYwSG",
+	"CFsZBRQ94BZfM13PcX3TtX3DHQuiBSGwg9CxHNfynNEQ+lyE/jwMRgOJm4RlGa5rmXYYerY/AUbfRZlz",
+	"aySYlCHC/UToLzBpFcGz3MDzAt937MAYCU3Qkg4SjzAwD4N0h4hApFbzGmseToCkp7LQn5vjKL3+ELQw",
+	"W0sJA9cPwyD07ElBYpoazablmIbvBFPh9KHkpGCwH0j2xNbv2yqLM0EDfo/phzuCNjjftJ3w1rM169jK",
+	"1+hWQS7LN8LAM1zPmwKk52DNYO6NQ+QtQZRHhyOywSlMNLRhOL7v+p5tHGEkjHfu9MR+m6brurYT+qHt",
+	"TgXUd0b+3B27qG4FTs/1Td+2PC/03ClA+hZjzMNxiEww/XBDEOrQwWipTvPN3eMvy2jZqpgahLZhmqNZ",
+	"4MQyb531Ws7cCMf2VOCjO+GIphOaPF14HJBeDLfN0PRDI3Am9kDQFlIIbc+2XdObCKq9M9cxLN83eLn9",
+	"aWD6OK09d5zxkD5fKwbb5d0mnAknlLcx1fR4Ib6Qx2CPE2hPMIpaCrQ9jt3vdNHi4V6TSQxrBFkVpa+6",
+	"XKBUtzQ6k/zTWUeta6nVvcpxHb30ONLkuH1ss6kT9En2SrLpxFNPBMug3VS5UpRnpVdxb9g6Fb8515ma",
+	"LR6q0w1axlezRBXr1rOqIsmaJlNXvVPY2GRqhfGgkL3OorXqT5ub9YjxrvpcKTBNZViv0HbUyn6r/zV6",
+	"RCLO6pvx/834/8x29pBJ6Aeh6/uB5duhfZxJ6JtWEJqHQdKbhL43n2RdntImbK2EV+91Xc8OwsmQ2tZl",
+	"4PiG4/qBN5L2/mjW3J6ciNDj5bo4J7EOA9en0IVjvTn7LTzLClxPFQn/7Bbe9vF1R6HjjqXQcS1npHPp",
+	"j2iQdU6Fd7EyfWs8A3pGg6zflnJ8M7Qc1/9ittTXZLp85YbHIQq5LjR9iGd1YtT/uBq94mx1ev7qtfdX",
+	"VzfjX4X9mRFqg2ZbnOvM9MO557mOFwS2H7q+qw+xyqIPdx1JcubNXWfsG6Ygamt+/x+TX3BjeVPVGrSn",
+	"gxJMmcxIguluwkPty4x8pKCMbsA8DrYvnCZWs7zqf4y3x6QH6GNt7t7+tCeMsj6f6c94IN2odATdhbx8",
+	"//P9zaElcOsRXbWQqtrp6C+JQZxwizONdWkGUZLRrrIyMuh+OEDCfeG4L/QxtGu8WrcmteemPVJFfuqs",
+	"1wzGqo9pZ6w1TiHSRND4ruGGxjy0HJPnqZsHN68U65I7U2dzri6mnPc3fpcj/AciGwzcaVRQc+6PtK6P",
+	"zcRpczzT8edc9QwC2+SeZO9583AkM6sfjJZQVBDS9aO+bnT8956IYsN8nkNFUQKJ8GF2WZN2wFHLXfIT",
+	"7SaS3KMNnsmoLd2cW7jboJSNWyBBUUbicd/qkMF8Zczvb3Vf7zBKmvv2xpH5Hkyqrbi51+71nDcwpnYb",
+	"xerqR6zDx+vFzZUMcpPKlE686gJNU8xQDERhK2345pOA1mPXnYX+3Ar/r70kVoXfdcD17EXFYWkIi1LE",
+	"xlD5IYkAUtPtGmKO4zuOH9pO4M+dcVZ1Nz/z8u7u9TW4fXvVE1SfE8x2VxNTrKhQ9d/KaZuupMA0PCMw",
+	"D2ae93faMpn5lmN33C/HwxeG9cI2B8ZeMn3pIdMDhsvDJPWa8SAq+nNzlL+jj2QlXpXpqipivnktrePW",
+	"rKiJQvUNl//uw/j+zE6+sqsEtpM7KxOrfVBiBP0ljRF5A1O4Qp0SQp7Ns1KEGjKy/NvjSpMf6nh26Dkj",
+	"QfTm9vHsugXJKQMUoQ8iAQxGa4weEcCMgqomAJAHhh8ReNiBtWQRAIJtRtgyS3DG0+iYzNXbZCkQBglV",
+	"WWYEqTQ0FAOcis9wGqNP4Dv+z//57//3roDyP//9/31/Dp4wW4uv5CVz0AhGawm0gFCOATR/4NY2wzBJ",
+	"diDKCEF0mwnrm2+oCYjmbUBiKXqDJtvgCCdoVIIAW9asqzopv3qv/V72JXrP5VBb7odOXQIuVXxu51bV",
+	"LVAhfJouN8Muv6+FDeM0Qlu9WsK74XM+YFljNJxDEwu6QsEPHM+6MQ1by2K7nJwfJ7j/W24YlncHXMMA",
+	"14sbicJ6AI9N4jNGOzNT+DitgIuIo6evsew8iovqOk2Ogj5tM9p+pzPnoT/mwvXZIC8hxRF4AxkiGCZ0",
+	"r0aAqzj/cjU6xqj+AAmBuz1ysrzFsQrhsIC7ZPpygoa9sIwXtvXCCeaBb/9HG1VnDG+QXnztSUSjdAVF",
+	"2mdOL1B6gVPKMMtld6oLxJb0gm5jMqPbmWsYM8SWM8ZRbka3u8nlGJr5XEra1SRNV/SVfKieFlZjOC1u",
+	"os/T0kumuniR5NLE/DaXacrVOs73yNZ78UmfYqxLpdmLz5NQalBzaRHeVMWlTJ0Zq1r35Ul0+dyvmMbZ",
+	"ZkEQAm9wHCcIXEPKQGGmAJVBM+pkfvjltd6V1V2i7M7WY1jH/V1ORtnFaMvRMm8/j4x9SkBbek0Z3nRU",
+	"YGfcLco8pl96dGjJYEbVK1VPEJq9uKYpzIXxlcIEpJ5tOV7gO+OB6a7+p+ur+dv7yX6Y6qZax97evGYL",
+	"zWPWEkKUpZyjXdMV95MlpUBsotv1/Q/3UdYSla41H2lXjSgJbYy57efpxXdsDV2UPmKSpZynw0R7bO44",
+	"KuOfLHbb5vCzYPaTvmH9IyIpTCPUndIz5v7IZ+MswrpFO+5Yb/GUF+OcJAN6AIrmq+zx4pJEa/yI6AWK",
+	"V5BcxJDBC3nnF9X1W7IuoF/704xjlvjjTJoVa7apX19O8NjKdq1SddVTA/7QeXAoL02LCM0T7t7aeUVq",
+	"8nj2EO17yLT+oev7H/hLZfVzTaZ/OcrqKZfMyUtvI2kzrt+9vf/lzfV7cP36+mrx/t3b26v7oXzt9zBt",
+	"7c0BWS4MUPcz9bnUoEVN029UOmxeVGvVg5ff43HvSsIZN2O9CfWgOr5v7/hiUqYzt4KRcHQe2n+7G1+3",
+	"6d/06mdfIz1bNK+0X9h9725ICVQ+uLv+NE8S+NAxtqv9oMe23+nMnokacOAlThJwmUfKAOni8GYLo9bY",
+	"19mT7tMtQY84y2n76k3TOviFrXJYyz00amqotWnuXYu23QjLTuW2xWlibY5tmDgUDGq7vhk4ns2buhrT",
+	"yiS0yvhVIJ2Qh/9MAYnTnF7pm6If09VT/uvdUpsEbzvcAd+XBK+Pu7Asf+yj+MneLWtL6eyo5zaGT/S8",
+	"iZpd/NCiey1uQvv+dJXFo3yZ6vOupG08WXFhdrlBBEewJxoEtnjkewSTXh+NPurj8s31v4/zDL5FT+Cv",
+	"GfkA5PNxcRjgkujXJ+/wPl8u8acWpIvLvRhQC/FIG3UumlUvxIm35iqOZugKf8wSHMOd9nH7Ksko4pV+",
+	"WsqT+cIwwN2bfulQ7R3Gf3+3RakGSPjCNsDlKCA9z+TcZHFPUTwOU7HTeJRvOdWUmCCYsg2kU+5S0XP5",
+	"EqVWMHRVbwR1/5jlhOpjdnC64r+2DtqRtwVm4l+nOS+5lHft0JnekjzpnpJ8Ep5urmyLUv2+FAIN7Isz",
+	"gH90aq0pTnLxFj39P5yMD6DA+qLOG0dfm7R1TrqbvSmKAhZODTrSFxZOkPcPmF0+thJIHMOzAscxrXB8",
+	"bYIf2/FZEoobeIHj94x5nT1phoie8VOKInTW77qha9mW7zjeBDDdHQg4ThAE7hQ4nV0JMKbnGbandye2",
+	"NxDOvWCsK7K7amNuGaYzGkB7ueHccI2R9c7KCvVdFAp56SA39IxwGiTNLfhm4No+7xE0NLBz7HboOB7P",
+	"XhoZv5Tmm8sUJjvK6PW21etAp/fVvn+vyZ8wtYMKt2XrvJzANkLbmOyx7ZyWBOROBtQ+PQHHmgCHruC1",
+	"fPxp7802vdDxAtczvYmQOrsToLgRYk1dVHt/clGuFZjW86rotfNu3mIDN+q8pMGf6jyvYqI1Flyx9BZt",
+	"tGmsRb3tw+rcQ/uKS85VsaGSn2iJo0Nhv9VlnHgypD2hL4ucpNxZ2Ho0M3zTDnzH5+1iLGOkl/chyz70",
+	"JxXac9/k+SihH/im65ljYTKes4tTJEJ42BtIVq0YA2Nu2aFvWq7riwy2YGQ9kQhu0SftUo25Z7oebwlp",
+	"czw2xybCdvJRrjJZSrQbA2Jac9f0PcvxHMt3bN8KRvdQ1C7ZmVuObweBYdo+B2mOBqeLTzG90HctN/Qc",
+	"XorHG1n3KspShtM8y6nqP5Kl9PS3JlxFTLfqwPNt03J9z7UdIxj55nisWydGD+xe1uruv213bliO7/Ec",
+	"Odtw/JHl7znoRXYpXvV1+7VCixcxdJ3ACJzA8vwJUJWvQgfWc83ANUxfXHxgmROgyqg5DbbPA9/iGY+G",
+	"ZQWWbzgTYL7RFgQ15oZtuIbvmbbthYHhOGPzvOXzOu8yIrwyW/Sp/954xwPTCj3eq943TNtxJ06yy3Km",
+	"JzDLtQzLC3nmoOG7wSS4f+2EVBtzw3BsN3DDgJ9H6Lmm706H2eNlN+Zt2OO1di3p26ZrGjyZ1zcM33W9",
+	"8eBiqAfoOLZv+Ebg8mOwxxaP4wEd151KdnPDsMzANt3AsE1nbKUG2WYHP/KmNp2EVWNuOUZomoFreo4Z",
+	"hGFgHVJB4E2eMLxthSta3ty1HN/yndD3Tc8ZWeO2LIz/GvWhvzd3jMD3Q4+zVXc84N7upY7++e4Tii97",
+	"lZFg7nqGbRqBG5gOJ5axj9xPkMTCY7vIVNQLLVMbWxudOe48tP2Qd9oLjCAcKTvqWYvv2pmlOrIPLcM0",
+	"Lc8OgtANbXd6SQOt7PfnRmhavhuGpuE5Y+svrkhGh8Sz41mGZzthYLqWPTaxu2ji1M9SpzWPfSVptLtn",
+	"bx46oW2FlhO6hu+PTKQvO65pMc3iMYeGbxqha7mm6Y0EWuTNvtorWA3OAjhzMXjfAnea+c5Z1ctFC6Tv",
+	"hkYQmE5gu4FpGOZkkLqTNY3QcB3DN0LXCUNrfCfQE6t6nWTtAXVaLJoLFNs3LMv3Dpugh7x8g5dq4EHb",
+	"oWUH9mGwtRjhG47hiHKBDle1D4N8DxOkVRBtw3JMrnA5oWe5YTgN/MB9Hia/VaUtqiU+mzvHbCcwLD9w",
+	"LMsaWRdF11D05q89L9kMfhrelB8apuN5gvO54+uLLLKXWfZBIz/Nue3zUiG+4wSeHZpTQH4mmdWC3oVr",
+	"+3Mr8PktmK7ner7tTYF7AzHpvm8fdRA3NfmnWa41Ny3L9QLPt7zQ9cIpoEcIbSuci/JDpmMJdjgJLXoo",
+	"M5iHdmBZQeDzssDmOJAfcxx90Jq9lmcYXuAEdmBz7J1adFRLju48NGzDDgKTa5SGZX++vrhVvY8u77Xc",
+	"uRM4oclzoWwvCEd6z0UmGQ9rR4RKm/SELqmyy+i78fLJnXue7Yu+uRyTRl45zZJHfoxa3u7wQ3FCOzBd",
+	"1x4bhzmp7k66wg8JevkZvHpPja62BQJqeJxtzo0wDG2uQLiG5QXO8YnD+pbAmu7CXQ25YdK2DNI+yamV",
+	"PCOcZV2dqtcbqidnjcDVacBaA+y85R1u+d0a3KiNp3W3Yo+8GRRyY4w3jeDVMd0BGbJPBujcbzo3l9ah",
+	"NmgU9Jrew3TRq1IOa4SjrNQBN2afTTeeD+5V5Pd7zsf47fRut7bTrN/h1RVEOkupxzRteeZ1byFDHHVQ",
+	"Yg3ZKe1XjB5vQdeMbHi/NNpb1/uq8XD1+6cGgy5EILruQSphG5j+RzdNgLu6Tdf2AssMPc+x3ZHFTjtN",
+	"KmzLDaf0+dc3o7Jsz3fNcHx+9xZnjGT0A9akbeifsofUqKu3f9WrUQziFMUFu2zpUa5nmdNfwVvv7n4Y",
+	"eke2/hejTW0dLFXPTHLc5in5vh+4wdTKeK8xfMAJZu32EK7lm74zZSdNxtx0DzieaY8/2j7lRKN/NOih",
+	"g0WdRTWPT4MQZY3uenOwzkFVd68lYtFEXq3xDmLSJeMlyTZ6zL3+RZsozwd0I1Gvc5KNTaS4/uV9j3HB",
+	"skm2CMs0IbH34FWWJJB0B/RdZuMIGouo7bacTXvOeZL0lgnrBg268wOSKVqVkh3PGdvfW1unLHiW0ksn",
+	"rVZmefORTt5utTLLn1snN7p0rQG5Z9AcGR/w+NSRVfxd/HijSVVFU/XQZHG0VlW0vu6qZ2pZvym0/jnP",
+	"2DisfvaG8zHc/XgEfsVw9/pwFJsaDXyqtsjpxHS7I0ihyCa66jIQKxhbDe2oks587OXjyjKMJgSTF6n2",
+	"XXcSFLcFxDHmluWfPqGV4Q2iDG62rdroduAbVmCY5ZCaDnksJ9khSLq0wNtWjx7eiRsVva+P4EUqCryo",
+	"y6vp4VxyoJInKZqsaLu2tWqZTZ2odr9NlGkU/lQssYnT9cvSyfUfCIyRNr+yU7lpA3HKNbiz8ZkZPOXd",
+	"HCNFV7JoiLae0ZuMrGDKU4DSBGlLYKXoqdxHNe7dIyKyTMZQIuHkgSeLD23tub2k2rbOi/v4rbixt+iJ",
+	"jrs1bsNPPfF/m9/NgTz2Ux13ip7oS0jRL+9fN4c9oPQfOJW1cPoG3uUPCaZr1C7boob2DVtg1q6kdQd3",
+	"dzABCwJTKk8LSJ8lBTCNwZ0s2whkeRrwivDqY9LPdw6ud4gCjtbqL+BXXjTsnpEsXYHFm1fgco1gzHPU",
+	"bkURs4yAV3D3AqhA2L5Vdk6kXh2gfjoXlnthWBeO7fiuERgXW7jbwmT2gCCjs4/OrMjfpTNIZ6za4Wwj",
+	"dziDaTxThSlnkh/NYr7D2UrsZ4Z2iM4E3ao/8KpoMyo2OGObeG9ZgSOoSnC1X9covctouyyJ7899y9XJ",
+	"PYUXQ/WfnYUZvjCDF4YzNwxjfAEnHZnf/XUKmTdXV112HTmbhNHG9gYLaHOHDvuARX51+yw51/gRc4TE",
+	"EUz6OL7EUpmhT1/mu5Y+opPpzTG8imcrNWT/oHuUNA/ZGjFGYGR7icHYgeOmHLALzUNLfB8kJvr2fq65",
+	"Mu2VaI984Gia6HKbxtyzLcuT9VQ/jlF8rwqItVyD738E2yQ6m5bLOaogIP/7JZ+5CeQVihBnD8CyzgWz",
+	"1rsmIW3LSelYBlEjZxpI5WquB7LJHvs2/vqnn8FVRrYZgX1FD9T4BY4+tAXb659+HotSV+9/HFXeRR7V",
+	"eeuy2qvo7qpKOK8cg+LwOJbc3t++vUeQROv3iOaJBjcOrcrbtSIdy3VdkTDofBYr8nh6bRaTrfahU7vl",
+	"24fIat/0FDgZqCUlK6CZwPB4/Vzb0+2nepp9Wzy1NOCEtm9P8ThPLp1D2WUaqyyilvPf8wNzSqkL2X9E",
+	"l3pniZSYZ6yaUW8XeZnGl5uMMF25C9N0HHfCurr9yy3bMr2JEFqtx03b8bwpryyonQ05tmEa2tJXsh1N",
+	"a/xIR9cSJzhdDSH7mMuZGum9QikiMOH3GG9wiinj7PoRabHWmBrF3KrQbXjBlLRPLEj2JVpmhL9vtnHD",
+	"CdzJwBbwk9pZK6LMd8JJwOSDsw7WNAgatmRMixg+iqWVUF6hWBYnoses5YY/ssgoGpyuqiia06yRQxc9",
+	"PsQEKO6BPwHqsXeQpWWghQRy/YlX3Bb/KWEfALUMLdDSoOs7nn9Ih0HNLicz2IytEbmM/57L+uR0kekl",
+	"6wRoRzCa8YHGBwZBUqHYXabxK/SIkmzL96xdr23avnHkU74tclsnAKEo4RLjMo2l4t6HMZOg/TBFHlie",
+	"EfpHBh/0OtgzBpN3HEMKypILeNvqhmF5Uxi39L6g+FKGKd2vede4/vpXh0J8hZMjutTqS7QNhSXIOoQ1",
+	"DaJTybIvwrLIVm/ql00hvpcURqgRw+g6Hv3aXEPHLruqt47dd2RwV6wP67qt3utn4+RBlyMP43pXDdLo",
+	"MmNE8DhBOszjazDO9MqD3uqSanVDR+6jxQGKGmFFLhZvJhqS6oVGGZKWf5Ah6Zmu8YUMSZ5q4p7IkDQ9",
+	"1z/ekBxdPnm8Ien5/nGGpOv57rGGpD/NzO4akvaRhqR9rB054TVywI50v7gd6fDWJaeyI91pYYd77Eg3",
+	"9P4QduREjvZF7MhD1/jNjuR2ZOBYwcnsyIn89eu3I382v4wdGTrW0Xak63vG78yOdALvC9uRvnliM9II",
+	"TN9yTmxGcrDeNzPymxn5zYw8sRkZo0+aAGh9ts/lq/Glgxf3I0tpF/31Lhb3/w6u4HaLYnAre0RgmFAg",
+	"lzjy2fb/Xixu305+t61FUpY77z2twYAIZeFqQxZuMKGsjFvoWh5Znvb9xqPLfs4hYYhofy9OtadPoP6n",
+	"/OF+4NfycKcdpZqwDr65fMlt9adLcYxILUBP59L4mGOK+Y/vyCtMt5n8j5ZMHOjocqXxQXDLyvM8fbds",
+	"giL2jtym8l+tkBO9CTlkiI5NLtG3RnJ6A+jaKktPQQQeNqY7ATswDNvp1/1wuuqmI/2Y7Rh4jx5QFEFw",
+	"OdDuGCP67iltGfam4djhyAj1EkyBHS1YtuMaUyBp2g1cyV6yoqD/6CiRO+2uaxGYr46MJauB6mLDm9n1",
+	"J7TZaqMq+R/eLfmptzwZ2XKJI0RegHsyB3+5OwdXa4yW4DKKGHgnfztpJytFXLxvlcmLF3qGrDhsu7U/",
+	"Fa2sbPfwVlZVyltdkWvfRYsQGnyhe9xdDG4TRPOkz/s5lIaXNHppaXG8yjBoIG5/56yf0O4NYgRHuhhx",
+	"qRHcFnUXmm62oPCnjuwTLWDdqbIOTVCuH7jTYb2vCkc0wdme6Uzx9MIt+rTIXtV0z06ZGh5TFrqGGXru",
+	"2EIuCmynwkALtuGHQeDbnmkajuE55iTgOve0wQu2mm4QepbvWrw0tj+6oO5Vlj4iQnGWXu2iBHWKNpmB",
+	"bTteaLmObRnW77PoLdzRd8sSp9/ljDKYdtpEm9bccyzXN1wncExeS3Lk6jj0Ast7gZvh3HMDx/ctMzRt",
+	"33EnAL+HA5A9cx7YluuGvuNaoWmPwyak0qy1xVktLzADPwxsy7eM0LL9Q6p/HtkyCj0usuuXt4tXl6co",
+	"H4oem1VV2kW5Asu3XT8IPM9yQmc0yGFKt3kHBocXzQptg7+ce6MBixtvtWMwbce2eU1OvlbH+jzVTWtn",
+	"VFSOaZ2VOakyQxPgDSabFln4ZmgFhmsFAa8L6nmToWox2DZN2/A9w3dCy/TdsTVG4Rpu3iLWdoDNTGtu",
+	"c7QLXMs1TNezvSkA5X8380fnbuAbpm95vmd6gW1PeGD5OYdJ+1rMucWLwDu87pjpmWO5AE6LKi90kS16",
+	"yllMe215mZMYpacoUYxFnhSKdWUseMlF9+jSKNO7zqWISacgEwfVZXQz0+VFnae9vciySxpuZ8xdLs0d",
+	"xw2MwPZsa2o1z45I952547umbVihzSXdRIDvEctJ+i7VocncdmzHCg2fY7ZpjKSRZ49Uk8EQfXpUwAuF",
+	"hqEfOiav3uyMfGsYOBVe7dFyDZv7p13fDexJIBXyX2+2SbZDcaf4vc1X6bm8WH1g+ZNAa7i7OfccXurZ",
+	"sA2fl0sN3UkQbwco1pg7js1rnpum71h+aJmTQC8UnzrhEVMuY/vc4T0IMg4w9w/wrL2Yp9+hlAoDo191",
+	"D4MgDHllWtuwLfvz1UbsYVmuF056LGbwk4bJH1jdeaBU0cyyHcM9ulTR1DqK9dT6tkpbV876NECNstnQ",
+	"Zrscv1OusCnnW3pES0+p30dHCmuqLrWlaod79XP7Xmrs8JR+ltDP2do2iU7LGzCx9UZ9x2weTfYjRMd+",
+	"Sh/QsLQ+ja7T5Lzrkum1Cods0UEruKMx6F0DPeaBVsnXcp0e/WnYSaWPW2wdivqoUnOM8AhXVRua5zqT",
+	"ws+7N9uBaPm+4x7lsGqDNOYBbzDoma5tWqZrmUe5rLrQjdCyQlfYs7wctOtNga8IQgPVci3XtQzX9J3A",
+	"tR3nYM9VG/bMMeamE3hmEPCC04Y7ti9WjRd318sPwQ5svlTLN/wpLiId6bUnMM15aPq8UHrguF44tlJy",
+	"L9134Bve3Pdsw3N8jzshTesIN1QbuOvMRecBvnjP9keWjW5wfQ2C2LzpgG+HorB2GI70lrYkdxsuT+6x",
+	"uTclGEuBlQRvw7LsuWN6vGB84IXcs3mQR6q7wrlvhiGnN97fzPEPd0p1FhzOffGu4rmGZZrORLdUG1ww",
+	"Ny3e1CrgPUg894A+MYUo6ZyB6YfhUc6mzlpd4Tc0/NCfh64fTAbbi6WmZfg8Ftt17NAJ3MkOpw77Mrkx",
+	"xjtZOTY3p11zssupc+/unNeBtUIjDHl7XcOd7nXqMBR++bZhea5lGp4RjPRL9qlFnZM9wPfUXaJhGK7n",
+	"hE5o+dyBGhzifurgJm+CcrwHynTCYBIf0mlQXdxx+PPF4U6oLoLzJxHXd0LbdD2eWOEe4IjqKEHe3DMd",
+	"x3FDL7SMsYyzxzrpLtl2HScwQsMIDYf38xnrHRnW+jW0H/AuTK5terZr247rHuAz6kK1/NCxPd/jSgbv",
+	"FeQf4zbqgveskL8ZuYHNZVfgHOA56tKZ49qOyyWB77umH/jHOI80KOhaRmDK7jGO54bOEQ6kU533OGPy",
+	"MK62x7jUqkoWf2IzAt760PZGYuJxrqQuUfvTSl8X3ovufnzP8WzPcwInMAPPsA9p1tGVqqZ1vE+pZOYc",
+	"vlbZbOlLA5qZXhVsK5xaFt01VjTSuqsTdBWP1jXoJKruZLVCUsfaBrn2EI3qeM4gyxhkgRpjo0e7GzaM",
+	"e+1xnb07hUmMEz+jWMOwjtXno9C6Qs617pYhq3CPRbrPItYpDr02f78p0WcQ9LGxfuVK56F6zWOo+4qe",
+	"H1s3vLdq8Oer190ucrfHn14EnSmYvOAXLzt6SRiOdCeCN+1K12V4oPiJzss+M5ssRjw8b0vQVpS25HUP",
+	"LyKy27JsJualM5bFcDdbooeZM3tg0QwmLMp4GUuCouwRkRnc4FiUqzQsw54/oYftmAKVI0pG2sCyX9jW",
+	"CzMcgkDakaO8TudWe9cUt+eK1Nd9VU91d/5ycdXXJQB9asUkX4mjBPIogThKsIaPCNB19pQCilcp5ZVK",
+	"1VnuAKTgl/n9HNwRRHGMUgZeZSlMYrAg+Wb7LxSk6CnZAZimWZ5GKAZ4s80IAwwSvFxSkKXgCqYwhqKO",
+	"6hv0CUcZeEIEgS3MKYrBMiPANgBnC3PwEou7BN+9XFx9L1cJCFqJ9hAAMwoeOV2egzVmnEUACP63aVg/",
+	"AV7uHfB7Bw8kgzEiQMrpch/a0m6sWwdWnc+dPJ+FOJ8b9ACcF+Dl4grIbARwqVAOvJfgwSWf+o5vCLxL",
+	"y6NZyDOYFDBbR4CDEF8c6yxLZ4yvgs7URVxMDZhtVynd1qqPMlWjVFK2wmOFbv3Rp3fle3+MaETwVgZf",
+	"nr0vQmZB+TiH0nzDFyESDn+2+P/Z/P84BxUhAjBNc/Gm9FFlDvymOWRxiwtIVojxvAyU0lwT+DpNCe4A",
+	"q/lYzLk/UvHlUDpFy23DmDC8U8B/0ug3KMawVR3fPabkeW1P9RV2j6y1gt+a99RTvzr+e+2b1qrHuZ1U",
+	"VdMDC4mr0ZrmLwR/AL9mWUzka9rXWt9a1rhUFafBe4gpopLLAXWoR1ehVmc0k9obLf87ypKMVDWqTc9w",
+	"wgvI1yMZ24z1LmB75KUPFY8WzSDG0eso3WBh+i8M94V1gnLS08qLjisnXcfg5sGet8mre27a+tO16tQt",
+	"4mrR9H2+2UCy05B1kizwhvcs6KVuw5yPfM5UwK6yvNUwyPT88vNaHecEUvYmS9l6cHoe2zJq+hKcZgF9",
+	"06ust8EFOCM7ltTgdVdg962AB8UMTW9b85HduAto3ckdbQHuUp1oCtGz//xbyd7+dnb+t7N7RhBPdRXZ",
+	"f+IvizW6SXZ/O/vt85BT6yL7EUVz5kPX2j6i3gtoofJ5D5k0jpBTnPAE9cRM1Hukqi9G3GmnN+SUoboG",
+	"sOPHN7pFTho21J9zCqCqS+a0UVMn2tNedwKkbvTCiFF9/VSnQWi0n506tN50durYWofbqUPf1D25Iwfu",
+	"a+56CKiyB+z0wfUn6ZEFvKZiVb119JRRRc/WCWNaXVsnjNQ3dz0YwPiB+gbJU8a3O1pPGLuv+fQEUHs7",
+	"Lx8GazoH7bQyHz9U2/55+vB6u+Qpo1vtyscP7e/APR5Gq2vyYQMnbrnV9X38wOEu20fAmb6H7EhkH2ho",
+	"fgCQw46z3Tx/wshOv/8pY5tt7SePPIph6Zr1Tx5dNhGfPrLdpX8yhGO5rAJzCLp9zHE09c5I7a1wMpq1",
+	"2tWPH9jfa34KjFHN/ycAzJJHni8yddgBoRYvD7e/Wi/26s4mrbnPQNaK6LZy2VUcB/hcHycaZx1pJdGQ",
+	"BdqLznpe2iPc+5S38661rQvWaFKhBq1a1mQ/z9vHUUdqinqW3sNphjnhCC7XYzb2mHV9luI+5WlIQd9L",
+	"I0PawV7JP1anHjbAB5TaSZxtjM41ynky0gTuNW81pmtPDJVGaPRoq/2qe9eN0+PT2sNy90miPaqoxpvV",
+	"byZpdfm2Qa1XYbSODb1dPWgz695uVXjRPVptUMqgvv5aDBlUjf9EVSOY3DV+13QSV6Lwv87ewIinJ4RB",
+	"GVmtiEKUNK7aWfyKIBEs+hz8mG2QiCq4jCJEaUbENLznSBnmh+9gzCuHeKFT/WmdpfJpwSwaL/1Ts+Nj",
+	"S8/01N/gQLT+8KOS4dM8SfipnL1gJEefxS8+OotWHYhABh0qycaA9+WCxhSX7IlvmdqJvvied/fvvuXK",
+	"ceCHJHuACbhHiazCNaJK5TN1EIyqQ+7spPqT/tC5sPg5z5gmPktzhs7cDz97j/tnj19r9mOvRbDpDmyb",
+	"YHb9qK3jORDiZ49r8ZBmG5xCVV6zSlEambGRbxDpDDYPPvPrv16/ndx9tlpEcz/as+Qxq7KfrOYwawrf",
+	"fZSR1iVrW/Dy8rp5KsJ/lfLVGah98hR6SpKMnKamD3dHmAMjCg27O0rbUZiUJ1Ojg5me9dcjqLvgXS34",
+	"Rhj1yEHH8yy1q9ah91+efqH6PZ9rsKbn9DXXyB9Lpfi5KjlfiyPKQIauiPj3e3BTWBS9/L6PPdgz0dl2",
+	"L3tI0dO95vj//f7mVvd5lsT6z398fTOqBfDZeWPDdYj1xegoe4Gidaq6QeOo4Emtw0wyeqiI6B7lYDDN",
+	"uh1SZ9n23ByXPdIu4WZZ3twbneuWdmOLRg0lFLcWzANexk07KBMPLIQvtqIOUp7JubrAQWm5wBu0JIpc",
+	"mtGd/Ccgf6viOs0Y7rQhmwuCIM0JN/0RHSl5HS55rXAMaW14HIfZZH/zkdmHYqzVHjt+qN0a6kwY67XG",
+	"jlvxDkHS3qxhjh9qtHRCyx091moN9ZzxQ9vTuuOXbLeGOuMPym5Pawejx7rtg/JGD/XbQw+nXYXcJaaW",
+	"eFciUYESxR0Vh1bsolhSef/ljZRn1KX+Wt3s+2iNNpJSL7f4J7S7zJlgyDg9e3H2MUdkV1gzL87gFn+o",
+	"R9tCMeLsn/8Uz6lLUT82wVHRAkqNenO7UCHmVSjqBrOZ+nKekdVFLbb/TJtaAi7vuChV+USCQwjrWfFy",
+	"uMVnL87suTG3hU+YrcWWLmCSzAorayYTDGbrLJchbCp2Liv81LcxV5mS5FoNkF6ZH/nnPyDV92ObFV1f",
+	"LMOQikfKlMkBt9uEi1ScpRd/p9LdQvn5ChcLZmgjBv5vgpZnL87+1wUX4VmKUkYv5Gf0QjP1WeXqgIRA",
+	"ddwtjr1GRfqE2B3PB4FJAoqtCyDOp09dXn+ZAkRIRkAWCVs1lthRxH+e/QWjJ8Da4HkGSAM+eMJsLb67",
+	"TBJQ7ALIbQCxD36Dc3C1RtEH8LRGKYjxcokISpmCTQEkCEDh+BJOoqc1YmtEONid+E355JMd4HcuNDm4",
+	"opyeGlf7G99CGcyMKMObQjbpr1x+eV18KC97CwncICbCHf9TTw+V5lyStnTmVLfe0eX0kGremT5IQ2ij",
+	"8jT6waukj/ayava3fmCCN5gNj/ztOeiiVOHLSxpDFpcgwZQJYlBh9CVvAagO6CDSeI8YwegRDQEXlCIS",
+	"QIFElhql3Ly5q5km5cYknUgHKdiSjLNsnnSFVzlBFCT4AwLK1X4OihRZsEUECJc3+O767v77c0FAoncN",
+	"+IB2taVtZKEuniW2zAiKIOXQH3YAyx4tu2I7PNcMcK5ONmCX5QTI9OENJ9gYRZjzYVqnQTWuIL9HiIWD",
+	"c1YxoV4CLL4tGMez89uJ2FSs9wQMVl00BHx1CWKonIXmW+mpVQhUMduc8tSrAofK0yv5rsKhxRqLf3Es",
+	"4k84xSwErVFKOZflhv4j5/DZEqykE7U11znn89kTny+niEikiFHK8HLHGTRBoGp0IJg0IzBGscC/JU4Y",
+	"IiCGDHIMo1sU4SWOajvh5LHMicBTiT+4gVOysUJGdgqrHmAC0wjN6BohNqNFu9xezHopv7/nn5fNdb8A",
+	"fx/BR/dJhomS4FnYsvZ4p9GSulEgbhTQOpBj6ClGDOIExX3wJeqJePwo2RVIK50quK7PqC0CsUfwiqOy",
+	"ZNAcW/+BgAgdoOcgwfABJ4IMJPOtPUACJLxenHZ4YizAKeX56BTglGWKKGG6+xda49NrBBO2rtNCdTaD",
+	"tDB7yJMP0wjiZZ58GE0Un1lV2cnnqr0UN0UBYegTu4joYxPD26T7jEjK9XV+6C3sKi+kybWbPBtKBGdZ",
+	"a1WCy8KIZJSCjXobrhB6Dm4ZQKl4h604OVRIXKFd0d6GcoZNpIbDmb9UIqQKQvNozfUH0aevnwAk0s/B",
+	"bYxgIshNrIYUsquN65JqmISy48deWQgVYWYpgCCBZIUAf91EdQoRmD9MHIxtptHGYvHm65QXX47B84iD",
+	"U/B4wC/jUBL6ATGBRYxA0R4KsCeUPCIg/CYUfLdYvPm+b+JhrsqitWxaNfuYZ0N240v+qeghKJ5p9XZj",
+	"jJYwT1iBCVq04S+9ust+yLIEwfS5Lrv24jzpesVhAXVYx7BE7jsQ5wnkgTbZIEEwEe8IairphQBPOEaA",
+	"CHdDtlTqq1gR50NLkm3ABv49K8tLPKA0WvN/CwaY4miNis9rGrNQemuMkhEYfShAbBER/j6OWW2Gq0AB",
+	"zNkUV9MTBDg3QJSdgxV+VMxvA6CsewEK9bvJEeVMDVVYQW6g6cciPKAfP8VJTuRgdBILex4HQJ4kB6Dm",
+	"Mk8S8LEYd4xCWWGfRDGFgw1rXryaS9yVSiJnUvl2xrIZW6PZBqc5Q6qSyzmoLKw0BvKlRgpxjtc4FWF3",
+	"eWGRNVBBbqiDCDPJSPajgyD03z9OHMqvPgNSnANx9jPhLamxp5IvyEvUoYu4FoU0YkcV6kAgYo4BTeGW",
+	"rjOxeK6ISQwUuFLoYso1KpGrwK0GavHlUBFSm9TUKZyCLOXLIwhuElG0R7GrPoTjAZqzZZI9jTC/y3iB",
+	"b6b3qZG/c7TTaIDfIuC3eAJr5odhm7aaSvj2paeoJAS+D8A3Ulk/tGlhi/GyozpTjiVZhorL9hLf6HnN",
+	"QamYqpKn6ao+M8sA4jWpIEMDZkhOGcRpzRaB4IG73RClQ9qjhjiG7fHONX6zxVeTMfc4rVODgdI4b7pO",
+	"S7O7dC1VK5ExtXqNtGaCl+plxxAnxVsCRxbdFgW3PwdrlGyFClnZ7iX8//nv/wPKqPaCGsQ/e2lBVELj",
+	"8qvHitah85AF3cHmP7f1rDuOo9n057eaNZMO8rxss8ligVAzvu5+7Kg+fI0pe643nmLanYqKm3YB1ZqP",
+	"fuJJAfrElBevgC8MSxTX5ylsykdIcJZTIFvwC/kWJXnMbw2liKx252CDGEyUsIMrgqM8YTlX8LYki/OI",
+	"CZ4jlc3a4QN++k3uhihFKeNCUGiVWcoXFotHpdrKSgtW8paMUIFMhZTkkvXTNslIGS2QiSNqSMwC3K7C",
+	"Hq4nzGhEEFLNzfuwh394r74bzVbK8s9vMoIWa5g2GMOIwJ09YF9nT4icDq687bMDuF7xgHvI2AfE4GnP",
+	"h0M88dEI2+a0qxQgT7zMIl/utCstoJ54sdI4PO1SJcwTL7TMlTkAu0X0+mGEgek1Ww77ZntH3uRpfODQ",
+	"SxEFlewWBKqmbwdAEeICXSaJSFW8SiCliB4E6qtRqJoy4D2i3LU+VaAr18eGO16Kx6iIYIYIhgcL+VeY",
+	"ysLCytPD1pABmOBVKm3ddggNZQQytNq1gjmkT6jYoDSEb2QYhQL8ACmKuYRWMjaC2/PC7SMp7xxw5nuu",
+	"FIdzoNBfqgkbLp7rQRx85gfED0eEm+SpEPUNhU+k/xXyOqcs28yqnJBZaST0i24x5lUnjeQLmAYqckpm",
+	"0t9F7ETMSVROOBm4uNapgPcg2GSE4X+I/zzZHPzWLtP4vkhJv62cJyebo1a04WQwi8oO+IQwVQ2Jk6LD",
+	"CS+qneN/MtDdHPhTQaYo4eZlXx8NsRl6whtkMlH+NMCKChVluY0TwY0yyt4tecmDU8IrG7uezKh5j+mH",
+	"O4I2ON+czhY4DSSC6YcbgtABl/I8CkqPpJumo+wQJMkOSFELXl3dFHHA3Jg+PF45TwEE3HeZERSDapE1",
+	"D+h3r65uvi8jMVu6yVW1nsv4EYreEUJB+ZUrOKVbFKfbnNFzwPoe05c4RTOWp8L/jgmAlOYbsQsqH6wg",
+	"wapgRMYDgGTbCKG4bBGhWQoT/A/1EiAEJUVAevRxliofrHIrNGJJo2VTh0n4axiKD9FlXsuh31SabyrN",
+	"N5Xmm0rzTaX5ptL8sVWaXoF3jGajBPDn0nBK8MdoOmrjhSemprk09BaWyUZXleoB4JIhAmAk5ubQRVwX",
+	"oyBGDwxkDwlewc7zSKWkxNFyOHTgVbSsggU+Px68urqZdtf89Eq17Lgnen4nHFz5LN95QBeBUsmuekiv",
+	"lLrae6a89GpRrRgtlaGnYqKKOKgiF49fOd5sE4xi1edskz3KJ1mhiSISoZTBFSrj1iNUpcryU6lnmPS8",
+	"uMdIfjgrP+y/f/XpVfnluJiR33suaHPfu2lIGaP2RRyMmPcM7lSCJIqbkX3FEkF5N42sznYaXtc3LbzH",
+	"osvfA0JptWoR8/TLfS2DjmUAPmY4Fg+28m0WcJGyK/zFHDdLZ+82Y+qdtwp7YkTIl/YrreoLJJByioH0",
+	"vDbR82DckVLvRJywSBGWnCjlR0BxpOSOCFErRE+JjFrR95diKSolHyZRnhRwG2utHhvQp63KQ865vl7x",
+	"VIlgBYYAAhnqE2jqBXOAoxVf/IljhoozkIXspvE2NRRwhwBPHjgNZ8tSkG+jbMOZS2uKOqKpH67lD1cw",
+	"QWkMySDf43uO8wTVFz8jKBFxnjFkNfEpmVk9BoagKCPqs3OwhbsiTI7/Z4yiBErMKv4k0VStcodREjc5",
+	"nlpwG1ln5Q97sbbY82js5dy8R+XXVkPqNRWzSVC+4fGfCI+zp79nKaKzKEspwywfCtd/lT39G//4qvr2",
+	"uawLkfZVm3eigM2egFg5uJVBUFyvuZQlrkFt50fH8IlQAZVqUYdbZHANLkTmyVWoNvStOA+OdHVUke7y",
+	"4lmf5g+z4t9rBGPVV1cKZD5BrGT6TnZvhnGM4v6MsqKUSC92lFXo/7zCWR3BATxNne7RLK0sOoPTWYy2",
+	"bF1AporRSV7RsEaKiwOybbPKL4I4rQW1N4vElGlosCraUa9iU0fKcn4ZBF+wqeu7+6oMjuRa6qGi7urh",
+	"U/OYdh4eAyhn11kKWLatrKFdPd1ygNEVy9gvr39ArDiRgrt/E9dfHrP7hbXwrkDKKlQretdvmsK7gexa",
+	"yc3RXGZSVAhcB1Zgb4G58Z4KSzBiPEWTI/tgPZEhxI2yNNvgaD/iXqsvv+mZdcRVh3II5qqhABVjT+0H",
+	"KhYn68a8RwmCFI1UKgt04CstSaFcsuDQpADIMrAlaMuRc1mluuPNFkZM6gMb+AFVi9xXyquYptQNytYT",
+	"M+FtGFASmk0qfveun9Z+JiJYORioYztJlTCZVtkGXnvCqBYtfD2NemCy/psMrOgiXVGNoD6RLPOicEpO",
+	"9bDjvz9gwfgfMrYW7xqqytF3VWAqf+VVr/zfS8MFPbBzlXaCRVjHA2IMEZCnMSKUwVT8mReV4Iw9I8Ml",
+	"kBBdieiNJKM5GcJKunpVffb7R8qC59W3NRE16QrEjbGH4aUIcG7FNtNaxLPE+9bz2vX9D6AKzgCyvYjE",
+	"0TKMuvKFi+zFPI1roc/X6SMmWcpHw+Qc3Gdca5US+Qc+OhW1Ob67vv/he0CjjAjLulIjz7ntxkgmqskW",
+	"2mmR3ss3wjt7iaUVYeGDzJGuZjLmYBABZTeFPxTyyS19GcSTDLHJvTheqZuo6k21cKgpn+9/AOpeJPq9",
+	"2SsmKyRETSSkNSRctZGwhnxCdA/iE1sO51FeL26eM3/yenFzSObk9eKmeFE9+JZ/Vq+6S5zGgOHoAyIF",
+	"THHOhYnISUZeeVl6eCE18Btx6d9dL27o920utLgBcmMNDiSkJMuyBFDx2rvk+FM8oPHxpUXMgXJRKN0y",
+	"fA0gI2qZjQtmy+pqL1Q2xuwJ8XoJw1xjcXMlv/61/Pj3zz9am5qOVeoEgTxBepK6FbJsxTp7krcqVOZc",
+	"2HaSbcSYn9JDzoqKj0WWbr1GHV8WbhcF4iv+W24YlieQEajN82JXWVR7hqvwrszKrYXUVq6dLBWga+EG",
+	"2bJck4SJYhmPIvOHCVrJ+hhF8QDua5loDzQwmBe13Ie3P6pv/gjoqvYyHVHLgzomXR/WapkSBD/E2VNa",
+	"uJvVta9RIg1PnPagb5fz1VGyuKyGPVoVvCpTwteo5g6sVf2V/mZMCooEWOFoRtgyS3BWV/lbhWL4DgQf",
+	"V4UnS1FdZMhhNICKRdeBPjS8TZfZHwEFRUPcBE1Hwa0cSE+raBUYoUe2Pvb3Rn4kUO62xtEE1pWO7Vop",
+	"AoH3tXqjdQXgXCIJv7pzEZ2QUiSUv+y8IAthU4INTOFKaPNVAmQ/QskHFb1w7vZcKNUIMQqUUrpddbtD",
+	"uC3uLU7yX2ixcJk7SpDiy4JBAwSjtXr7mYObjADVgoTvSn0oXD878ARTJou38z/ysXKCIqqIr4i3C+LH",
+	"JrRw/jFfFUPROs2SbLVTEwG8lA9IDygRlyMg9HxKwTbDojBTRsBKBBrPz867NCkP64+l0dT3dICaLE+w",
+	"hnTTqFWDypN6nvQ2PBlxKbW8/K/tWk7XS4VjPKq1Cjju6a6I1Kt6qbQe2XSV/lWP04HWKjfcSMpySZ/Z",
+	"FqXF2wm3tQUYvEG0Vuq/mub4bivl2+FM+lt6ca3seCE6+/3+ib+1n4mVKIvBgJajD5PSlLb9wzXYjKB0",
+	"xdZ1LbD88UdZ9E6uvq9vBH82KxqHlNJYFOxlG5iC/5iJ4QKN7nDGSEY/YAmyLBkkI7V7qgT+z3//H1mM",
+	"jIfIDNcFH3IHLzOCPrV9J62DHI7A5fMXPXvBFmJCi6c8pf+KKQr+0GzlIn4puiqDOzG42SogjVUhYaUC",
+	"lC4h/trdmjUbaaK1qIsvoliDWMJzeYk6M0+kBHF6zUM4UAoKUAojVvzy+pnRD+Ln3z0PEtuYdt5S0Kjj",
+	"OUFQVAIZoqwBVgars2xbdRSqFSKECcAyfkoGgjRoSd6L4kYEKpBF8ULhzCjYUL6Vs50Drl8X/xaaME4Z",
+	"lBVtpYv3XCdr5Z3SuoUqwp8fElRwq8o3xO0MwmhVQLTWl0BXSrHZEkkubrbGlGUERzDZg5c/lh/+iUOu",
+	"aocwHcuroy5wUOGCdNad6D22EiMqlrPWcEyRQond1XaaSF46/1SyPPfRlXXna9soNDacllPUXzz0yL0X",
+	"JSX1zlL0tI9XvhZfvkVP47nm7z7hSOycb/nL95zrBEl1eG+B3+2+jCVbBfIKAd9Qn77HB2wyjlsoQikD",
+	"+VZGMWd6rCsDmytuWoVXJTiVvRY40IzgFU5hAjiqAZrlJBKFcQWaSxDN1Dp6XrL+JSabiv/LoBahhgl/",
+	"Ef8Y0DVeSvcjQTABqjK7mEXlTjGSC4eKiNSia0QGiKMiu1m0hoRdmO4Gp70EUpH2Ff/adN/g9Asw7i8U",
+	"7NUDJc1SGP9dnPlX0OHklfKkX8E0Tg6XJabLezkoTI0hg8fKkTrmt7tBNCjYdGdvZBeJ25Qh8ggTVTFO",
+	"oFzLk1q+G9T6BeBUrZ7DwApGg165sX8u/IPnIMmezoX7oOpaoYwYqSiJZdYYCBYvpUW3J4IkL8IpHwB3",
+	"QFCR1AJl9Wr+6wbFON/MGCIbdQKMIPHklpetoooJY9iw/wS8LrFOynmoqPYLZj9UizguD6KGo2NTIsCx",
+	"DSwXpUWrUVMU25+SFvHjyD10cyMab1Jx0YSSIC5L4vMqJUIELUdrtUjxEWbiwYtmaX+CRA3DUkhj+HEi",
+	"fr0Vg/4Y2CX38ux4pKbtxxj1wZfEDcHDZiiLL3ifmRF4cccHXGcxb3H059EXnq1r1JGy/vrdq5qgB9/x",
+	"S/3+WHnPgfSIe6FPp7uGPt2uTtKwOaX8vyuB/UUCqzqV8iCCWiSJdMBOkPhNaa9qzVbdq2pPmZqOVuol",
+	"ldOb4tty+Hd/+fXy7vsRwrwip4QDmkBPr/n33wjqpAQlzvTEFCXu9WiSKoP1CnVX4JOcokk+LyHFUVdt",
+	"7lrBVSSCgCVV2TJsoU5B/ErKIsxdDfmc+/LFcFbTj4Vvh/9Vaf+19wAh2jY4xZsiGY8DLRXqB7GDDv8Q",
+	"q6QjaIpuXcOYqL3c37mG8cdQXu5lPModcA3j2VWYxuT9ikzjs+dXZ7IEx3BHZw+7sn1/P46oj1/uisfp",
+	"zxs88Cdku8XBqqOeiPnqfk4WyFB79qnDHhHEUKAKeLmrIhla2C10oyJVR/oKlAOpP6hhT3ACTqNsg0b0",
+	"K7wVH37rVvgZyjs0DnZqf2E++ASNCjuRrW3IlQouM3mr9z0h3R8bT35S0osQyIaP7j0Hv+Dg5a47jQ2l",
+	"mBCRmUVUw7kESyR4xLDs+NSo3VUmKCn/mJhUdpcVvL6Zvj8UJ9Gmh+E6g627+9agcDUNXY8rfciPu4NI",
+	"2rLapOP31aM3TkWXwabbNkYUr1IVvCna689Ee/1C+5RlMuuv5M2Xz/72nxVaNjLw6gVwRFkI/tCTUaro",
+	"oozpVVSQIqa201M4sYPTQ30KWyj95+5S2D2M49jz529Q2J5xmNfxTv41LX84t65tZFSJdiNwQ5zZVxcd",
+	"dJzdJE2Uk9RQKh+m+qLvpOJYVC9QnfRVnlUnZlXXN0kFxd6qoUWfw2Hjvp5cVQ9uLPIMihSDek/tMlCN",
+	"s0thYYnlVjlWjZCnql3iB4S2KqgjW5YZWmrHDyiN1vzfA33/JTrvR+HnTBAVEx4Sfta86W949UXxiuIY",
+	"kZlyXF3ImJIBHBOfq/Z0MqJkNJuM+yudT7LUf/eBRdUpplQGtkzlzWI8YE0AJ4rlrEGPa32j6x3r5MUD",
+	"tQ+g0KFu5TytcbQutMECJBX5BQ+5SKfOCFCtFeoVi4vu1jKvsLbBFg43sLYHl1X/unG4LFPBv4A6+Dmx",
+	"uWekrNjGm2XgD4c0x6xdy0KqPl9c1fl6CEoiUj8VPexKsshI96FPDa+TFqon+pTeNiZ/2cBY1eUh24xA",
+	"hkpiaxTr+4SinLcrKco2E9lcej9RfUC7mUoB6aWkn9Dujfzkm+fsVChdnek0VK6l7BwrEyqVprLlFehm",
+	"t61WQkz58U9oB9QmJAZfF/Hzpe1f2ffn4O7iuszkrXUshTLHqf5OVtYAIQhgkfNac58NWYU1bB50FFSn",
+	"/5l9BM+MS5ON/Bo6ATX4tLo6RUU//LrdPxN2vzL7+Rrqt1+sp8S6xeJNF9cUEhcF84rqGHUXVdsVKwuX",
+	"AbRc4ghzdVwiYoI/5jgWnwjfW9HTpfS3ld1kVP2yTiabTBJZI1nOcocgmYCkw17axt0+Z1+YrwCpuJ+0",
+	"fu8cD8pWMb1u0kEXUxWLkyQKU8Rb6yPEicoNKl5Z4QOkIggWNS3BiGAm3nIrNBD3pSomqLVW5aRgtG7k",
+	"Qyo1vDZaqGu1pohNp6umXm4r4UpiYY8D9aBuiF+kD+LvoudHvbfVCXp/lDmkNbb1KGoxltzv9f52V+Kp",
+	"VZpkOC01xbK1EYxUj5wHpnNtbDPKZvzHEtXKjTWQsnBKqJ6dkO8IMs6voag20a4vSXIkSz1y/fXgflnq",
+	"3bdZdbIXcaVH56rx8e8eZ1VHpOauxqDtG13FzooHcvRQ3bBQrEyV4zUAVhVaaE3cqjxemkmpiIxc4UeU",
+	"yvCWblwk/0DtpnkM+/11YruVnsmhUqzStjU9dpQAqPsWKzedlDYrmK+QQOEit13ttyh02t9ySYvNswfI",
+	"BhwZOpx+yUdoEbt1axw1+AaL8nosA0vEonXPFRW4cXZ+hj5tkyxGBQ30EwydQjHnZ5TtEv4XLszO/ngE",
+	"dAJ/9/DVVI3tKv0Fp5ywcLpKygU1jTaBL6MoqK1XFXZYjawF+dT80dU6KOaLgynKcprszgFlBMFNIgsK",
+	"cwh1VbojbQZalU3IzPhi+RjHPch1Uy8+e1cTfdqF+uvzdyrhuaMXK9mHd7bnoUJmKotPJ75SfF15lL/7",
+	"tw5+EZeE4Whq5Di/7SMr9HffNhT2SOBQLksVrICCXSEmpaHMUG6lUavBVQb1uwcGcSpwO8EpoueApni7",
+	"RUxFr8hoMslFf3n/uqpOXPk/xEpE6Wu4asS48B/qiC8UjjFoL54uvyH9nxXpe9pSNIoFKDktZtO9ewsM",
+	"v0FF+2NFR1o0b6O4RPxWzeZCF6rXFthPfT3UIDODGCQrrijzi0xp3v84IdKCFuLrq+Lj373Fp9vUNExT",
+	"KdcCBChP8QStKlSgwr9UQBtztXiq2AiQOwHlVvqMtlqu2gbFGKYqeqJnJo52ReM9rZ9C3wRDUYdsfVpr",
+	"GK1aoNaS9geKSDSwdEydldqV/hmLrdS2P73kSo3BNfA6RU+HI7RgpLICSo2PNliYvPHmnHJET4GUFrdt",
+	"YH+nPouoAl12aitfG5YZQRGknPnWUREUFVrxBhVnItdyXi60ygKtXoGrQi312izqgQNtESltspHIPhbL",
+	"J+H37yPe4uunlBOTR1PPqBIsuoWD6L/0MuchomhSQ43OBbJmaUuOFYnIzaQ5tQSZH1dWACqtUAlCkYwk",
+	"qac1km4+BfgJSjd40zwdIILynPbTwb389I+kk6gtHYGa8vwwOk2XhyI9oUTFjmxvcNJ+DUVtrE8/qbh1",
+	"M+u9xHmh6pahnDIStfCSccJZ8tuvtWyoKSvSO93UQWovfo2O7YTLJBTjbuRcG2Vlefl+LBW/fwHU1CFa",
+	"+d1Fta736m8CTQxz/9BrjjPVKJ0KW0ra8mVVTqepy1Q8PagF1aov6IvpVm+ynPmUh92KGh7ZgZUvonpO",
+	"0zqFGyoCHyvK6ai4hg0PTm6XxW+6VdXOh0MQ1OanJYhBwr7ah7SDui60kOW47C/JeCRO7Y9oaDWQ1GFt",
+	"mfzVie1qlDYo4tULGJIUaAuNKlQ7L329LdTKCJrwFtETmvAxz1g/c/qZ//r7ryOeJ4nYycS6yTxb+mMx",
+	"7jRZsfKOBdCWDJReIbHMShmTKahsjYqyckXhGE05mOpxCqeiiX0Oi5749buXG6pd/oyuM8KGUeCef/K7",
+	"xwOxiwMQQRzQkZjAb/Mjb7kGaAq3dJ3JJ6CpmCG20Gm7XCtCmOAP1YOT4iVFpRThLq9XGFLYQhlMWZXD",
+	"I9W5PpyRxUmHpZXs+/eckXLijA7pmSi3c7wkkXNXgqRUSaQoERfalCFyZs2TsrLYdDy8KkDZ1HTULlSl",
+	"kijbbFAaK9W7kDdFnERZsZuHT7U+3i9ZIANZq1t8TZoo2LMCx/dgCL1X3/2JU4SPxdySnxzdCbIWnKPt",
+	"E9HuqlDiRLNEg1pWcbW9dlyLHIptNHt3NApwN7x8OAVZTsog0fPKOiuMvS6JZAN2mvx9EGGzb7kXp+/t",
+	"Ig/20N4upBx9stBPbVEFbX8XufQ+BC9N3FYIfD3UPY1rkfBqM+cApfAhqULdqri5+jLrhq0uFLnt+lBB",
+	"0UNB8XL+waQNueU/QsJGuZPjUO+o4HrhP6scWkMJGp15G5kZw5jYl9zRTZmouz4mou04xNqvN2bPnWTx",
+	"NSAC1yLfl2AqLVK8qFIA0/K8uZ98Nz7XorPK6klgDm6ZVk3tFDTVdZTqx456ao+I1IdFBn4ZZFhyOq6P",
+	"lnK7/fTXzs7o0zllnttshbIVgds1jmYUrTj2DYepv5fjfiiH3ddGfYlHswPFdQ84ykgesZx8BanD6qTr",
+	"5ztR1ZUAAG1BOMYnU2J5AbxsJCoaj1fYpJo8VwxXbQdUmAPU1lpNyXhbo6qHtQRDAT9b1e66lSvHMiaQ",
+	"XYJvFCgT6VzCQKetHqC9PFeRxZZkcR6xSTRxJ8d8I4hvBFEShMIjkOAUdWlBYQyob0kSwy9pjIhIylDZ",
+	"UQoQBTHBj03VG0GSln6MFWItCaRp7VdzdRYLVGcyqJDIshWzCPfrIrJIwNXtT6MxP8IfJqH98zy33P4k",
+	"d/Ie0Txhh7y3NEz8q9ufDka0a0hxsqtpLhweShmBiQp155mm313d/vR9rVBksiv6RPZ4HK5uf2p4RPMU",
+	"f8xRwTsxIhSkCMWqAN/99RVY4qREM4JWeQJZRnYgzqJcsnH1OlhkTqi5E6xJ/CyzKbYk49M30E6cfAvn",
+	"coq3+7Dul/vbu/F4JyB+fZjHN3E47tWqMFfIx0Eei37yHtTdK0ysrrM2bf25+GEnJwdpvnlApJ0dJ36q",
+	"Cohwad3usF4898nSVM335lrDrVbCEaQ0i3AzPE7Mtg/NMB1opyVv5fb+9u1oJBPwvr76e/e3b0+LYhzi",
+	"58AwATtliKSF0+a+mv1WsSp5GOCtxLHv+FokJ9TiZxMH+cfPhoJ8sn0YKBFnEAPfws2E12UJ9mvwrNZq",
+	"q3/pJ01xKPdl2vB45N9AFq2rymDH1mLiWNrMSDhv4Jt6WipOrsRNPo5fJNvVUbus/sxX2/TqFxBaSgBH",
+	"paKwU+UGQ2m8zXDKABYejmUu20MoqhTk01izDErkDUI/pNmTfArhUQBcGYFE9quoCktBShETuwMPOQNp",
+	"xmpFCGv6xz5KkQP20Iq849HUUvznNyr5KqhESYYlVvGwDbQDoqNp8YyvefuXK2+gt/p3rdKZwMOq3Jly",
+	"/5dPuKoYp3Ii9KPkGhJEeWUS2P96ey8+uuHf/FEKO9S2NNEPzIcAeWwHo0fNSBbx14U3tQjvgUz4WQWi",
+	"6NBEbQKIXQCxCzH0dQmobiDxUdLXpLTZbNmxteSGypZZBZKN7p2vj3Uc3x3nS/XEOS6BvK/9zWdPIx9q",
+	"fdP47flTyuk2wWyg9zVi9/KLP2sACN/99eNkXBPnKhNZ6fxkzr+60ZulhZEgLqivdowuu1VsCrxSSt6o",
+	"+jCdNkwChMC1AvHkn1T9wmY9mLzioPU+7jXnouBooPQCA7hkiJTsVIBuMC6YoDSGpIHFs/Kve9H5qvjy",
+	"a04O/xMgeDOHCj5kOQ+rjbJN1R5OobdO8ZK/FHc5DpE53ehnaOtktX43g7jfwnhun8iC5DVUb6E3BQ9o",
+	"mRHFp8XZDGG3LHwwVPpeljx4xtL3hWJ2gNZecKZjqwG1HdZ9RfBr7pnCjtRqZ+rHqsZ9kdANHkgG+T1z",
+	"07isql8AE3ksbK0qHYsSidWMOOV3rtzWJNuUhnK7+n4Tf0RlKYIqE7ecu6rDWBnkjbJwqrBxqdOJVc72",
+	"dLKTe7+a1sMOp48wwXu623/t4r228Yn8T4w8unOijgF2qlU05xqwQa/UF7U69MWgOBcv2htEVqI0Now+",
+	"5phiFVhcZ4Sq7xDcoHoLR5TSvF7TsCzrncba/Og2IjIUrVPRhBOnMb+1jNALQnEvTi6KAbfl9+8p/mIv",
+	"3a9RumLrQXjoE9xsE3T2wjTOR/thyqTHg3uFLUoIf8oWkV08mUbI3fH06CZO71ECmexRTQTeSMvuu/f3",
+	"t9+DkhJASQlKeZcFHgsXkagisxGP6jhrmHAaWiqojIhSo7sZp9F+s26hPnvPv/raayQ9DxbVT2QaAhVn",
+	"Dkgx9jTZaBwBau10F41pyuoaG8g6z2RcLpSfix3VJQJ/fKo646gsI4YIokzBVpowB4WiLM02uzr2yT/h",
+	"SLgQ+BakIrITeHO5xT+h3WXO1mcv/vM3fncUkccCq3KSnL04WzO2pS8uLko1aZPFiL/5bwnazqNsc0EZ",
+	"V3HO/vnbP///AQB0H/Ar3l0CAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,118 @@
+package financialmodelingprep
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ReadCSV decodes the CSV files returned by the bulk endpoints, e.g. IncomeStatementBulkGet, into
+// the model of the rows. Columns are matched to fields by their JSON name, case-insensitively, and
+// unknown columns are ignored. Empty cells leave the field at its zero value.
+func ReadCSV[T any](r io.Reader) ([]T, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the columns to field indexes.
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	fields := map[string][]int{}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[strings.ToLower(name)] = sf.Index
+	}
+	columns := make([][]int, len(header))
+	for i, name := range header {
+		columns[i] = fields[strings.ToLower(strings.TrimSpace(name))]
+	}
+
+	var rows []T
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var row T
+		v := reflect.ValueOf(&row).Elem()
+		for i, cell := range record {
+			if i >= len(columns) || columns[i] == nil || cell == "" {
+				continue
+			}
+			if err := setCSVField(v.FieldByIndex(columns[i]), cell); err != nil {
+				return nil, fmt.Errorf("line %d, column %s: %w", line, header[i], err)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+var dateType = reflect.TypeOf(openapi_types.Date{})
+
+func setCSVField(field reflect.Value, cell string) error {
+	if field.Kind() == reflect.Pointer {
+		ptr := reflect.New(field.Type().Elem())
+		if err := setCSVField(ptr.Elem(), cell); err != nil {
+			return err
+		}
+		field.Set(ptr)
+		return nil
+	}
+
+	if field.Type() == dateType {
+		t, err := time.Parse(openapi_types.DateFormat, cell)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(openapi_types.Date{Time: t}))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(cell)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		// Integers are sometimes rendered as floats, e.g. "5.0".
+		f, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(f))
+	case reflect.Bool:
+		b, err := strconv.ParseBool(cell)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	default:
+		return fmt.Errorf("not supported field type: %s", field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package financialmodelingprep
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type bulkSuite struct {
+	suite.Suite
+}
+
+func (r *bulkSuite) TestReadCSV() {
+	const data = `date,symbol,reportedCurrency,fiscalYear,period,revenue,netIncome,weightedAverageShsOut,unknownColumn
+2024-09-28,AAPL,USD,2024,FY,391035000000,93736000000,15343783000,x
+2024-06-30,MSFT,USD,2024,FY,245122000000,,7469000000,y
+`
+	statements, err := ReadCSV[IncomeStatement](strings.NewReader(data))
+	r.NoError(err)
+	r.Len(statements, 2)
+
+	r.Equal("AAPL", statements[0].Symbol)
+	r.Equal(time.Date(2024, time.September, 28, 0, 0, 0, 0, time.UTC), statements[0].Date.Time)
+	r.Equal("FY", statements[0].Period)
+	r.InDelta(391035000000, statements[0].Revenue, 1)
+
+	// Empty cells are left at zero.
+	r.Zero(statements[1].NetIncome)
+}
+
+func (r *bulkSuite) TestReadCSVInvalid() {
+	const data = `symbol,revenue
+AAPL,abc
+`
+	_, err := ReadCSV[IncomeStatement](strings.NewReader(data))
+	r.ErrorContains(err, "line 2")
+
+	statements, err := ReadCSV[IncomeStatement](strings.NewReader(""))
+	r.NoError(err)
+	r.Empty(statements)
+}
+
+func TestBulkSuite(t *testing.T) {
+	suite.Run(t, new(bulkSuite))
+}
@@ -59,6 +59,10 @@ type ClientConfig struct {
 	Endpoint string
 
 	Debug bool
+
+	// ValidateSymbols fails requests with invalid symbols before they are sent, see
+	// ValidateSymbolParams.
+	ValidateSymbols bool
 }
 
 func MustClient(cfg *ClientConfig) *ClientWithResponses {
@@ -81,6 +85,10 @@ func MustClient(cfg *ClientConfig) *ClientWithResponses {
 	clientOptions := []ClientOption{
 		httpClientOption,
 		WithRequestEditorFn(apiKeyProvider.Intercept),
+		WithRequestEditorFn(ValidateCustomDCFParams),
+	}
+	if cfg.ValidateSymbols {
+		clientOptions = append(clientOptions, WithRequestEditorFn(ValidateSymbolParams))
 	}
 
 	// Return client.
@@ -103,6 +111,8 @@ func Get(ctx context.Context, c *ClientWithResponses, path OperationPath, params
 
 	// Execute the API operation.
 	switch path {
+	case AllExchangeMarketHoursGetOperationPath:
+		resp, err = c.AllExchangeMarketHoursGet(ctx)
 	case AnalystEstimatesGetOperationPath:
 		var p AnalystEstimatesGetParams
 		if err := json.Unmarshal(paramsJSON, &p); err != nil {
@@ -147,6 +157,12 @@ func Get(ctx context.Context, c *ClientWithResponses, path OperationPath, params
 		resp, err = c.CashFlowStatementTTMGet(ctx, &p)
 	case CommoditiesListGetOperationPath:
 		resp, err = c.CommoditiesListGet(ctx)
+	case CompanyScreenerGetOperationPath:
+		var p CompanyScreenerGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.CompanyScreenerGet(ctx, &p)
 	case DcfBulkGetOperationPath:
 		resp, err = c.DcfBulkGet(ctx)
 	case DelistedCompaniesOperationPath:
@@ -173,6 +189,12 @@ func Get(ctx context.Context, c *ClientWithResponses, path OperationPath, params
 			return nil, err
 		}
 		resp, err = c.EsgRatingsGet(ctx, &p)
+	case ExchangeMarketHoursGetOperationPath:
+		var p ExchangeMarketHoursGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.ExchangeMarketHoursGet(ctx, &p)
 	case GradesLatestNewsGetOperationPath:
 		var p GradesLatestNewsGetParams
 		if err := json.Unmarshal(paramsJSON, &p); err != nil {
@@ -197,6 +219,12 @@ func Get(ctx context.Context, c *ClientWithResponses, path OperationPath, params
 			return nil, err
 		}
 		resp, err = c.HistoricalPriceEodLightGet(ctx, &p)
+	case HolidaysByExchangeGetOperationPath:
+		var p HolidaysByExchangeGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.HolidaysByExchangeGet(ctx, &p)
 	case IncomeStatementGetOperationPath:
 		var p IncomeStatementGetParams
 		if err := json.Unmarshal(paramsJSON, &p); err != nil {
@@ -297,6 +325,24 @@ func Get(ctx context.Context, c *ClientWithResponses, path OperationPath, params
 			return nil, err
 		}
 		resp, err = c.RevenueProductSegmentationGet(ctx, &p)
+	case SearchCIKGetOperationPath:
+		var p SearchCIKGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.SearchCIKGet(ctx, &p)
+	case SearchCUSIPGetOperationPath:
+		var p SearchCUSIPGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.SearchCUSIPGet(ctx, &p)
+	case SearchISINGetOperationPath:
+		var p SearchISINGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.SearchISINGet(ctx, &p)
 	case SearchNameGetOperationPath:
 		var p SearchNameGetParams
 		if err := json.Unmarshal(paramsJSON, &p); err != nil {
@@ -315,6 +361,12 @@ func Get(ctx context.Context, c *ClientWithResponses, path OperationPath, params
 			return nil, err
 		}
 		resp, err = c.SharesFloatGet(ctx, &p)
+	case SymbolChangeGetOperationPath:
+		var p SymbolChangeGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.SymbolChangeGet(ctx, &p)
 	case TreasuryRatesGetOperationPath:
 		var p TreasuryRatesGetParams
 		if err := json.Unmarshal(paramsJSON, &p); err != nil {
@@ -399,6 +451,18 @@ func Get(ctx context.Context, c *ClientWithResponses, path OperationPath, params
 			return nil, err
 		}
 		resp, err = c.IndexConstituentListGet(ctx, &p)
+	case SP500ConstituentGetOperationPath:
+		resp, err = c.SP500ConstituentGet(ctx)
+	case NasdaqConstituentGetOperationPath:
+		resp, err = c.NasdaqConstituentGet(ctx)
+	case DowJonesConstituentGetOperationPath:
+		resp, err = c.DowJonesConstituentGet(ctx)
+	case HistoricalSP500ConstituentGetOperationPath:
+		resp, err = c.HistoricalSP500ConstituentGet(ctx)
+	case HistoricalNasdaqConstituentGetOperationPath:
+		resp, err = c.HistoricalNasdaqConstituentGet(ctx)
+	case HistoricalDowJonesConstituentGetOperationPath:
+		resp, err = c.HistoricalDowJonesConstituentGet(ctx)
 	case BatchIndexQuotesGetOperationPath:
 		var p BatchIndexQuotesGetParams
 		if err := json.Unmarshal(paramsJSON, &p); err != nil {
@@ -425,8 +489,86 @@ func Get(ctx context.Context, c *ClientWithResponses, path OperationPath, params
 			return nil, err
 		}
 		resp, err = c.ETFSectorWeightingsGet(ctx, &p)
+	case ETFHoldingsGetOperationPath:
+		var p ETFHoldingsGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.ETFHoldingsGet(ctx, &p)
 	case ForexCurrencyPairsGetOperationPath:
 		resp, err = c.ForexCurrencyPairsGet(ctx)
+	case FinancialScoresGetOperationPath:
+		var p FinancialScoresGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.FinancialScoresGet(ctx, &p)
+	case DiscountedCashFlowGetOperationPath:
+		var p DiscountedCashFlowGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.DiscountedCashFlowGet(ctx, &p)
+	case LeveredDiscountedCashFlowGetOperationPath:
+		var p LeveredDiscountedCashFlowGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.LeveredDiscountedCashFlowGet(ctx, &p)
+	case CustomDiscountedCashFlowGetOperationPath:
+		var p CustomDiscountedCashFlowGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+		resp, err = c.CustomDiscountedCashFlowGet(ctx, &p)
+	case CustomLeveredDiscountedCashFlowGetOperationPath:
+		var p CustomLeveredDiscountedCashFlowGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+		resp, err = c.CustomLeveredDiscountedCashFlowGet(ctx, &p)
+	case GradesGetOperationPath:
+		var p GradesGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.GradesGet(ctx, &p)
+	case GradesHistoricalGetOperationPath:
+		var p GradesHistoricalGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.GradesHistoricalGet(ctx, &p)
+	case PriceTargetSummaryGetOperationPath:
+		var p PriceTargetSummaryGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.PriceTargetSummaryGet(ctx, &p)
+	case PriceTargetConsensusGetOperationPath:
+		var p PriceTargetConsensusGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.PriceTargetConsensusGet(ctx, &p)
+	case PriceTargetNewsGetOperationPath:
+		var p PriceTargetNewsGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.PriceTargetNewsGet(ctx, &p)
+	case PriceTargetLatestNewsGetOperationPath:
+		var p PriceTargetLatestNewsGetParams
+		if err := json.Unmarshal(paramsJSON, &p); err != nil {
+			return nil, err
+		}
+		resp, err = c.PriceTargetLatestNewsGet(ctx, &p)
 	default:
 		return nil, fmt.Errorf("not supported operation path: %s", string(path))
 	}
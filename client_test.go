@@ -69,6 +69,106 @@ func (r *clientSuite) TestStockList() {
 	}
 }
 
+func (r *clientSuite) TestExchangeMarketHours() {
+	if resp, err := r.c.ExchangeMarketHoursGetWithResponse(context.Background(), &ExchangeMarketHoursGetParams{Exchange: "NASDAQ"}); err != nil {
+		r.NoError(err)
+	} else {
+		r.Equal(http.StatusOK, resp.StatusCode())
+		r.NotNil(resp.JSON200)
+		r.NotEmpty(*resp.JSON200)
+	}
+}
+
+func (r *clientSuite) TestHolidaysByExchange() {
+	if resp, err := r.c.HolidaysByExchangeGetWithResponse(context.Background(), &HolidaysByExchangeGetParams{Exchange: "NASDAQ"}); err != nil {
+		r.NoError(err)
+	} else {
+		r.Equal(http.StatusOK, resp.StatusCode())
+		r.NotNil(resp.JSON200)
+		r.NotEmpty(*resp.JSON200)
+	}
+}
+
+func (r *clientSuite) TestAllExchangeMarketHours() {
+	if resp, err := r.c.AllExchangeMarketHoursGetWithResponse(context.Background()); err != nil {
+		r.NoError(err)
+	} else {
+		r.Equal(http.StatusOK, resp.StatusCode())
+		r.NotNil(resp.JSON200)
+		r.NotEmpty(*resp.JSON200)
+	}
+}
+
+func (r *clientSuite) TestSearchCIK() {
+	if resp, err := r.c.SearchCIKGetWithResponse(context.Background(), &SearchCIKGetParams{Cik: "320193"}); err != nil {
+		r.NoError(err)
+	} else {
+		r.Equal(http.StatusOK, resp.StatusCode())
+		r.NotNil(resp.JSON200)
+		r.NotEmpty(*resp.JSON200)
+	}
+}
+
+func (r *clientSuite) TestSearchCUSIP() {
+	if resp, err := r.c.SearchCUSIPGetWithResponse(context.Background(), &SearchCUSIPGetParams{Cusip: "037833100"}); err != nil {
+		r.NoError(err)
+	} else {
+		r.Equal(http.StatusOK, resp.StatusCode())
+		r.NotNil(resp.JSON200)
+		r.NotEmpty(*resp.JSON200)
+	}
+}
+
+func (r *clientSuite) TestSearchISIN() {
+	params := map[string]interface{}{
+		"isin": "US0378331005",
+	}
+	if resp, err := Get(context.Background(), r.c, SearchISINGetOperationPath, params); err != nil {
+		r.NoError(err)
+	} else {
+		r.NoError(err)
+		r.Equal(http.StatusOK, resp.StatusCode)
+
+		var results []ISINSearchResult
+		err = json.NewDecoder(resp.Body).Decode(&results)
+		r.NoError(err)
+		r.NotEmpty(results)
+	}
+}
+
+func (r *clientSuite) TestSymbolChange() {
+	limit := 10
+	if resp, err := r.c.SymbolChangeGetWithResponse(context.Background(), &SymbolChangeGetParams{Limit: &limit}); err != nil {
+		r.NoError(err)
+	} else {
+		r.Equal(http.StatusOK, resp.StatusCode())
+		r.NotNil(resp.JSON200)
+		r.NotEmpty(*resp.JSON200)
+	}
+}
+
+func (r *clientSuite) TestCompanyScreener() {
+	criteria := ScreenerCriteria{
+		MarketCap:         Range{Min: float64Ptr(1e11)},
+		Sector:            "Technology",
+		Country:           "US",
+		IsActivelyTrading: boolPtr(true),
+		Limit:             10,
+	}
+	if resp, err := r.c.CompanyScreenerGetWithResponse(context.Background(), criteria.Params()); err != nil {
+		r.NoError(err)
+	} else {
+		r.Equal(http.StatusOK, resp.StatusCode())
+		r.NotNil(resp.JSON200)
+
+		results := *resp.JSON200
+		r.NotEmpty(results)
+		for _, c := range results {
+			r.GreaterOrEqual(c.MarketCap, 1e11)
+		}
+	}
+}
+
 func (r *clientSuite) TestProfileAAPL() {
 	symbol := "AAPL"
 	if resp, err := r.c.ProfileGetWithResponse(context.Background(), &ProfileGetParams{
@@ -294,6 +394,25 @@ func (r *clientSuite) TestKeyMetricsNMG() {
 	}
 }
 
+func (r *clientSuite) TestFinancialScoresAAPL() {
+	const symbol = "AAPL"
+	params := map[string]interface{}{
+		"symbol": symbol,
+	}
+	if resp, err := Get(context.Background(), r.c, FinancialScoresGetOperationPath, params); err != nil {
+		r.NoError(err)
+	} else {
+		r.Equal(http.StatusOK, resp.StatusCode)
+
+		var scores []FinancialScores
+		r.NoError(json.NewDecoder(resp.Body).Decode(&scores))
+		r.Len(scores, 1)
+		r.Equal(symbol, scores[0].Symbol)
+		r.NotZero(scores[0].AltmanZScore)
+		r.NotZero(scores[0].PiotroskiScore)
+	}
+}
+
 func (r *clientSuite) TestTechnicalIndicatorsRsi() {
 	const symbol = "AAPL"
 	params := map[string]interface{}{
@@ -390,6 +509,46 @@ func (r *clientSuite) TestHistoricalChart15Min() {
 	}
 }
 
+// TestRecordTTMFixtures records the quarterly statements of AAPL and the responses of the TTM
+// endpoints into testdata/ttm-aapl, which ttmSuite compares against. It only runs with
+// FMP_RECORD_FIXTURES set.
+func (r *clientSuite) TestRecordTTMFixtures() {
+	if os.Getenv("FMP_RECORD_FIXTURES") == "" {
+		r.T().Skip("FMP_RECORD_FIXTURES is not set")
+	}
+	ctx := context.Background()
+	limit, period := 8, Quarter
+	r.Require().NoError(os.MkdirAll(ttmFixtureDir, 0o755))
+	record := func(name string, status int, body []byte) {
+		r.Require().Equal(http.StatusOK, status, name)
+		r.Require().NoError(os.WriteFile(ttmFixture(name), body, 0o644))
+	}
+
+	income, err := r.c.IncomeStatementGetWithResponse(ctx, &IncomeStatementGetParams{Symbol: "AAPL", Limit: &limit, Period: &period})
+	r.Require().NoError(err)
+	record(incomeStatementName+"-quarter", income.StatusCode(), income.Body)
+
+	cashFlow, err := r.c.CashFlowStatementGetWithResponse(ctx, &CashFlowStatementGetParams{Symbol: "AAPL", Limit: &limit, Period: &period})
+	r.Require().NoError(err)
+	record(cashFlowStatementName+"-quarter", cashFlow.StatusCode(), cashFlow.Body)
+
+	balanceSheet, err := r.c.BalanceSheetStatementGetWithResponse(ctx, &BalanceSheetStatementGetParams{Symbol: "AAPL", Limit: &limit, Period: &period})
+	r.Require().NoError(err)
+	record(balanceSheetStatementName+"-quarter", balanceSheet.StatusCode(), balanceSheet.Body)
+
+	incomeTTM, err := r.c.IncomeStatementTTMGetWithResponse(ctx, &IncomeStatementTTMGetParams{Symbol: "AAPL"})
+	r.Require().NoError(err)
+	record(incomeStatementName+"-ttm", incomeTTM.StatusCode(), incomeTTM.Body)
+
+	cashFlowTTM, err := r.c.CashFlowStatementTTMGetWithResponse(ctx, &CashFlowStatementTTMGetParams{Symbol: "AAPL"})
+	r.Require().NoError(err)
+	record(cashFlowStatementName+"-ttm", cashFlowTTM.StatusCode(), cashFlowTTM.Body)
+
+	balanceSheetTTM, err := r.c.BalanceSheetStatementTTMGetWithResponse(ctx, &BalanceSheetStatementTTMGetParams{Symbol: "AAPL"})
+	r.Require().NoError(err)
+	record(balanceSheetStatementName+"-ttm", balanceSheetTTM.StatusCode(), balanceSheetTTM.Body)
+}
+
 func (r *clientSuite) TestKeyMetricsBulkTTM() {
 	if resp, err := Get(context.Background(), r.c, KeyMetricsTTMBulkGetOperationPath, nil); err != nil {
 		r.NoError(err)
@@ -436,6 +595,34 @@ func (r *clientSuite) TestIndexConstituentList() {
 	}
 }
 
+func (r *clientSuite) TestSP500Constituent() {
+	resp, err := r.c.SP500ConstituentGetWithResponse(context.Background())
+	r.NoError(err)
+	r.Equal(http.StatusOK, resp.StatusCode())
+	r.NotEmpty(*resp.JSON200)
+}
+
+func (r *clientSuite) TestHistoricalSP500Constituent() {
+	resp, err := r.c.HistoricalSP500ConstituentGetWithResponse(context.Background())
+	r.NoError(err)
+	r.Equal(http.StatusOK, resp.StatusCode())
+	r.NotEmpty(*resp.JSON200)
+}
+
+func (r *clientSuite) TestHistoricalDowJonesConstituent() {
+	if resp, err := Get(context.Background(), r.c, HistoricalDowJonesConstituentGetOperationPath, nil); err != nil {
+		r.NoError(err)
+	} else {
+		r.NoError(err)
+		r.Equal(http.StatusOK, resp.StatusCode)
+
+		var changes []HistoricalIndexConstituent
+		err = json.NewDecoder(resp.Body).Decode(&changes)
+		r.NoError(err)
+		r.NotEmpty(changes)
+	}
+}
+
 func (r *clientSuite) TestBatchIndexQuotesShort() {
 	params := map[string]interface{}{
 		"short": true,
@@ -520,6 +707,25 @@ func (r *clientSuite) TestETFSectorWeightings() {
 	}
 }
 
+func (r *clientSuite) TestETFHoldings() {
+	const symbol = "SPY"
+	params := map[string]interface{}{
+		"symbol": symbol,
+	}
+	if resp, err := Get(context.Background(), r.c, ETFHoldingsGetOperationPath, params); err != nil {
+		r.NoError(err)
+	} else {
+		r.NoError(err)
+		r.Equal(http.StatusOK, resp.StatusCode)
+
+		var ehList []ETFHolding
+		err = json.NewDecoder(resp.Body).Decode(&ehList)
+		r.NoError(err)
+		r.NotEmpty(ehList)
+		r.Equal(symbol, ehList[0].Symbol)
+	}
+}
+
 func (r *clientSuite) TestETFInfoSKWEAS() {
 	const symbol = "SKWE.AS"
 	params := map[string]interface{}{
@@ -538,6 +744,156 @@ func (r *clientSuite) TestETFInfoSKWEAS() {
 	}
 }
 
+func (r *clientSuite) TestDiscountedCashFlowAAPL() {
+	const symbol = "AAPL"
+	params := DiscountedCashFlowGetParams{
+		Symbol: symbol,
+	}
+	if resp, err := r.c.DiscountedCashFlowGetWithResponse(context.Background(), &params); err != nil {
+		r.NoError(err)
+	} else {
+		r.Equal(http.StatusOK, resp.StatusCode())
+		r.NotNil(resp.JSON200)
+
+		dcfList := *resp.JSON200
+		r.NotEmpty(dcfList)
+		r.Equal(symbol, dcfList[0].Symbol)
+		r.NotZero(dcfList[0].StockPrice)
+	}
+}
+
+func (r *clientSuite) TestCustomLeveredDiscountedCashFlowAAPL() {
+	const symbol = "AAPL"
+	params := map[string]interface{}{
+		"symbol":             symbol,
+		"taxRate":            0.15,
+		"longTermGrowthRate": 3,
+	}
+	if resp, err := Get(context.Background(), r.c, CustomLeveredDiscountedCashFlowGetOperationPath, params); err != nil {
+		r.NoError(err)
+	} else {
+		r.NoError(err)
+		r.Equal(http.StatusOK, resp.StatusCode)
+
+		var cdcfList []CustomLeveredDiscountedCashFlow
+		err = json.NewDecoder(resp.Body).Decode(&cdcfList)
+		r.NoError(err)
+		r.NotEmpty(cdcfList)
+		r.InDelta(3, cdcfList[0].LongTermGrowthRate, 1e-9)
+	}
+
+	// Percent given for a fraction.
+	params["taxRate"] = 15
+	_, err := Get(context.Background(), r.c, CustomLeveredDiscountedCashFlowGetOperationPath, params)
+	r.ErrorContains(err, "taxRate")
+}
+
+func (r *clientSuite) TestGradesAAPL() {
+	const symbol = "AAPL"
+	params := GradesGetParams{
+		Symbol: symbol,
+	}
+	if resp, err := r.c.GradesGetWithResponse(context.Background(), &params); err != nil {
+		r.NoError(err)
+	} else {
+		r.Equal(http.StatusOK, resp.StatusCode())
+		r.NotNil(resp.JSON200)
+
+		gList := *resp.JSON200
+		r.NotEmpty(gList)
+		r.Equal(symbol, gList[0].Symbol)
+		r.NotEmpty(gList[0].GradingCompany)
+	}
+}
+
+func (r *clientSuite) TestGradesHistoricalAAPL() {
+	params := map[string]interface{}{
+		"symbol": "AAPL",
+		"limit":  3,
+	}
+	if resp, err := Get(context.Background(), r.c, GradesHistoricalGetOperationPath, params); err != nil {
+		r.NoError(err)
+	} else {
+		r.NoError(err)
+		r.Equal(http.StatusOK, resp.StatusCode)
+
+		var hgList []HistoricalGrade
+		err = json.NewDecoder(resp.Body).Decode(&hgList)
+		r.NoError(err)
+		r.NotEmpty(hgList)
+		r.LessOrEqual(len(hgList), params["limit"].(int))
+	}
+}
+
+func (r *clientSuite) TestPriceTargetSummaryAAPL() {
+	const symbol = "AAPL"
+	params := PriceTargetSummaryGetParams{
+		Symbol: symbol,
+	}
+	if resp, err := r.c.PriceTargetSummaryGetWithResponse(context.Background(), &params); err != nil {
+		r.NoError(err)
+	} else {
+		r.Equal(http.StatusOK, resp.StatusCode())
+		r.NotNil(resp.JSON200)
+
+		ptsList := *resp.JSON200
+		r.NotEmpty(ptsList)
+		r.Equal(symbol, ptsList[0].Symbol)
+		r.NotZero(ptsList[0].AllTimeCount)
+	}
+}
+
+func (r *clientSuite) TestPriceTargetConsensusAAPL() {
+	const symbol = "AAPL"
+	params := PriceTargetConsensusGetParams{
+		Symbol: symbol,
+	}
+	if resp, err := r.c.PriceTargetConsensusGetWithResponse(context.Background(), &params); err != nil {
+		r.NoError(err)
+	} else {
+		r.Equal(http.StatusOK, resp.StatusCode())
+		r.NotNil(resp.JSON200)
+
+		ptcList := *resp.JSON200
+		r.NotEmpty(ptcList)
+		r.Equal(symbol, ptcList[0].Symbol)
+		r.LessOrEqual(ptcList[0].TargetLow, ptcList[0].TargetHigh)
+	}
+}
+
+func (r *clientSuite) TestPriceTargetNewsAAPL() {
+	params := map[string]interface{}{
+		"symbol": "AAPL",
+		"limit":  2,
+	}
+	if resp, err := Get(context.Background(), r.c, PriceTargetNewsGetOperationPath, params); err != nil {
+		r.NoError(err)
+	} else {
+		r.NoError(err)
+		r.Equal(http.StatusOK, resp.StatusCode)
+
+		var ptnList []PriceTargetNews
+		err = json.NewDecoder(resp.Body).Decode(&ptnList)
+		r.NoError(err)
+		r.NotEmpty(ptnList)
+		r.LessOrEqual(len(ptnList), params["limit"].(int))
+		for _, news := range ptnList {
+			r.NotZero(news.PriceTarget)
+		}
+	}
+}
+
+func (r *clientSuite) TestPriceTargetLatestNews() {
+	params := PriceTargetLatestNewsGetParams{}
+	if resp, err := r.c.PriceTargetLatestNewsGetWithResponse(context.Background(), &params); err != nil {
+		r.NoError(err)
+	} else {
+		r.Equal(http.StatusOK, resp.StatusCode())
+		r.NotNil(resp.JSON200)
+		r.NotEmpty(*resp.JSON200)
+	}
+}
+
 func TestClientSuite(t *testing.T) {
 	suite.Run(t, new(clientSuite))
 }
@@ -0,0 +1,379 @@
+package financialmodelingprep
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// IndexType is a stock market index with dedicated constituent endpoints.
+type IndexType string
+
+const (
+	IndexUnknown  IndexType = ""
+	IndexSP500    IndexType = "sp500"
+	IndexNasdaq   IndexType = "nasdaq"
+	IndexDowJones IndexType = "dowjones"
+)
+
+// IndexTypes are the indexes with dedicated constituent endpoints.
+var IndexTypes = []IndexType{IndexSP500, IndexNasdaq, IndexDowJones}
+
+var indexTypeNames = map[string]IndexType{
+	"sp500":     IndexSP500,
+	"s&p 500":   IndexSP500,
+	"s&p500":    IndexSP500,
+	"spx":       IndexSP500,
+	"^gspc":     IndexSP500,
+	"nasdaq":    IndexNasdaq,
+	"nasdaq100": IndexNasdaq,
+	"ndx":       IndexNasdaq,
+	"^ndx":      IndexNasdaq,
+	"dowjones":  IndexDowJones,
+	"dow jones": IndexDowJones,
+	"dow":       IndexDowJones,
+	"djia":      IndexDowJones,
+	"^dji":      IndexDowJones,
+}
+
+// ParseIndexType parses an index such as "sp500", "S&P 500" or "^DJI". Unknown values parse as
+// IndexUnknown.
+func ParseIndexType(s string) IndexType {
+	return indexTypeNames[strings.ToLower(strings.TrimSpace(s))]
+}
+
+// GetIndexConstituents fetches the current constituents of an index.
+func GetIndexConstituents(ctx context.Context, c *ClientWithResponses, index IndexType) ([]IndexConstituent, error) {
+	var (
+		constituents *[]IndexConstituent
+		status       string
+	)
+	switch index {
+	case IndexSP500:
+		resp, err := c.SP500ConstituentGetWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		constituents, status = resp.JSON200, resp.Status()
+	case IndexNasdaq:
+		resp, err := c.NasdaqConstituentGetWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		constituents, status = resp.JSON200, resp.Status()
+	case IndexDowJones:
+		resp, err := c.DowJonesConstituentGetWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		constituents, status = resp.JSON200, resp.Status()
+	default:
+		return nil, fmt.Errorf("unsupported index: %q", index)
+	}
+	if constituents == nil {
+		return nil, fmt.Errorf("unexpected status of %s constituents: %s", index, status)
+	}
+	return *constituents, nil
+}
+
+// ConstituentChangeKind tells additions to an index from removals.
+type ConstituentChangeKind string
+
+const (
+	ConstituentAdded   ConstituentChangeKind = "added"
+	ConstituentRemoved ConstituentChangeKind = "removed"
+)
+
+// ConstituentChange is the addition or removal of a company to or from an index.
+type ConstituentChange struct {
+	Index  IndexType
+	Date   time.Time
+	Kind   ConstituentChangeKind
+	Symbol string
+
+	// Name and Reason are only set for changes from the historical constituent endpoints.
+	Name   string
+	Reason string
+}
+
+func sortConstituentChanges(changes []ConstituentChange) {
+	// Removals go first on the same date, so a company replaced by itself under a new ticker is
+	// never a member twice.
+	sort.SliceStable(changes, func(i, j int) bool {
+		if !changes[i].Date.Equal(changes[j].Date) {
+			return changes[i].Date.Before(changes[j].Date)
+		}
+		return changes[i].Kind == ConstituentRemoved && changes[j].Kind == ConstituentAdded
+	})
+}
+
+// NewConstituentChanges splits the rows of the historical constituent endpoints into additions
+// and removals, oldest first.
+func NewConstituentChanges(index IndexType, rows []HistoricalIndexConstituent) []ConstituentChange {
+	var changes []ConstituentChange
+	for _, r := range rows {
+		if r.RemovedTicker != "" {
+			changes = append(changes, ConstituentChange{
+				Index:  index,
+				Date:   r.Date.Time,
+				Kind:   ConstituentRemoved,
+				Symbol: r.RemovedTicker,
+				Name:   r.RemovedSecurity,
+				Reason: r.Reason,
+			})
+		}
+		if r.Symbol != "" {
+			changes = append(changes, ConstituentChange{
+				Index:  index,
+				Date:   r.Date.Time,
+				Kind:   ConstituentAdded,
+				Symbol: r.Symbol,
+				Name:   r.AddedSecurity,
+				Reason: r.Reason,
+			})
+		}
+	}
+	sortConstituentChanges(changes)
+	return changes
+}
+
+// MembersAsOf rolls the current members of an index back to the end of the day asOf by undoing
+// the changes after it, and returns the members then, sorted. The changes must be sorted oldest
+// first, as returned by NewConstituentChanges.
+func MembersAsOf(current []string, changes []ConstituentChange, asOf time.Time) []string {
+	members := map[string]bool{}
+	for _, symbol := range current {
+		members[symbol] = true
+	}
+	// Changes are dated by day at midnight UTC.
+	end := time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	for i := len(changes) - 1; i >= 0 && !changes[i].Date.Before(end); i-- {
+		switch changes[i].Kind {
+		case ConstituentAdded:
+			delete(members, changes[i].Symbol)
+		case ConstituentRemoved:
+			members[changes[i].Symbol] = true
+		}
+	}
+	symbols := make([]string, 0, len(members))
+	for symbol := range members {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// GetConstituentChanges fetches the historical changes to an index, oldest first.
+func GetConstituentChanges(ctx context.Context, c *ClientWithResponses, index IndexType) ([]ConstituentChange, error) {
+	var (
+		rows   *[]HistoricalIndexConstituent
+		status string
+	)
+	switch index {
+	case IndexSP500:
+		resp, err := c.HistoricalSP500ConstituentGetWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows, status = resp.JSON200, resp.Status()
+	case IndexNasdaq:
+		resp, err := c.HistoricalNasdaqConstituentGetWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows, status = resp.JSON200, resp.Status()
+	case IndexDowJones:
+		resp, err := c.HistoricalDowJonesConstituentGetWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows, status = resp.JSON200, resp.Status()
+	default:
+		return nil, fmt.Errorf("unsupported index: %q", index)
+	}
+	if rows == nil {
+		return nil, fmt.Errorf("unexpected status of historical %s constituents: %s", index, status)
+	}
+	return NewConstituentChanges(index, *rows), nil
+}
+
+// GetMembersAsOf fetches the current constituents and the historical changes of an index and
+// returns its members at the end of the day asOf, sorted.
+func GetMembersAsOf(ctx context.Context, c *ClientWithResponses, index IndexType, asOf time.Time) ([]string, error) {
+	constituents, err := GetIndexConstituents(ctx, c, index)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := GetConstituentChanges(ctx, c, index)
+	if err != nil {
+		return nil, err
+	}
+	current := make([]string, len(constituents))
+	for i := range constituents {
+		current[i] = constituents[i].Symbol
+	}
+	return MembersAsOf(current, changes, asOf), nil
+}
+
+// ConstituentSnapshot is the membership of an index as seen at a point in time.
+type ConstituentSnapshot struct {
+	Index IndexType `json:"index"`
+	Taken time.Time `json:"taken"`
+
+	// Symbols are sorted.
+	Symbols []string `json:"symbols"`
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func containsSorted(values []string, v string) bool {
+	i := sort.SearchStrings(values, v)
+	return i < len(values) && values[i] == v
+}
+
+// ConstituentStore keeps snapshots of the membership of indexes, so additions and removals can be
+// tracked locally, independently of the historical constituent endpoints. A snapshot is only
+// stored when the membership changed since the previous one.
+type ConstituentStore struct {
+	mu        sync.RWMutex
+	snapshots map[IndexType][]ConstituentSnapshot
+}
+
+// NewConstituentStore returns an empty store.
+func NewConstituentStore() *ConstituentStore {
+	return &ConstituentStore{snapshots: map[IndexType][]ConstituentSnapshot{}}
+}
+
+// Add records the members of an index as seen at taken. It reports whether a snapshot was stored.
+func (s *ConstituentStore) Add(taken time.Time, index IndexType, symbols []string) bool {
+	members := sortedUnique(append([]string(nil), symbols...))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Keep the snapshots sorted, and skip those equal to the membership in effect at taken.
+	snapshots := s.snapshots[index]
+	i := sort.Search(len(snapshots), func(i int) bool {
+		return snapshots[i].Taken.After(taken)
+	})
+	if i > 0 && equalStrings(snapshots[i-1].Symbols, members) {
+		return false
+	}
+	snapshots = append(snapshots, ConstituentSnapshot{})
+	copy(snapshots[i+1:], snapshots[i:])
+	snapshots[i] = ConstituentSnapshot{Index: index, Taken: taken, Symbols: members}
+	s.snapshots[index] = snapshots
+	return true
+}
+
+// Snapshot fetches the current constituents of an index and adds them to the store.
+func (s *ConstituentStore) Snapshot(ctx context.Context, c *ClientWithResponses, index IndexType) (bool, error) {
+	constituents, err := GetIndexConstituents(ctx, c, index)
+	if err != nil {
+		return false, err
+	}
+	symbols := make([]string, len(constituents))
+	for i := range constituents {
+		symbols[i] = constituents[i].Symbol
+	}
+	return s.Add(time.Now(), index, symbols), nil
+}
+
+// History returns the snapshots of an index, oldest first.
+func (s *ConstituentStore) History(index IndexType) []ConstituentSnapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]ConstituentSnapshot(nil), s.snapshots[index]...)
+}
+
+// Members returns the members of an index as seen at t, sorted, or nil before the first snapshot.
+func (s *ConstituentStore) Members(index IndexType, t time.Time) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	snapshots := s.snapshots[index]
+	i := sort.Search(len(snapshots), func(i int) bool {
+		return snapshots[i].Taken.After(t)
+	})
+	if i == 0 {
+		return nil
+	}
+	return append([]string(nil), snapshots[i-1].Symbols...)
+}
+
+// Changes diffs consecutive snapshots of an index into additions and removals, dated by the
+// snapshot they were first seen in, oldest first.
+func (s *ConstituentStore) Changes(index IndexType) []ConstituentChange {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var changes []ConstituentChange
+	snapshots := s.snapshots[index]
+	for i := 1; i < len(snapshots); i++ {
+		previous, current := snapshots[i-1].Symbols, snapshots[i].Symbols
+		for _, symbol := range previous {
+			if !containsSorted(current, symbol) {
+				changes = append(changes, ConstituentChange{Index: index, Date: snapshots[i].Taken, Kind: ConstituentRemoved, Symbol: symbol})
+			}
+		}
+		for _, symbol := range current {
+			if !containsSorted(previous, symbol) {
+				changes = append(changes, ConstituentChange{Index: index, Date: snapshots[i].Taken, Kind: ConstituentAdded, Symbol: symbol})
+			}
+		}
+	}
+	return changes
+}
+
+// Save writes the snapshots as JSON lines, sorted by index then time.
+func (s *ConstituentStore) Save(w io.Writer) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	indexes := make([]IndexType, 0, len(s.snapshots))
+	for index := range s.snapshots {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i] < indexes[j]
+	})
+
+	enc := json.NewEncoder(w)
+	for _, index := range indexes {
+		for i := range s.snapshots[index] {
+			if err := enc.Encode(&s.snapshots[index][i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Load reads snapshots written by Save and adds them to the store.
+func (s *ConstituentStore) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var snapshot ConstituentSnapshot
+		if err := json.Unmarshal(scanner.Bytes(), &snapshot); err != nil {
+			return err
+		}
+		s.Add(snapshot.Taken, snapshot.Index, snapshot.Symbols)
+	}
+	return scanner.Err()
+}
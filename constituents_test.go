@@ -0,0 +1,88 @@
+package financialmodelingprep
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type constituentsSuite struct {
+	suite.Suite
+}
+
+func (r *constituentsSuite) TestParseIndexType() {
+	r.Equal(IndexSP500, ParseIndexType("S&P 500"))
+	r.Equal(IndexSP500, ParseIndexType("^GSPC"))
+	r.Equal(IndexNasdaq, ParseIndexType(" nasdaq "))
+	r.Equal(IndexDowJones, ParseIndexType("DJIA"))
+	r.Equal(IndexUnknown, ParseIndexType("STOXX50"))
+}
+
+func (r *constituentsSuite) TestMembersAsOf() {
+	changes := NewConstituentChanges(IndexSP500, []HistoricalIndexConstituent{
+		{Date: fixtureDate(2025, time.March, 24), Symbol: "DASH", AddedSecurity: "DoorDash", RemovedTicker: "BWA", RemovedSecurity: "BorgWarner"},
+		{Date: fixtureDate(2024, time.September, 23), Symbol: "PLTR", AddedSecurity: "Palantir", RemovedTicker: "AAL", RemovedSecurity: "American Airlines"},
+		// A ticker change replaces the company by itself.
+		{Date: fixtureDate(2025, time.January, 2), Symbol: "NEW", RemovedTicker: "OLD"},
+		// An addition without a removal.
+		{Date: fixtureDate(2025, time.June, 2), Symbol: "EXTRA"},
+	})
+	r.Len(changes, 7)
+	r.Equal("AAL", changes[0].Symbol)
+	r.Equal(ConstituentRemoved, changes[0].Kind)
+	r.Equal("American Airlines", changes[0].Name)
+	r.Equal("PLTR", changes[1].Symbol)
+	r.Equal(ConstituentAdded, changes[1].Kind)
+
+	current := []string{"AAPL", "DASH", "EXTRA", "NEW", "PLTR"}
+	r.Equal(current, MembersAsOf(current, changes, time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)))
+	r.Equal([]string{"AAPL", "DASH", "NEW", "PLTR"}, MembersAsOf(current, changes, time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)))
+	// The day of a change includes it.
+	r.Equal([]string{"AAPL", "DASH", "NEW", "PLTR"}, MembersAsOf(current, changes, time.Date(2025, time.March, 24, 0, 0, 0, 0, time.UTC)))
+	r.Equal([]string{"AAPL", "BWA", "NEW", "PLTR"}, MembersAsOf(current, changes, time.Date(2025, time.March, 23, 0, 0, 0, 0, time.UTC)))
+	r.Equal([]string{"AAL", "AAPL", "BWA", "OLD"}, MembersAsOf(current, changes, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)))
+
+	newYork, err := time.LoadLocation("America/New_York")
+	r.Require().NoError(err)
+	r.Equal([]string{"AAPL", "BWA", "NEW", "PLTR"}, MembersAsOf(current, changes, time.Date(2025, time.March, 23, 20, 0, 0, 0, newYork)))
+}
+
+func (r *constituentsSuite) TestStore() {
+	s := NewConstituentStore()
+	day := func(d int) time.Time {
+		return time.Date(2025, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+	r.True(s.Add(day(1), IndexDowJones, []string{"MSFT", "AAPL", "INTC"}))
+	r.False(s.Add(day(2), IndexDowJones, []string{"AAPL", "INTC", "MSFT", "AAPL"}))
+	r.True(s.Add(day(10), IndexDowJones, []string{"AAPL", "MSFT", "NVDA"}))
+	// Out of order snapshots are inserted by time.
+	r.True(s.Add(day(5), IndexDowJones, []string{"AAPL", "INTC", "MSFT", "SHW"}))
+	r.True(s.Add(day(1), IndexSP500, []string{"AAPL"}))
+	r.Len(s.History(IndexDowJones), 3)
+
+	r.Nil(s.Members(IndexDowJones, day(0)))
+	r.Equal([]string{"AAPL", "INTC", "MSFT"}, s.Members(IndexDowJones, day(4)))
+	r.Equal([]string{"AAPL", "INTC", "MSFT", "SHW"}, s.Members(IndexDowJones, day(5)))
+
+	changes := s.Changes(IndexDowJones)
+	r.Equal([]ConstituentChange{
+		{Index: IndexDowJones, Date: day(5), Kind: ConstituentAdded, Symbol: "SHW"},
+		{Index: IndexDowJones, Date: day(10), Kind: ConstituentRemoved, Symbol: "INTC"},
+		{Index: IndexDowJones, Date: day(10), Kind: ConstituentRemoved, Symbol: "SHW"},
+		{Index: IndexDowJones, Date: day(10), Kind: ConstituentAdded, Symbol: "NVDA"},
+	}, changes)
+
+	var buf bytes.Buffer
+	r.Require().NoError(s.Save(&buf))
+	loaded := NewConstituentStore()
+	r.Require().NoError(loaded.Load(&buf))
+	r.Len(loaded.History(IndexDowJones), 3)
+	r.Len(loaded.History(IndexSP500), 1)
+	r.Equal(changes, loaded.Changes(IndexDowJones))
+}
+
+func TestConstituentsSuite(t *testing.T) {
+	suite.Run(t, new(constituentsSuite))
+}
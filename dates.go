@@ -0,0 +1,29 @@
+package financialmodelingprep
+
+import (
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// dateWindow is an inclusive range of dates.
+type dateWindow struct {
+	From, To openapi_types.Date
+}
+
+// dateWindows splits [from, to] into consecutive windows of at most days days, for endpoints that
+// limit the range served by a single call.
+func dateWindows(from, to time.Time, days int) []dateWindow {
+	var windows []dateWindow
+	for start := from; !start.After(to); start = start.AddDate(0, 0, days) {
+		end := start.AddDate(0, 0, days-1)
+		if end.After(to) {
+			end = to
+		}
+		windows = append(windows, dateWindow{
+			From: openapi_types.Date{Time: start},
+			To:   openapi_types.Date{Time: end},
+		})
+	}
+	return windows
+}
@@ -0,0 +1,164 @@
+package dcf
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+// ValueParams defines parameters for Value.
+type ValueParams struct {
+	Symbol string
+
+	// Limit is the number of fiscal years fetched.
+	Limit *int
+
+	Assumptions Assumptions
+}
+
+// Value fetches the annual statements, enterprise value, analyst consensus, yield curve and
+// profile of a company and values it.
+func Value(ctx context.Context, c *fmp.ClientWithResponses, params *ValueParams) (*Valuation, error) {
+	annual := fmp.Annual
+	financials, err := fmp.GetFinancials(ctx, c, &fmp.FinancialsGetParams{
+		Symbol:     params.Symbol,
+		Limit:      params.Limit,
+		Period:     &annual,
+		Concurrent: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	in := &Inputs{
+		Symbol:     params.Symbol,
+		Financials: financials,
+	}
+
+	evResp, err := c.EnterpriseValueGetWithResponse(ctx, &fmp.EnterpriseValueGetParams{Symbol: params.Symbol})
+	if err != nil {
+		return nil, err
+	}
+	if evResp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of enterprise values: %s", evResp.Status())
+	}
+	for i := range *evResp.JSON200 {
+		ev := &(*evResp.JSON200)[i]
+		if in.EnterpriseValue == nil || ev.Date.After(in.EnterpriseValue.Date.Time) {
+			in.EnterpriseValue = ev
+		}
+	}
+
+	if !params.Assumptions.IgnoreEstimates {
+		estimatesResp, err := c.AnalystEstimatesGetWithResponse(ctx, &fmp.AnalystEstimatesGetParams{
+			Symbol: params.Symbol,
+			Period: fmp.Annual,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if estimatesResp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected status of analyst estimates: %s", estimatesResp.Status())
+		}
+		in.Estimates = *estimatesResp.JSON200
+	}
+
+	if in.YieldCurve, err = fmp.GetYieldCurve(ctx, c, time.Now()); err != nil {
+		return nil, err
+	}
+
+	profileResp, err := c.ProfileGetWithResponse(ctx, &fmp.ProfileGetParams{Symbol: params.Symbol})
+	if err != nil {
+		return nil, err
+	}
+	if profileResp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of profile: %s", profileResp.Status())
+	}
+	if len(*profileResp.JSON200) > 0 {
+		in.Profile = &(*profileResp.JSON200)[0]
+	}
+
+	return Compute(in, &params.Assumptions)
+}
+
+// Comparison compares a valuation against the DCF value published by the vendor.
+type Comparison struct {
+	Symbol string
+
+	Computed   float64
+	Reported   float64
+	StockPrice float64
+
+	// Difference is the relative difference, (computed - reported) / |reported|.
+	Difference float64
+}
+
+// Compare compares a valuation against a DCF record of DcfBulkGet.
+func Compare(v *Valuation, reported *fmp.DCF) (*Comparison, error) {
+	value, err := strconv.ParseFloat(strings.TrimSpace(reported.Dcf), 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DCF of %s: %w", reported.Symbol, err)
+	}
+	c := &Comparison{
+		Symbol:   reported.Symbol,
+		Computed: v.PerShare,
+		Reported: value,
+	}
+	if reported.StockPrice != "" {
+		if c.StockPrice, err = strconv.ParseFloat(strings.TrimSpace(reported.StockPrice), 64); err != nil {
+			return nil, fmt.Errorf("invalid stock price of %s: %w", reported.Symbol, err)
+		}
+	}
+	if value != 0 {
+		c.Difference = (c.Computed - value) / math.Abs(value)
+	}
+	return c, nil
+}
+
+// CompareAll compares valuations against DCF records, matched by symbol. Valuations without a
+// record are skipped. The comparisons are sorted by absolute difference, largest first.
+func CompareAll(valuations []*Valuation, reported []fmp.DCF) ([]*Comparison, error) {
+	bySymbol := make(map[string]*fmp.DCF, len(reported))
+	for i := range reported {
+		bySymbol[reported[i].Symbol] = &reported[i]
+	}
+
+	var comparisons []*Comparison
+	for _, v := range valuations {
+		r, ok := bySymbol[v.Symbol]
+		if !ok {
+			continue
+		}
+		c, err := Compare(v, r)
+		if err != nil {
+			return nil, err
+		}
+		comparisons = append(comparisons, c)
+	}
+	sort.SliceStable(comparisons, func(i, j int) bool {
+		return math.Abs(comparisons[i].Difference) > math.Abs(comparisons[j].Difference)
+	})
+	return comparisons, nil
+}
+
+// GetBulkDCF fetches the DCF values of all companies with DcfBulkGet, which may be served as JSON
+// or as CSV.
+func GetBulkDCF(ctx context.Context, c *fmp.ClientWithResponses) ([]fmp.DCF, error) {
+	resp, err := c.DcfBulkGetWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 != nil {
+		return *resp.JSON200, nil
+	}
+	if resp.StatusCode() != 200 {
+		return nil, fmt.Errorf("unexpected status of dcf bulk: %s", resp.Status())
+	}
+	return fmp.ReadCSV[fmp.DCF](bytes.NewReader(resp.Body))
+}
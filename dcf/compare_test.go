@@ -0,0 +1,47 @@
+package dcf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+type compareSuite struct {
+	suite.Suite
+}
+
+func (r *compareSuite) TestCompare() {
+	v := &Valuation{Symbol: "AAPL", PerShare: 150}
+
+	c, err := Compare(v, &fmp.DCF{Symbol: "AAPL", Dcf: "120", StockPrice: "180.5"})
+	r.NoError(err)
+	r.InDelta(120, c.Reported, 1e-9)
+	r.InDelta(180.5, c.StockPrice, 1e-9)
+	r.InDelta(0.25, c.Difference, 1e-9)
+
+	_, err = Compare(v, &fmp.DCF{Symbol: "AAPL", Dcf: "n/a"})
+	r.Error(err)
+}
+
+func (r *compareSuite) TestCompareAll() {
+	valuations := []*Valuation{
+		{Symbol: "AAPL", PerShare: 150},
+		{Symbol: "MSFT", PerShare: 300},
+		{Symbol: "NONE", PerShare: 1},
+	}
+	reported := []fmp.DCF{
+		{Symbol: "AAPL", Dcf: "140"},
+		{Symbol: "MSFT", Dcf: "400"},
+	}
+
+	comparisons, err := CompareAll(valuations, reported)
+	r.NoError(err)
+	r.Len(comparisons, 2)
+	r.Equal("MSFT", comparisons[0].Symbol)
+	r.InDelta(-0.25, comparisons[0].Difference, 1e-9)
+}
+
+func TestCompareSuite(t *testing.T) {
+	suite.Run(t, new(compareSuite))
+}
@@ -0,0 +1,604 @@
+// Package dcf values companies with a configurable discounted cash flow model built from the
+// statements, the analyst consensus and the treasury yield curve, as a transparent alternative to
+// the single figure returned by DcfBulkGet.
+package dcf
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+// TerminalMethod selects how the value beyond the explicit forecast is estimated.
+type TerminalMethod string
+
+const (
+	// GordonGrowth grows the last free cash flow in perpetuity at Assumptions.TerminalGrowth.
+	GordonGrowth TerminalMethod = "gordon-growth"
+
+	// ExitMultiple applies Assumptions.ExitMultiple to the last EBITDA.
+	ExitMultiple TerminalMethod = "exit-multiple"
+)
+
+// Defaults applied to the zero values of Assumptions.
+const (
+	DefaultEquityRiskPremium = 0.05
+	DefaultTerminalGrowth    = 0.025
+	DefaultExitMultiple      = 12
+	DefaultHistoryYears      = 3
+	DefaultTaxRate           = 0.21
+	DefaultDebtSpread        = 0.02
+)
+
+// Stage is a period of constant revenue growth.
+type Stage struct {
+	Years  int
+	Growth float64
+
+	// Fade interpolates the growth linearly from the previous stage to Growth over the stage.
+	Fade bool
+}
+
+// Assumptions configures the model. Zero values are replaced by defaults derived from the inputs,
+// and every replacement is recorded in Valuation.Notes.
+type Assumptions struct {
+	// Stages defaults to five years at the historical revenue growth followed by five years fading
+	// to the terminal growth.
+	Stages []Stage
+
+	// IgnoreEstimates disables the analyst consensus, which otherwise replaces the stage growth of
+	// the years it covers.
+	IgnoreEstimates bool
+
+	// HistoryYears is the number of fiscal years averaged for the margins and the tax rate.
+	HistoryYears int
+
+	// Operating ratios, relative to revenue except WorkingCapitalRate which is relative to the
+	// revenue change. They default to the historical averages.
+	EBITMargin         *float64
+	DepreciationRatio  *float64
+	CapexRatio         *float64
+	WorkingCapitalRate *float64
+	TaxRate            *float64
+
+	// WACC inputs. RiskFreeRate defaults to the 10-year treasury rate, Beta to the company beta
+	// and CostOfDebt to the interest expense over the total debt.
+	RiskFreeRate      *float64
+	Beta              *float64
+	EquityRiskPremium *float64
+	CostOfDebt        *float64
+
+	// WACC overrides the computed rate.
+	WACC *float64
+
+	Terminal       TerminalMethod
+	TerminalGrowth *float64
+	ExitMultiple   *float64
+
+	// MidYear discounts the cash flows from the middle of each year.
+	MidYear bool
+}
+
+// Inputs holds the data the model is built from.
+type Inputs struct {
+	Symbol string
+
+	// Financials holds the annual statements.
+	Financials *fmp.Financials
+
+	// EnterpriseValue provides the market capitalization, debt, cash, shares and price. The
+	// latest balance sheet is used when it is nil.
+	EnterpriseValue *fmp.EnterpriseValue
+
+	// Estimates holds the annual analyst consensus. Only estimates after the last fiscal year are
+	// used.
+	Estimates []fmp.FinancialEstimates
+
+	// YieldCurve provides the risk-free rate.
+	YieldCurve *fmp.YieldCurve
+
+	Profile *fmp.CompanyProfile
+}
+
+// WACC is the breakdown of the weighted average cost of capital.
+type WACC struct {
+	RiskFreeRate      float64
+	Beta              float64
+	EquityRiskPremium float64
+	CostOfEquity      float64
+
+	PreTaxCostOfDebt   float64
+	TaxRate            float64
+	AfterTaxCostOfDebt float64
+
+	Equity       float64
+	Debt         float64
+	EquityWeight float64
+	DebtWeight   float64
+
+	Rate float64
+}
+
+// Year is a single year of the forecast.
+type Year struct {
+	Year int
+
+	// Source is "estimate" for years taken from the analyst consensus, otherwise the stage, e.g.
+	// "stage 1".
+	Source string
+
+	Revenue                float64
+	Growth                 float64
+	EBIT                   float64
+	Taxes                  float64
+	NOPAT                  float64
+	Depreciation           float64
+	CapitalExpenditure     float64
+	ChangeInWorkingCapital float64
+	FreeCashFlow           float64
+
+	DiscountFactor float64
+	PresentValue   float64
+}
+
+// Valuation is the outcome of the model together with its audit trail.
+type Valuation struct {
+	Symbol string
+
+	// BaseYear is the last reported fiscal year and Date its period end.
+	BaseYear int
+	Date     time.Time
+
+	Terminal       TerminalMethod
+	TerminalGrowth float64
+	ExitMultiple   float64
+
+	// Historical operating ratios, as used in the forecast.
+	EBITMargin         float64
+	DepreciationRatio  float64
+	CapexRatio         float64
+	WorkingCapitalRate float64
+
+	WACC  WACC
+	Years []Year
+
+	TerminalValue        float64
+	PresentTerminalValue float64
+	EnterpriseValue      float64
+	Debt                 float64
+	Cash                 float64
+	EquityValue          float64
+	Shares               float64
+	PerShare             float64
+
+	// Price is the current share price and Upside the relative difference of PerShare to it.
+	Price  float64
+	Upside float64
+
+	// Notes records the defaults and fallbacks applied.
+	Notes []string
+}
+
+func (v *Valuation) note(format string, args ...any) {
+	v.Notes = append(v.Notes, fmt.Sprintf(format, args...))
+}
+
+// Compute values a company. An error is returned when the inputs are insufficient, e.g. without
+// any complete fiscal year or when the WACC does not exceed the terminal growth.
+func Compute(in *Inputs, a *Assumptions) (*Valuation, error) {
+	if a == nil {
+		a = &Assumptions{}
+	}
+	history := completeYears(in.Financials)
+	if len(history) == 0 {
+		return nil, fmt.Errorf("no complete fiscal year of %s", in.Symbol)
+	}
+	if len(a.Stages) > 0 && a.Stages[0].Years <= 0 {
+		return nil, fmt.Errorf("invalid stage of %d years", a.Stages[0].Years)
+	}
+	base := history[len(history)-1]
+
+	v := &Valuation{
+		Symbol:   in.Symbol,
+		BaseYear: base.IncomeStatement.Date.Year(),
+		Date:     base.Date(),
+	}
+	if y, err := fiscalYear(base); err == nil {
+		v.BaseYear = y
+	}
+
+	n := a.HistoryYears
+	if n <= 0 {
+		n = DefaultHistoryYears
+	}
+	if len(history) > n {
+		history = history[len(history)-n:]
+	}
+
+	// Operating ratios.
+	v.EBITMargin = pick(a.EBITMargin, average(history, func(s *fmp.FinancialStatements) (float64, float64) {
+		return s.IncomeStatement.Ebit, s.IncomeStatement.Revenue
+	}))
+	v.DepreciationRatio = pick(a.DepreciationRatio, average(history, func(s *fmp.FinancialStatements) (float64, float64) {
+		return s.CashFlowStatement.DepreciationAndAmortization, s.IncomeStatement.Revenue
+	}))
+	v.CapexRatio = pick(a.CapexRatio, average(history, func(s *fmp.FinancialStatements) (float64, float64) {
+		return -s.CashFlowStatement.CapitalExpenditure, s.IncomeStatement.Revenue
+	}))
+	v.WorkingCapitalRate = pick(a.WorkingCapitalRate, workingCapitalRate(history))
+
+	taxRate := average(history, func(s *fmp.FinancialStatements) (float64, float64) {
+		return s.IncomeStatement.IncomeTaxExpense, s.IncomeStatement.IncomeBeforeTax
+	})
+	if a.TaxRate != nil {
+		taxRate = *a.TaxRate
+	} else if taxRate < 0 || taxRate > 1 {
+		v.note("effective tax rate %.4f out of range, using %.4f", taxRate, DefaultTaxRate)
+		taxRate = DefaultTaxRate
+	}
+
+	if err := v.computeWACC(in, a, base, taxRate); err != nil {
+		return nil, err
+	}
+
+	// Terminal value parameters.
+	v.Terminal = a.Terminal
+	if v.Terminal == "" {
+		v.Terminal = GordonGrowth
+	}
+	switch v.Terminal {
+	case GordonGrowth:
+		v.TerminalGrowth = pick(a.TerminalGrowth, DefaultTerminalGrowth)
+		if v.TerminalGrowth >= v.WACC.Rate {
+			return nil, fmt.Errorf("terminal growth %.4f does not stay below the WACC %.4f", v.TerminalGrowth, v.WACC.Rate)
+		}
+	case ExitMultiple:
+		v.ExitMultiple = pick(a.ExitMultiple, DefaultExitMultiple)
+	default:
+		return nil, fmt.Errorf("not supported terminal method: %s", v.Terminal)
+	}
+
+	stages := a.Stages
+	if len(stages) == 0 {
+		growth := revenueGrowth(history)
+		terminal := v.TerminalGrowth
+		if v.Terminal != GordonGrowth {
+			terminal = DefaultTerminalGrowth
+		}
+		stages = []Stage{
+			{Years: 5, Growth: growth},
+			{Years: 5, Growth: terminal, Fade: true},
+		}
+		v.note("default stages: 5 years at %.4f, then 5 years fading to %.4f", growth, terminal)
+	}
+
+	v.forecast(in, a, stages, base, taxRate)
+
+	// Terminal value, discounted from the end of the last year.
+	last := v.Years[len(v.Years)-1]
+	switch v.Terminal {
+	case GordonGrowth:
+		v.TerminalValue = last.FreeCashFlow * (1 + v.TerminalGrowth) / (v.WACC.Rate - v.TerminalGrowth)
+	case ExitMultiple:
+		v.TerminalValue = (last.EBIT + last.Depreciation) * v.ExitMultiple
+	}
+	v.PresentTerminalValue = v.TerminalValue / math.Pow(1+v.WACC.Rate, float64(len(v.Years)))
+
+	v.EnterpriseValue = v.PresentTerminalValue
+	for _, y := range v.Years {
+		v.EnterpriseValue += y.PresentValue
+	}
+
+	v.bridge(in, base)
+	return v, nil
+}
+
+func (v *Valuation) computeWACC(in *Inputs, a *Assumptions, base *fmp.FinancialStatements, taxRate float64) error {
+	w := &v.WACC
+	w.TaxRate = taxRate
+
+	switch {
+	case a.RiskFreeRate != nil:
+		w.RiskFreeRate = *a.RiskFreeRate
+	case in.YieldCurve != nil && len(in.YieldCurve.Tenors) > 0:
+		rate, err := in.YieldCurve.Interpolate(fmp.Tenor10Y.Years(), fmp.LinearInterpolation)
+		if err != nil {
+			return err
+		}
+		w.RiskFreeRate = rate
+	case a.WACC == nil:
+		return fmt.Errorf("no risk-free rate for %s", in.Symbol)
+	}
+
+	switch {
+	case a.Beta != nil:
+		w.Beta = *a.Beta
+	case in.Profile != nil && in.Profile.Beta != 0:
+		w.Beta = in.Profile.Beta
+	default:
+		w.Beta = 1
+		v.note("no beta, using 1")
+	}
+	w.EquityRiskPremium = pick(a.EquityRiskPremium, DefaultEquityRiskPremium)
+	w.CostOfEquity = w.RiskFreeRate + w.Beta*w.EquityRiskPremium
+
+	w.Debt = base.BalanceSheetStatement.TotalDebt
+	switch {
+	case in.EnterpriseValue != nil:
+		w.Equity = in.EnterpriseValue.MarketCapitalization
+		w.Debt = in.EnterpriseValue.AddTotalDebt
+	case in.Profile != nil:
+		w.Equity = in.Profile.MarketCap
+	}
+	if w.Equity == 0 {
+		w.Equity = base.BalanceSheetStatement.TotalStockholdersEquity
+		v.note("no market capitalization, weighting equity at book value")
+	}
+
+	switch {
+	case a.CostOfDebt != nil:
+		w.PreTaxCostOfDebt = *a.CostOfDebt
+	case base.BalanceSheetStatement.TotalDebt > 0 && base.IncomeStatement.InterestExpense > 0:
+		w.PreTaxCostOfDebt = base.IncomeStatement.InterestExpense / base.BalanceSheetStatement.TotalDebt
+	default:
+		w.PreTaxCostOfDebt = w.RiskFreeRate + DefaultDebtSpread
+		v.note("no interest expense, pricing debt at the risk-free rate plus %.4f", DefaultDebtSpread)
+	}
+	w.AfterTaxCostOfDebt = w.PreTaxCostOfDebt * (1 - taxRate)
+
+	if total := w.Equity + w.Debt; total > 0 {
+		w.EquityWeight = w.Equity / total
+		w.DebtWeight = w.Debt / total
+	} else {
+		w.EquityWeight = 1
+	}
+	w.Rate = w.EquityWeight*w.CostOfEquity + w.DebtWeight*w.AfterTaxCostOfDebt
+	if a.WACC != nil {
+		w.Rate = *a.WACC
+		v.note("WACC overridden to %.4f", w.Rate)
+	}
+	if w.Rate <= 0 {
+		return fmt.Errorf("non-positive WACC %.4f for %s", w.Rate, in.Symbol)
+	}
+	return nil
+}
+
+func (v *Valuation) forecast(in *Inputs, a *Assumptions, stages []Stage, base *fmp.FinancialStatements, taxRate float64) {
+	estimates := map[int]float64{}
+	if !a.IgnoreEstimates {
+		for _, e := range in.Estimates {
+			if e.Date.After(v.Date) && e.RevenueAvg > 0 {
+				estimates[e.Date.Year()] = e.RevenueAvg
+			}
+		}
+	}
+	// Estimate years are keyed by calendar year; map them to fiscal years through the base date.
+	offset := v.BaseYear - v.Date.Year()
+
+	revenue := base.IncomeStatement.Revenue
+	growth := stages[0].Growth
+	t := 0
+	for i, stage := range stages {
+		start := growth
+		for k := 1; k <= stage.Years; k++ {
+			t++
+			g := stage.Growth
+			if stage.Fade {
+				g = start + (stage.Growth-start)*float64(k)/float64(stage.Years)
+			}
+			growth = g
+
+			y := Year{
+				Year:   v.BaseYear + t,
+				Source: fmt.Sprintf("stage %d", i+1),
+			}
+			if e, ok := estimates[y.Year-offset]; ok {
+				y.Source = "estimate"
+				g = e/revenue - 1
+			}
+			y.Growth = g
+			y.Revenue = revenue * (1 + g)
+			y.EBIT = y.Revenue * v.EBITMargin
+			y.Taxes = math.Max(y.EBIT, 0) * taxRate
+			y.NOPAT = y.EBIT - y.Taxes
+			y.Depreciation = y.Revenue * v.DepreciationRatio
+			y.CapitalExpenditure = y.Revenue * v.CapexRatio
+			y.ChangeInWorkingCapital = (y.Revenue - revenue) * v.WorkingCapitalRate
+			y.FreeCashFlow = y.NOPAT + y.Depreciation - y.CapitalExpenditure - y.ChangeInWorkingCapital
+
+			period := float64(t)
+			if a.MidYear {
+				period -= 0.5
+			}
+			y.DiscountFactor = 1 / math.Pow(1+v.WACC.Rate, period)
+			y.PresentValue = y.FreeCashFlow * y.DiscountFactor
+
+			v.Years = append(v.Years, y)
+			revenue = y.Revenue
+		}
+	}
+}
+
+// bridge converts the enterprise value to a value per share.
+func (v *Valuation) bridge(in *Inputs, base *fmp.FinancialStatements) {
+	v.Debt = base.BalanceSheetStatement.TotalDebt
+	v.Cash = base.BalanceSheetStatement.CashAndCashEquivalents
+	v.Shares = base.IncomeStatement.WeightedAverageShsOutDil
+	if ev := in.EnterpriseValue; ev != nil {
+		v.Debt = ev.AddTotalDebt
+		v.Cash = ev.MinusCashAndCashEquivalents
+		if ev.NumberOfShares > 0 {
+			v.Shares = float64(ev.NumberOfShares)
+		}
+		v.Price = ev.StockPrice
+	}
+	if v.Price == 0 && in.Profile != nil {
+		v.Price = in.Profile.Price
+	}
+
+	v.EquityValue = v.EnterpriseValue - v.Debt + v.Cash
+	if v.Shares > 0 {
+		v.PerShare = v.EquityValue / v.Shares
+	}
+	if v.Price > 0 {
+		v.Upside = v.PerShare/v.Price - 1
+	}
+}
+
+// String formats the audit trail.
+func (v *Valuation) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s DCF as of FY %d (%s)\n", v.Symbol, v.BaseYear, v.Date.Format(time.DateOnly))
+	w := v.WACC
+	fmt.Fprintf(&b, "WACC %.4f: cost of equity %.4f (rf %.4f + beta %.2f x ERP %.4f) x %.4f, after-tax cost of debt %.4f x %.4f\n",
+		w.Rate, w.CostOfEquity, w.RiskFreeRate, w.Beta, w.EquityRiskPremium, w.EquityWeight, w.AfterTaxCostOfDebt, w.DebtWeight)
+	fmt.Fprintf(&b, "EBIT margin %.4f, D&A %.4f, capex %.4f, working capital %.4f of revenue, tax rate %.4f\n",
+		v.EBITMargin, v.DepreciationRatio, v.CapexRatio, v.WorkingCapitalRate, w.TaxRate)
+	fmt.Fprintf(&b, "%-6s %-9s %16s %8s %16s %16s %8s %16s\n", "year", "source", "revenue", "growth", "ebit", "fcf", "df", "pv")
+	for _, y := range v.Years {
+		fmt.Fprintf(&b, "%-6d %-9s %16.0f %8.4f %16.0f %16.0f %8.4f %16.0f\n",
+			y.Year, y.Source, y.Revenue, y.Growth, y.EBIT, y.FreeCashFlow, y.DiscountFactor, y.PresentValue)
+	}
+	switch v.Terminal {
+	case GordonGrowth:
+		fmt.Fprintf(&b, "terminal value %.0f (growth %.4f), present %.0f\n", v.TerminalValue, v.TerminalGrowth, v.PresentTerminalValue)
+	case ExitMultiple:
+		fmt.Fprintf(&b, "terminal value %.0f (%.1fx EBITDA), present %.0f\n", v.TerminalValue, v.ExitMultiple, v.PresentTerminalValue)
+	}
+	fmt.Fprintf(&b, "enterprise value %.0f - debt %.0f + cash %.0f = equity %.0f / %.0f shares = %.2f per share",
+		v.EnterpriseValue, v.Debt, v.Cash, v.EquityValue, v.Shares, v.PerShare)
+	if v.Price > 0 {
+		fmt.Fprintf(&b, " (price %.2f, upside %.2f%%)", v.Price, v.Upside*100)
+	}
+	b.WriteString("\n")
+	for _, n := range v.Notes {
+		fmt.Fprintf(&b, "note: %s\n", n)
+	}
+	return b.String()
+}
+
+// Grid is a sensitivity table of the value per share. Rows vary the WACC and columns the terminal
+// parameter, i.e. the terminal growth or the exit multiple.
+type Grid struct {
+	Terminal  TerminalMethod
+	WACCs     []float64
+	Terminals []float64
+
+	// PerShare is indexed by WACC, then terminal parameter. Invalid combinations, e.g. a terminal
+	// growth above the WACC, are NaN.
+	PerShare [][]float64
+}
+
+// Sensitivity computes the value per share for every combination of WACC and terminal parameter.
+func Sensitivity(in *Inputs, a *Assumptions, waccs, terminals []float64) (*Grid, error) {
+	if a == nil {
+		a = &Assumptions{}
+	}
+	if len(completeYears(in.Financials)) == 0 {
+		return nil, fmt.Errorf("no complete fiscal year of %s", in.Symbol)
+	}
+	g := &Grid{
+		Terminal:  a.Terminal,
+		WACCs:     waccs,
+		Terminals: terminals,
+		PerShare:  make([][]float64, len(waccs)),
+	}
+	if g.Terminal == "" {
+		g.Terminal = GordonGrowth
+	}
+	for i, wacc := range waccs {
+		g.PerShare[i] = make([]float64, len(terminals))
+		for j, terminal := range terminals {
+			cell := *a
+			cell.WACC = &wacc
+			switch g.Terminal {
+			case GordonGrowth:
+				cell.TerminalGrowth = &terminal
+			case ExitMultiple:
+				cell.ExitMultiple = &terminal
+			}
+			v, err := Compute(in, &cell)
+			if err != nil {
+				g.PerShare[i][j] = math.NaN()
+				continue
+			}
+			g.PerShare[i][j] = v.PerShare
+		}
+	}
+	return g, nil
+}
+
+// completeYears returns the complete fiscal years, oldest first.
+func completeYears(f *fmp.Financials) []*fmp.FinancialStatements {
+	if f == nil {
+		return nil
+	}
+	var years []*fmp.FinancialStatements
+	for i := range f.Periods {
+		s := &f.Periods[i]
+		if !s.IsQuarter() && s.Complete() {
+			years = append(years, s)
+		}
+	}
+	sort.SliceStable(years, func(i, j int) bool {
+		return years[i].Before(years[j].FinancialPeriod)
+	})
+	return years
+}
+
+func fiscalYear(s *fmp.FinancialStatements) (int, error) {
+	return strconv.Atoi(s.FiscalYear)
+}
+
+// average returns the ratio of the summed numerators to the summed denominators.
+func average(history []*fmp.FinancialStatements, f func(*fmp.FinancialStatements) (float64, float64)) float64 {
+	var num, den float64
+	for _, s := range history {
+		n, d := f(s)
+		num += n
+		den += d
+	}
+	if den == 0 {
+		return 0
+	}
+	return num / den
+}
+
+// workingCapitalRate returns the investment in working capital per unit of revenue growth.
+func workingCapitalRate(history []*fmp.FinancialStatements) float64 {
+	var investment, growth float64
+	for i := 1; i < len(history); i++ {
+		// The cash flow statement reports an increase of working capital as a negative change.
+		investment -= history[i].CashFlowStatement.ChangeInWorkingCapital
+		growth += history[i].IncomeStatement.Revenue - history[i-1].IncomeStatement.Revenue
+	}
+	if growth <= 0 {
+		return 0
+	}
+	return investment / growth
+}
+
+// revenueGrowth returns the compound annual revenue growth over the history.
+func revenueGrowth(history []*fmp.FinancialStatements) float64 {
+	if len(history) < 2 {
+		return 0
+	}
+	first := history[0].IncomeStatement.Revenue
+	last := history[len(history)-1].IncomeStatement.Revenue
+	if first <= 0 || last <= 0 {
+		return 0
+	}
+	return math.Pow(last/first, 1/float64(len(history)-1)) - 1
+}
+
+func pick(override *float64, fallback float64) float64 {
+	if override != nil {
+		return *override
+	}
+	return fallback
+}
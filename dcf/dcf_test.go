@@ -0,0 +1,154 @@
+package dcf
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/suite"
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+type dcfSuite struct {
+	suite.Suite
+	in *Inputs
+}
+
+func (r *dcfSuite) SetupTest() {
+	fy2023 := openapi_types.Date{Time: time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC)}
+	fy2024 := openapi_types.Date{Time: time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)}
+	incomes := []fmp.IncomeStatement{
+		{Symbol: "ACME", FiscalYear: "2023", Period: "FY", Date: fy2023, Revenue: 900, Ebit: 180, IncomeBeforeTax: 160, IncomeTaxExpense: 40, InterestExpense: 15, WeightedAverageShsOutDil: 100},
+		{Symbol: "ACME", FiscalYear: "2024", Period: "FY", Date: fy2024, Revenue: 1000, Ebit: 200, IncomeBeforeTax: 180, IncomeTaxExpense: 45, InterestExpense: 15, WeightedAverageShsOutDil: 100},
+	}
+	balances := []fmp.BalanceSheetStatement{
+		{Symbol: "ACME", FiscalYear: "2023", Period: "FY", Date: fy2023, TotalDebt: 300, CashAndCashEquivalents: 80, TotalStockholdersEquity: 500},
+		{Symbol: "ACME", FiscalYear: "2024", Period: "FY", Date: fy2024, TotalDebt: 300, CashAndCashEquivalents: 100, TotalStockholdersEquity: 600},
+	}
+	cashFlows := []fmp.CashFlowStatement{
+		{Symbol: "ACME", FiscalYear: "2023", Period: "FY", Date: fy2023, DepreciationAndAmortization: 45, CapitalExpenditure: -50, ChangeInWorkingCapital: -8},
+		{Symbol: "ACME", FiscalYear: "2024", Period: "FY", Date: fy2024, DepreciationAndAmortization: 50, CapitalExpenditure: -55, ChangeInWorkingCapital: -10},
+	}
+	r.in = &Inputs{
+		Symbol:     "ACME",
+		Financials: fmp.NewFinancials("ACME", incomes, balances, cashFlows),
+		YieldCurve: fmp.NewYieldCurve(&fmp.TreasuryRates{Date: openapi_types.Date{Time: time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC)}, Year2: 4.2, Year10: 4.5}),
+		Profile:    &fmp.CompanyProfile{Symbol: "ACME", Beta: 1.2, MarketCap: 1700, Price: 17},
+	}
+}
+
+func float(v float64) *float64 {
+	return &v
+}
+
+func (r *dcfSuite) TestCompute() {
+	v, err := Compute(r.in, &Assumptions{
+		Stages:             []Stage{{Years: 2, Growth: 0.1}},
+		EBITMargin:         float(0.2),
+		DepreciationRatio:  float(0.05),
+		CapexRatio:         float(0.05),
+		WorkingCapitalRate: float(0),
+		TaxRate:            float(0.25),
+		WACC:               float(0.1),
+		TerminalGrowth:     float(0),
+	})
+	r.NoError(err)
+	r.Equal(2024, v.BaseYear)
+	r.Len(v.Years, 2)
+
+	r.Equal(2025, v.Years[0].Year)
+	r.Equal("stage 1", v.Years[0].Source)
+	r.InDelta(1100, v.Years[0].Revenue, 1e-9)
+	r.InDelta(165, v.Years[0].FreeCashFlow, 1e-9)
+	r.InDelta(150, v.Years[0].PresentValue, 1e-9)
+	r.InDelta(150, v.Years[1].PresentValue, 1e-9)
+
+	r.InDelta(1815, v.TerminalValue, 1e-9)
+	r.InDelta(1500, v.PresentTerminalValue, 1e-9)
+	r.InDelta(1800, v.EnterpriseValue, 1e-9)
+	r.InDelta(1600, v.EquityValue, 1e-9)
+	r.InDelta(16, v.PerShare, 1e-9)
+	r.InDelta(16.0/17-1, v.Upside, 1e-9)
+	r.Contains(v.String(), "16.00 per share")
+	r.Contains(v.Notes, "WACC overridden to 0.1000")
+}
+
+func (r *dcfSuite) TestComputeDefaults() {
+	r.in.Estimates = []fmp.FinancialEstimates{
+		{Symbol: "ACME", Date: openapi_types.Date{Time: time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC)}, RevenueAvg: 1200},
+		// Already reported.
+		{Symbol: "ACME", Date: openapi_types.Date{Time: time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)}, RevenueAvg: 990},
+	}
+
+	v, err := Compute(r.in, nil)
+	r.NoError(err)
+	r.Len(v.Years, 10)
+	r.Equal("estimate", v.Years[0].Source)
+	r.InDelta(0.2, v.Years[0].Growth, 1e-9)
+	r.Equal("stage 1", v.Years[1].Source)
+	r.InDelta(1000.0/900-1, v.Years[1].Growth, 1e-9)
+	r.InDelta(DefaultTerminalGrowth, v.Years[9].Growth, 1e-9)
+
+	// 18 / 100 of the working capital over the revenue growth.
+	r.InDelta(0.1, v.WorkingCapitalRate, 1e-9)
+	r.InDelta(0.2, v.EBITMargin, 1e-9)
+
+	w := v.WACC
+	r.InDelta(0.045, w.RiskFreeRate, 1e-9)
+	r.InDelta(0.045+1.2*DefaultEquityRiskPremium, w.CostOfEquity, 1e-9)
+	r.InDelta(0.05, w.PreTaxCostOfDebt, 1e-9)
+	r.InDelta(85.0/340, w.TaxRate, 1e-9)
+	r.InDelta(0.85, w.EquityWeight, 1e-9)
+	r.InDelta(0.85*w.CostOfEquity+0.15*w.AfterTaxCostOfDebt, w.Rate, 1e-9)
+	r.Greater(v.PerShare, 0.0)
+}
+
+func (r *dcfSuite) TestComputeExitMultiple() {
+	v, err := Compute(r.in, &Assumptions{
+		Stages:            []Stage{{Years: 1, Growth: 0}},
+		EBITMargin:        float(0.2),
+		DepreciationRatio: float(0.05),
+		WACC:              float(0.1),
+		Terminal:          ExitMultiple,
+		ExitMultiple:      float(10),
+		IgnoreEstimates:   true,
+	})
+	r.NoError(err)
+	r.InDelta(2500, v.TerminalValue, 1e-9)
+	r.InDelta(2500/1.1, v.PresentTerminalValue, 1e-9)
+}
+
+func (r *dcfSuite) TestComputeInvalid() {
+	_, err := Compute(&Inputs{Symbol: "ACME"}, nil)
+	r.Error(err)
+
+	_, err = Compute(r.in, &Assumptions{WACC: float(0.02)})
+	r.ErrorContains(err, "terminal growth")
+
+	_, err = Compute(r.in, &Assumptions{Terminal: "unknown"})
+	r.ErrorContains(err, "not supported terminal method")
+}
+
+func (r *dcfSuite) TestSensitivity() {
+	a := &Assumptions{
+		Stages:          []Stage{{Years: 5, Growth: 0.05}},
+		IgnoreEstimates: true,
+	}
+	g, err := Sensitivity(r.in, a, []float64{0.08, 0.1}, []float64{0.02, 0.03, 0.09})
+	r.NoError(err)
+	r.Len(g.PerShare, 2)
+	r.Len(g.PerShare[0], 3)
+
+	// Value rises with the terminal growth and falls with the WACC.
+	r.Greater(g.PerShare[0][1], g.PerShare[0][0])
+	r.Greater(g.PerShare[0][0], g.PerShare[1][0])
+	r.True(math.IsNaN(g.PerShare[0][2]))
+
+	// The assumptions are left untouched.
+	r.Nil(a.WACC)
+}
+
+func TestDCFSuite(t *testing.T) {
+	suite.Run(t, new(dcfSuite))
+}
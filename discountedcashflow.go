@@ -0,0 +1,165 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// customDCFInputs are the inputs shared by the custom DCF and custom levered DCF endpoints.
+type customDCFInputs struct {
+	Symbol string
+
+	RevenueGrowthPct                           *float64
+	EbitdaPct                                  *float64
+	DepreciationAndAmortizationPct             *float64
+	CashAndShortTermInvestmentsPct             *float64
+	ReceivablesPct                             *float64
+	InventoriesPct                             *float64
+	PayablePct                                 *float64
+	EbitPct                                    *float64
+	CapitalExpenditurePct                      *float64
+	OperatingCashFlowPct                       *float64
+	SellingGeneralAndAdministrativeExpensesPct *float64
+	TaxRate                                    *float64
+	LongTermGrowthRate                         *float64
+	CostOfDebt                                 *float64
+	CostOfEquity                               *float64
+	MarketRiskPremium                          *float64
+	Beta                                       *float64
+	RiskFreeRate                               *float64
+}
+
+// customDCFInput is an input by query name, with its allowed range.
+type customDCFInput struct {
+	name     string
+	value    **float64
+	min, max float64
+}
+
+func (in *customDCFInputs) inputs() []customDCFInput {
+	return []customDCFInput{
+		{"revenueGrowthPct", &in.RevenueGrowthPct, -1, math.MaxFloat64},
+		{"ebitdaPct", &in.EbitdaPct, -1, 1},
+		{"depreciationAndAmortizationPct", &in.DepreciationAndAmortizationPct, -1, 1},
+		{"cashAndShortTermInvestmentsPct", &in.CashAndShortTermInvestmentsPct, 0, math.MaxFloat64},
+		{"receivablesPct", &in.ReceivablesPct, 0, math.MaxFloat64},
+		{"inventoriesPct", &in.InventoriesPct, 0, math.MaxFloat64},
+		{"payablePct", &in.PayablePct, 0, math.MaxFloat64},
+		{"ebitPct", &in.EbitPct, -1, 1},
+		{"capitalExpenditurePct", &in.CapitalExpenditurePct, -1, 1},
+		{"operatingCashFlowPct", &in.OperatingCashFlowPct, -1, 1},
+		{"sellingGeneralAndAdministrativeExpensesPct", &in.SellingGeneralAndAdministrativeExpensesPct, 0, 1},
+		{"taxRate", &in.TaxRate, 0, 1},
+		{"longTermGrowthRate", &in.LongTermGrowthRate, -100, 100},
+		{"costOfDebt", &in.CostOfDebt, 0, 100},
+		{"costOfEquity", &in.CostOfEquity, 0, 100},
+		{"marketRiskPremium", &in.MarketRiskPremium, 0, 100},
+		{"beta", &in.Beta, -10, 10},
+		{"riskFreeRate", &in.RiskFreeRate, -100, 100},
+	}
+}
+
+func (in *customDCFInputs) validate() error {
+	if in.Symbol == "" {
+		return fmt.Errorf("missing symbol")
+	}
+	for _, input := range in.inputs() {
+		if *input.value == nil {
+			continue
+		}
+		v := **input.value
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("invalid %s: %g", input.name, v)
+		}
+		if v < input.min || v > input.max {
+			return fmt.Errorf("invalid %s: %g, must be within [%g, %g]", input.name, v, input.min, input.max)
+		}
+	}
+	if in.LongTermGrowthRate != nil && in.CostOfEquity != nil && *in.LongTermGrowthRate >= *in.CostOfEquity {
+		return fmt.Errorf("invalid longTermGrowthRate: %g, must be below costOfEquity %g", *in.LongTermGrowthRate, *in.CostOfEquity)
+	}
+	return nil
+}
+
+// Validate checks the custom DCF inputs for values out of range, e.g. a tax rate given in percent
+// or a long-term growth rate above the cost of equity. The *Pct inputs and TaxRate are fractions,
+// e.g. 0.1094 for a revenue growth of 10.94%, while LongTermGrowthRate, CostOfDebt, CostOfEquity,
+// MarketRiskPremium and RiskFreeRate are percents, e.g. 4 for 4%.
+func (p *CustomDiscountedCashFlowGetParams) Validate() error {
+	return (&customDCFInputs{
+		Symbol:                         p.Symbol,
+		RevenueGrowthPct:               p.RevenueGrowthPct,
+		EbitdaPct:                      p.EbitdaPct,
+		DepreciationAndAmortizationPct: p.DepreciationAndAmortizationPct,
+		CashAndShortTermInvestmentsPct: p.CashAndShortTermInvestmentsPct,
+		ReceivablesPct:                 p.ReceivablesPct,
+		InventoriesPct:                 p.InventoriesPct,
+		PayablePct:                     p.PayablePct,
+		EbitPct:                        p.EbitPct,
+		CapitalExpenditurePct:          p.CapitalExpenditurePct,
+		OperatingCashFlowPct:           p.OperatingCashFlowPct,
+		SellingGeneralAndAdministrativeExpensesPct: p.SellingGeneralAndAdministrativeExpensesPct,
+		TaxRate:            p.TaxRate,
+		LongTermGrowthRate: p.LongTermGrowthRate,
+		CostOfDebt:         p.CostOfDebt,
+		CostOfEquity:       p.CostOfEquity,
+		MarketRiskPremium:  p.MarketRiskPremium,
+		Beta:               p.Beta,
+		RiskFreeRate:       p.RiskFreeRate,
+	}).validate()
+}
+
+// Validate checks the custom levered DCF inputs, see CustomDiscountedCashFlowGetParams.Validate.
+func (p *CustomLeveredDiscountedCashFlowGetParams) Validate() error {
+	return (&customDCFInputs{
+		Symbol:                         p.Symbol,
+		RevenueGrowthPct:               p.RevenueGrowthPct,
+		EbitdaPct:                      p.EbitdaPct,
+		DepreciationAndAmortizationPct: p.DepreciationAndAmortizationPct,
+		CashAndShortTermInvestmentsPct: p.CashAndShortTermInvestmentsPct,
+		ReceivablesPct:                 p.ReceivablesPct,
+		InventoriesPct:                 p.InventoriesPct,
+		PayablePct:                     p.PayablePct,
+		EbitPct:                        p.EbitPct,
+		CapitalExpenditurePct:          p.CapitalExpenditurePct,
+		OperatingCashFlowPct:           p.OperatingCashFlowPct,
+		SellingGeneralAndAdministrativeExpensesPct: p.SellingGeneralAndAdministrativeExpensesPct,
+		TaxRate:            p.TaxRate,
+		LongTermGrowthRate: p.LongTermGrowthRate,
+		CostOfDebt:         p.CostOfDebt,
+		CostOfEquity:       p.CostOfEquity,
+		MarketRiskPremium:  p.MarketRiskPremium,
+		Beta:               p.Beta,
+		RiskFreeRate:       p.RiskFreeRate,
+	}).validate()
+}
+
+// ValidateCustomDCFParams is a RequestEditorFn that fails requests to the custom DCF endpoints with
+// inputs out of range before they are sent, see CustomDiscountedCashFlowGetParams.Validate. It is
+// installed by MustClient, so the typed methods validate as Get does.
+func ValidateCustomDCFParams(ctx context.Context, req *http.Request) error {
+	if !strings.HasSuffix(req.URL.Path, string(CustomDiscountedCashFlowGetOperationPath)) &&
+		!strings.HasSuffix(req.URL.Path, string(CustomLeveredDiscountedCashFlowGetOperationPath)) {
+		return nil
+	}
+	query := req.URL.Query()
+	in := customDCFInputs{Symbol: query.Get("symbol")}
+	for _, input := range in.inputs() {
+		if !query.Has(input.name) {
+			continue
+		}
+		v, err := strconv.ParseFloat(query.Get(input.name), 64)
+		if err != nil {
+			return fmt.Errorf("%s %s: invalid %s: %w", req.Method, req.URL.Path, input.name, err)
+		}
+		*input.value = &v
+	}
+	if err := in.validate(); err != nil {
+		return fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, err)
+	}
+	return nil
+}
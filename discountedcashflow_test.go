@@ -0,0 +1,86 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type discountedCashFlowSuite struct {
+	suite.Suite
+}
+
+func (r *discountedCashFlowSuite) TestUnmarshal() {
+	const data = `[{"symbol":"AAPL","date":"2025-02-04","dcf":147.2669883190846,"Stock Price":231.795}]`
+
+	var dcfList []DiscountedCashFlow
+	r.NoError(json.Unmarshal([]byte(data), &dcfList))
+	r.Len(dcfList, 1)
+	r.InDelta(147.26698, dcfList[0].Dcf, 1e-5)
+	r.InDelta(231.795, dcfList[0].StockPrice, 1e-9)
+}
+
+func (r *discountedCashFlowSuite) TestValidate() {
+	value := func(v float64) *float64 {
+		return &v
+	}
+
+	p := CustomDiscountedCashFlowGetParams{
+		Symbol:             "AAPL",
+		RevenueGrowthPct:   value(0.1094),
+		TaxRate:            value(0.149),
+		LongTermGrowthRate: value(4),
+		CostOfEquity:       value(9.51),
+		Beta:               value(1.244),
+	}
+	r.NoError(p.Validate())
+
+	p.TaxRate = value(14.9)
+	r.ErrorContains(p.Validate(), "taxRate")
+	p.TaxRate = value(math.NaN())
+	r.ErrorContains(p.Validate(), "taxRate")
+	p.TaxRate = nil
+
+	p.LongTermGrowthRate = value(10)
+	r.ErrorContains(p.Validate(), "below costOfEquity")
+
+	levered := CustomLeveredDiscountedCashFlowGetParams{Symbol: "AAPL", RevenueGrowthPct: value(-1.5)}
+	r.ErrorContains(levered.Validate(), "revenueGrowthPct")
+	r.ErrorContains((&CustomLeveredDiscountedCashFlowGetParams{}).Validate(), "symbol")
+}
+
+func (r *discountedCashFlowSuite) TestValidateCustomDCFParams() {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+	c, err := NewClientWithResponses(server.URL, WithRequestEditorFn(ValidateCustomDCFParams))
+	r.Require().NoError(err)
+
+	// The typed methods validate too, without sending the request.
+	taxRate := 14.9
+	_, err = c.CustomDiscountedCashFlowGetWithResponse(context.Background(), &CustomDiscountedCashFlowGetParams{Symbol: "AAPL", TaxRate: &taxRate})
+	r.ErrorContains(err, "taxRate")
+	_, err = c.CustomLeveredDiscountedCashFlowGetWithResponse(context.Background(), &CustomLeveredDiscountedCashFlowGetParams{Symbol: "AAPL", TaxRate: &taxRate})
+	r.ErrorContains(err, "taxRate")
+	r.Zero(requests)
+
+	taxRate = 0.149
+	_, err = c.CustomLeveredDiscountedCashFlowGetWithResponse(context.Background(), &CustomLeveredDiscountedCashFlowGetParams{Symbol: "AAPL", TaxRate: &taxRate})
+	r.NoError(err)
+	_, err = c.DiscountedCashFlowGetWithResponse(context.Background(), &DiscountedCashFlowGetParams{Symbol: "AAPL"})
+	r.NoError(err)
+	r.Equal(2, requests)
+}
+
+func TestDiscountedCashFlowSuite(t *testing.T) {
+	suite.Run(t, new(discountedCashFlowSuite))
+}
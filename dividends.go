@@ -0,0 +1,460 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// DividendFrequency is the payment frequency of a dividend.
+type DividendFrequency int
+
+const (
+	DividendFrequencyUnknown DividendFrequency = iota
+	DividendAnnual
+	DividendSemiAnnual
+	DividendQuarterly
+	DividendMonthly
+	DividendIrregular
+
+	// DividendSpecial is a one-off payment, excluded from the regular dividend.
+	DividendSpecial
+)
+
+// ParseDividendFrequency parses the Frequency of a DividendEvent, e.g. "Quarterly" or
+// "Semi-Annual", ignoring case, spaces and hyphens. Unknown values parse as
+// DividendFrequencyUnknown.
+func ParseDividendFrequency(s string) DividendFrequency {
+	s = strings.NewReplacer("-", "", " ", "", "_", "").Replace(strings.ToLower(s))
+	switch s {
+	case "annual", "annually", "yearly":
+		return DividendAnnual
+	case "semiannual", "semiannually":
+		return DividendSemiAnnual
+	case "quarterly":
+		return DividendQuarterly
+	case "monthly":
+		return DividendMonthly
+	case "irregular":
+		return DividendIrregular
+	case "special", "extra":
+		return DividendSpecial
+	default:
+		return DividendFrequencyUnknown
+	}
+}
+
+func (f DividendFrequency) String() string {
+	switch f {
+	case DividendAnnual:
+		return "Annual"
+	case DividendSemiAnnual:
+		return "Semi-Annual"
+	case DividendQuarterly:
+		return "Quarterly"
+	case DividendMonthly:
+		return "Monthly"
+	case DividendIrregular:
+		return "Irregular"
+	case DividendSpecial:
+		return "Special"
+	default:
+		return "Unknown"
+	}
+}
+
+// PerYear returns the number of payments per year, or zero for frequencies without a schedule.
+func (f DividendFrequency) PerYear() int {
+	switch f {
+	case DividendAnnual:
+		return 1
+	case DividendSemiAnnual:
+		return 2
+	case DividendQuarterly:
+		return 4
+	case DividendMonthly:
+		return 12
+	default:
+		return 0
+	}
+}
+
+// Dividend is a parsed DividendEvent. Dates the API leaves empty are zero.
+type Dividend struct {
+	Symbol string
+
+	ExDate          time.Time
+	RecordDate      time.Time
+	PaymentDate     time.Time
+	DeclarationDate time.Time
+
+	// Amount is the dividend per share as paid, and AdjAmount the amount adjusted for later
+	// splits.
+	Amount    float64
+	AdjAmount float64
+
+	Frequency DividendFrequency
+}
+
+func parseOptionalDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.DateOnly, s)
+}
+
+// NewDividend parses a DividendEvent.
+func NewDividend(e *DividendEvent) (*Dividend, error) {
+	d := &Dividend{
+		Symbol:    e.Symbol,
+		ExDate:    e.Date.Time,
+		Amount:    e.Dividend,
+		AdjAmount: e.AdjDividend,
+		Frequency: ParseDividendFrequency(e.Frequency),
+	}
+	for _, v := range []struct {
+		name string
+		s    string
+		t    *time.Time
+	}{
+		{"record date", e.RecordDate, &d.RecordDate},
+		{"payment date", e.PaymentDate, &d.PaymentDate},
+		{"declaration date", e.DeclarationDate, &d.DeclarationDate},
+	} {
+		t, err := parseOptionalDate(v.s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s of %s dividend on %s: %w", v.name, e.Symbol, e.Date, err)
+		}
+		*v.t = t
+	}
+	return d, nil
+}
+
+// NewDividends parses DividendEvent rows and sorts them by ex-dividend date, oldest first.
+func NewDividends(events []DividendEvent) ([]Dividend, error) {
+	dividends := make([]Dividend, 0, len(events))
+	for i := range events {
+		d, err := NewDividend(&events[i])
+		if err != nil {
+			return nil, err
+		}
+		dividends = append(dividends, *d)
+	}
+	sort.SliceStable(dividends, func(i, j int) bool {
+		return dividends[i].ExDate.Before(dividends[j].ExDate)
+	})
+	return dividends, nil
+}
+
+// regular reports whether the dividend is part of the regular schedule.
+func (d *Dividend) regular() bool {
+	return d.Frequency != DividendSpecial
+}
+
+// TrailingDividend returns the sum of the split-adjusted dividends that went ex within the year
+// up to t, special dividends included.
+func TrailingDividend(dividends []Dividend, t time.Time) float64 {
+	from := t.AddDate(-1, 0, 0)
+	var sum float64
+	for i := range dividends {
+		if d := &dividends[i]; d.ExDate.After(from) && !d.ExDate.After(t) {
+			sum += d.AdjAmount
+		}
+	}
+	return sum
+}
+
+// latestRegular returns the latest regular dividend that went ex on or before t.
+func latestRegular(dividends []Dividend, t time.Time) (*Dividend, bool) {
+	for i := len(dividends) - 1; i >= 0; i-- {
+		if d := &dividends[i]; d.regular() && !d.ExDate.After(t) {
+			return d, true
+		}
+	}
+	return nil, false
+}
+
+// paymentsPerYear returns the payments per year of the regular dividend as of t, from its
+// frequency, or else the number of regular dividends within the year up to t.
+func paymentsPerYear(dividends []Dividend, t time.Time) int {
+	latest, ok := latestRegular(dividends, t)
+	if !ok {
+		return 0
+	}
+	if n := latest.Frequency.PerYear(); n > 0 {
+		return n
+	}
+	from := t.AddDate(-1, 0, 0)
+	n := 0
+	for i := range dividends {
+		if d := &dividends[i]; d.regular() && d.ExDate.After(from) && !d.ExDate.After(t) {
+			n++
+		}
+	}
+	return n
+}
+
+// ForwardDividend returns the latest regular split-adjusted dividend as of t annualized by its
+// frequency.
+func ForwardDividend(dividends []Dividend, t time.Time) float64 {
+	latest, ok := latestRegular(dividends, t)
+	if !ok {
+		return 0
+	}
+	return latest.AdjAmount * float64(paymentsPerYear(dividends, t))
+}
+
+// DividendYield is the dividend yield of a trading day, as a fraction of the price.
+type DividendYield struct {
+	Date  time.Time
+	Price float64
+
+	Trailing float64
+	Forward  float64
+}
+
+// DividendYields computes the trailing and forward yields against a price history, which must be
+// adjusted for splits like the dividend amounts are. The yields are sorted by date; days without
+// a positive price are skipped.
+func DividendYields(dividends []Dividend, prices []LightCandle) []DividendYield {
+	var yields []DividendYield
+	for i := range prices {
+		p := &prices[i]
+		if p.Price <= 0 {
+			continue
+		}
+		price := float64(p.Price)
+		yields = append(yields, DividendYield{
+			Date:     p.Date.Time,
+			Price:    price,
+			Trailing: TrailingDividend(dividends, p.Date.Time) / price,
+			Forward:  ForwardDividend(dividends, p.Date.Time) / price,
+		})
+	}
+	sort.Slice(yields, func(i, j int) bool {
+		return yields[i].Date.Before(yields[j].Date)
+	})
+	return yields
+}
+
+// AnnualDividend is the sum of the regular split-adjusted dividends that went ex in a calendar
+// year.
+type AnnualDividend struct {
+	Year     int
+	Amount   float64
+	Payments int
+}
+
+// AnnualDividends sums the regular dividends by calendar year of the ex-dividend date, oldest
+// first. Special dividends are excluded so they do not break growth streaks.
+func AnnualDividends(dividends []Dividend) []AnnualDividend {
+	var annual []AnnualDividend
+	for i := range dividends {
+		d := &dividends[i]
+		if !d.regular() {
+			continue
+		}
+		year := d.ExDate.Year()
+		j := sort.Search(len(annual), func(j int) bool { return annual[j].Year >= year })
+		if j == len(annual) || annual[j].Year != year {
+			annual = append(annual, AnnualDividend{})
+			copy(annual[j+1:], annual[j:])
+			annual[j] = AnnualDividend{Year: year}
+		}
+		annual[j].Amount += d.AdjAmount
+		annual[j].Payments++
+	}
+	return annual
+}
+
+// DividendAristocratYears is the growth streak, in years, of a dividend aristocrat.
+const DividendAristocratYears = 25
+
+// DividendGrowthStreak returns the number of consecutive years up to and including through in
+// which the annual dividend rose over the previous year. A year without dividends ends the streak.
+// Pass the last complete year as through, as the current year is usually partial.
+func DividendGrowthStreak(annual []AnnualDividend, through int) int {
+	amounts := make(map[int]float64, len(annual))
+	for _, a := range annual {
+		amounts[a.Year] = a.Amount
+	}
+	streak := 0
+	for year := through; ; year-- {
+		current, ok := amounts[year]
+		if !ok {
+			break
+		}
+		previous, ok := amounts[year-1]
+		if !ok || current <= previous {
+			break
+		}
+		streak++
+	}
+	return streak
+}
+
+// DividendCoverage is the dividend coverage of a fiscal period of a cash flow statement.
+type DividendCoverage struct {
+	Date       time.Time
+	FiscalYear string
+	Period     string
+
+	// DividendsPaid is the common dividends paid, as a positive amount.
+	DividendsPaid float64
+
+	NetIncome    float64
+	FreeCashFlow float64
+
+	// PayoutRatio is DividendsPaid over NetIncome, and FCFCoverage FreeCashFlow over
+	// DividendsPaid. Both are zero when the denominator is zero.
+	PayoutRatio float64
+	FCFCoverage float64
+}
+
+// NewDividendCoverage computes the dividend coverage of a cash flow statement.
+func NewDividendCoverage(s *CashFlowStatement) *DividendCoverage {
+	paid := -s.CommonDividendsPaid
+	return &DividendCoverage{
+		Date:          s.Date.Time,
+		FiscalYear:    s.FiscalYear,
+		Period:        s.Period,
+		DividendsPaid: paid,
+		NetIncome:     s.NetIncome,
+		FreeCashFlow:  s.FreeCashFlow,
+		PayoutRatio:   ratio(paid, s.NetIncome),
+		FCFCoverage:   ratio(s.FreeCashFlow, paid),
+	}
+}
+
+// DividendCoverages computes the dividend coverage of every statement, sorted by date.
+func DividendCoverages(statements []CashFlowStatement) []DividendCoverage {
+	coverages := make([]DividendCoverage, len(statements))
+	for i := range statements {
+		coverages[i] = *NewDividendCoverage(&statements[i])
+	}
+	sort.Slice(coverages, func(i, j int) bool {
+		return coverages[i].Date.Before(coverages[j].Date)
+	})
+	return coverages
+}
+
+// ProjectNextDividend projects the first regular dividend going ex after t from the latest one:
+// the same amount, ex-dividend dates spaced by the frequency, and the payment date as many days
+// after the ex-dividend date as the latest one. It fails without a regular dividend or a known
+// frequency.
+func ProjectNextDividend(dividends []Dividend, t time.Time) (*Dividend, bool) {
+	latest, ok := latestRegular(dividends, t)
+	if !ok {
+		return nil, false
+	}
+	perYear := paymentsPerYear(dividends, t)
+	if perYear == 0 || 12%perYear != 0 {
+		return nil, false
+	}
+	months := 12 / perYear
+
+	next := *latest
+	next.RecordDate, next.PaymentDate, next.DeclarationDate = time.Time{}, time.Time{}, time.Time{}
+	for n := 1; !next.ExDate.After(t); n++ {
+		next.ExDate = addMonths(latest.ExDate, n*months)
+	}
+	if !latest.PaymentDate.IsZero() {
+		next.PaymentDate = next.ExDate.Add(latest.PaymentDate.Sub(latest.ExDate))
+	}
+	if !latest.RecordDate.IsZero() {
+		next.RecordDate = next.ExDate.Add(latest.RecordDate.Sub(latest.ExDate))
+	}
+	return &next, true
+}
+
+// addMonths adds months to t, clamping the day to the end of the target month rather than spilling
+// into the next one as AddDate does.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	// Day zero of the month after the target is the last day of the target month.
+	last := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	hour, minute, sec := t.Clock()
+	return time.Date(year, month+time.Month(months), min(day, last), hour, minute, sec, t.Nanosecond(), t.Location())
+}
+
+// GetDividends fetches the dividends of a company, oldest first.
+func GetDividends(ctx context.Context, c *ClientWithResponses, params *DividendsGetParams) ([]Dividend, error) {
+	resp, err := c.DividendsGetWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of dividends: %s", resp.Status())
+	}
+	return NewDividends(*resp.JSON200)
+}
+
+// DividendProfile summarizes the dividends of a company.
+type DividendProfile struct {
+	Symbol    string
+	Dividends []Dividend
+	Frequency DividendFrequency
+
+	// Yields covers the year up to the latest price.
+	Yields []DividendYield
+
+	Annual []AnnualDividend
+
+	// GrowthStreak is the growth streak through the last complete calendar year.
+	GrowthStreak int
+
+	// Coverage is computed from the annual cash flow statements.
+	Coverage []DividendCoverage
+
+	// Next is the projected next dividend, or nil without a schedule.
+	Next *Dividend
+}
+
+// GetDividendProfile fetches the dividends, the price history of the year up to now and the
+// annual cash flow statements of a company, and summarizes its dividends.
+func GetDividendProfile(ctx context.Context, c *ClientWithResponses, symbol string) (*DividendProfile, error) {
+	dividends, err := GetDividends(ctx, c, &DividendsGetParams{Symbol: symbol})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	from := openapi_types.Date{Time: now.AddDate(-1, 0, 0)}
+	to := openapi_types.Date{Time: now}
+	prices, err := c.HistoricalPriceEodLightGetWithResponse(ctx, &HistoricalPriceEodLightGetParams{Symbol: symbol, From: &from, To: &to})
+	if err != nil {
+		return nil, err
+	}
+	if prices.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of historical prices: %s", prices.Status())
+	}
+
+	period := Annual
+	cashFlows, err := c.CashFlowStatementGetWithResponse(ctx, &CashFlowStatementGetParams{Symbol: symbol, Period: &period})
+	if err != nil {
+		return nil, err
+	}
+	if cashFlows.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of %s: %s", cashFlowStatementName, cashFlows.Status())
+	}
+
+	p := &DividendProfile{
+		Symbol:    symbol,
+		Dividends: dividends,
+		Yields:    DividendYields(dividends, *prices.JSON200),
+		Annual:    AnnualDividends(dividends),
+		Coverage:  DividendCoverages(*cashFlows.JSON200),
+	}
+	if latest, ok := latestRegular(dividends, now); ok {
+		p.Frequency = latest.Frequency
+	}
+	p.GrowthStreak = DividendGrowthStreak(p.Annual, now.Year()-1)
+	if next, ok := ProjectNextDividend(dividends, now); ok {
+		p.Next = next
+	}
+	return p, nil
+}
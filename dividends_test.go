@@ -0,0 +1,164 @@
+package financialmodelingprep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type dividendsSuite struct {
+	suite.Suite
+}
+
+func dividendEvent(date time.Time, amount float64, frequency, recordDate, paymentDate string) DividendEvent {
+	return DividendEvent{
+		Symbol:      "KO",
+		Date:        fixtureDate(date.Year(), date.Month(), date.Day()),
+		Dividend:    amount,
+		AdjDividend: amount,
+		Frequency:   frequency,
+		RecordDate:  recordDate,
+		PaymentDate: paymentDate,
+	}
+}
+
+func (r *dividendsSuite) dividends() []Dividend {
+	var events []DividendEvent
+	for year, amount := range map[int]float64{2022: 0.44, 2023: 0.46, 2024: 0.485} {
+		for _, month := range []time.Month{time.March, time.June, time.September, time.November} {
+			events = append(events, dividendEvent(time.Date(year, month, 14, 0, 0, 0, 0, time.UTC), amount, "Quarterly", "", ""))
+		}
+	}
+	events = append(events,
+		dividendEvent(time.Date(2024, time.December, 2, 0, 0, 0, 0, time.UTC), 1, "Special", "", ""),
+		dividendEvent(time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC), 0.51, "Quarterly", "2025-03-14", "2025-04-01"),
+		dividendEvent(time.Date(2025, time.June, 13, 0, 0, 0, 0, time.UTC), 0.51, "Quarterly", "2025-06-13", "2025-07-01"),
+	)
+	dividends, err := NewDividends(events)
+	r.Require().NoError(err)
+	return dividends
+}
+
+func (r *dividendsSuite) TestParseDividendFrequency() {
+	r.Equal(DividendQuarterly, ParseDividendFrequency("Quarterly"))
+	r.Equal(DividendSemiAnnual, ParseDividendFrequency("Semi-Annual"))
+	r.Equal(DividendSemiAnnual, ParseDividendFrequency("semi annual"))
+	r.Equal(DividendMonthly, ParseDividendFrequency("MONTHLY"))
+	r.Equal(DividendSpecial, ParseDividendFrequency("Special"))
+	r.Equal(DividendFrequencyUnknown, ParseDividendFrequency(""))
+	r.Equal("Semi-Annual", DividendSemiAnnual.String())
+	r.Equal(2, DividendSemiAnnual.PerYear())
+	r.Zero(DividendIrregular.PerYear())
+}
+
+func (r *dividendsSuite) TestNewDividends() {
+	dividends := r.dividends()
+	r.Len(dividends, 15)
+	r.Equal(time.Date(2022, time.March, 14, 0, 0, 0, 0, time.UTC), dividends[0].ExDate)
+	r.True(dividends[0].PaymentDate.IsZero())
+
+	latest := dividends[len(dividends)-1]
+	r.Equal(DividendQuarterly, latest.Frequency)
+	r.Equal(time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC), latest.PaymentDate)
+
+	_, err := NewDividends([]DividendEvent{dividendEvent(time.Now(), 1, "Annual", "", "soon")})
+	r.Error(err)
+}
+
+func (r *dividendsSuite) TestYields() {
+	dividends := r.dividends()
+	t := time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC)
+
+	// Two quarters of 2024, the special dividend and two quarters of 2025.
+	r.InDelta(0.485*2+1+0.51*2, TrailingDividend(dividends, t), 1e-9)
+	r.InDelta(0.51*4, ForwardDividend(dividends, t), 1e-9)
+
+	yields := DividendYields(dividends, []LightCandle{
+		{Symbol: "KO", Date: fixtureDate(2025, time.June, 30), Price: 60},
+		{Symbol: "KO", Date: fixtureDate(2025, time.June, 27), Price: 0},
+	})
+	r.Len(yields, 1)
+	r.InDelta(2.99/60, yields[0].Trailing, 1e-6)
+	r.InDelta(2.04/60, yields[0].Forward, 1e-6)
+
+	// Without a frequency, the payments within the trailing year set the forward dividend.
+	unknown, err := NewDividends([]DividendEvent{
+		dividendEvent(time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC), 0.3, "", "", ""),
+		dividendEvent(time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC), 0.35, "", "", ""),
+	})
+	r.Require().NoError(err)
+	r.InDelta(0.7, ForwardDividend(unknown, t), 1e-9)
+}
+
+func (r *dividendsSuite) TestGrowthStreak() {
+	annual := AnnualDividends(r.dividends())
+	r.Len(annual, 4)
+	r.Equal(2024, annual[2].Year)
+	r.InDelta(0.485*4, annual[2].Amount, 1e-9)
+	r.Equal(4, annual[2].Payments)
+
+	r.Equal(2, DividendGrowthStreak(annual, 2024))
+	r.Equal(1, DividendGrowthStreak(annual, 2023))
+	// The partial year did not grow.
+	r.Zero(DividendGrowthStreak(annual, 2025))
+	r.Zero(DividendGrowthStreak(annual, 2030))
+}
+
+func (r *dividendsSuite) TestCoverage() {
+	coverages := DividendCoverages([]CashFlowStatement{
+		{Date: fixtureDate(2024, time.December, 31), FiscalYear: "2024", Period: "FY", CommonDividendsPaid: -8e9, NetIncome: 10e9, FreeCashFlow: 12e9},
+		{Date: fixtureDate(2023, time.December, 31), FiscalYear: "2023", Period: "FY", NetIncome: 9e9, FreeCashFlow: 11e9},
+	})
+	r.Len(coverages, 2)
+	r.Equal("2023", coverages[0].FiscalYear)
+	r.Zero(coverages[0].FCFCoverage)
+
+	r.InDelta(8e9, coverages[1].DividendsPaid, 1e-9)
+	r.InDelta(0.8, coverages[1].PayoutRatio, 1e-9)
+	r.InDelta(1.5, coverages[1].FCFCoverage, 1e-9)
+}
+
+func (r *dividendsSuite) TestProjectNextDividend() {
+	dividends := r.dividends()
+
+	next, ok := ProjectNextDividend(dividends, time.Date(2025, time.July, 15, 0, 0, 0, 0, time.UTC))
+	r.True(ok)
+	r.Equal(time.Date(2025, time.September, 13, 0, 0, 0, 0, time.UTC), next.ExDate)
+	r.Equal(time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC), next.PaymentDate)
+	r.Equal(next.ExDate, next.RecordDate)
+	r.InDelta(0.51, next.Amount, 1e-9)
+
+	// Skips the schedule forward past t.
+	next, ok = ProjectNextDividend(dividends, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	r.True(ok)
+	r.Equal(time.Date(2026, time.March, 13, 0, 0, 0, 0, time.UTC), next.ExDate)
+
+	_, ok = ProjectNextDividend(dividends, time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))
+	r.False(ok)
+
+	// Month-end ex-dividend dates stay at the end of shorter months.
+	var events []DividendEvent
+	for _, date := range []time.Time{
+		time.Date(2024, time.August, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.November, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.May, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.August, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.November, 30, 0, 0, 0, 0, time.UTC),
+	} {
+		events = append(events, dividendEvent(date, 0.25, "Quarterly", "", ""))
+	}
+	monthEnd, err := NewDividends(events)
+	r.Require().NoError(err)
+	next, ok = ProjectNextDividend(monthEnd, time.Date(2025, time.December, 15, 0, 0, 0, 0, time.UTC))
+	r.True(ok)
+	r.Equal(time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC), next.ExDate)
+	next, ok = ProjectNextDividend(monthEnd, time.Date(2027, time.December, 15, 0, 0, 0, 0, time.UTC))
+	r.True(ok)
+	r.Equal(time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC), next.ExDate)
+}
+
+func TestDividendsSuite(t *testing.T) {
+	suite.Run(t, new(dividendsSuite))
+}
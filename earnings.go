@@ -0,0 +1,198 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// EarningsOutcome classifies reported earnings against the consensus.
+type EarningsOutcome string
+
+const (
+	EarningsBeat   EarningsOutcome = "beat"
+	EarningsMiss   EarningsOutcome = "miss"
+	EarningsInLine EarningsOutcome = "in-line"
+)
+
+// SUE parameters: the surprise is standardized by the seasonal EPS changes of up to SUEWindow
+// earlier quarters, and requires at least MinSUEHistory of them. The same quarter a year earlier
+// is the report closest to a year before, within SeasonalTolerance.
+const (
+	SUEWindow         = 8
+	MinSUEHistory     = 4
+	SeasonalTolerance = 45 * 24 * time.Hour
+)
+
+// EarningsSurprise is the surprise of a reported quarter.
+type EarningsSurprise struct {
+	Symbol string
+	Date   time.Time
+
+	EpsActual    float64
+	EpsEstimated float64
+
+	// EpsSurprise is EpsActual minus EpsEstimated, and EpsSurprisePercentage the surprise relative
+	// to the absolute estimate, in percent. The latter is zero when the estimate is zero.
+	EpsSurprise           float64
+	EpsSurprisePercentage float64
+
+	RevenueActual             float64
+	RevenueEstimated          float64
+	RevenueSurprise           float64
+	RevenueSurprisePercentage float64
+
+	// Outcome compares the EPS to the consensus.
+	Outcome EarningsOutcome
+
+	// SUE is the standardized unexpected earnings: the EPS change from the same quarter a year
+	// earlier, divided by the standard deviation of that change over the preceding quarters. It is
+	// nil without enough history.
+	SUE *float64
+}
+
+// reported reports whether the quarter has been reported. The earnings endpoints return upcoming
+// quarters with zero actual figures.
+func reported(e *EarningEvent) bool {
+	return e.EpsActual != 0 || e.RevenueActual != 0
+}
+
+func surprisePercentage(actual, estimated float64) float64 {
+	if estimated == 0 {
+		return 0
+	}
+	return (actual - estimated) / math.Abs(estimated) * 100
+}
+
+// NewEarningsSurprises computes the surprises of the reported quarters of a company, oldest first.
+// Upcoming quarters are skipped.
+func NewEarningsSurprises(events []EarningEvent) []EarningsSurprise {
+	var surprises []EarningsSurprise
+	for i := range events {
+		e := &events[i]
+		if !reported(e) {
+			continue
+		}
+		s := EarningsSurprise{
+			Symbol:                    e.Symbol,
+			Date:                      e.Date.Time,
+			EpsActual:                 e.EpsActual,
+			EpsEstimated:              e.EpsEstimated,
+			EpsSurprise:               e.EpsActual - e.EpsEstimated,
+			EpsSurprisePercentage:     surprisePercentage(e.EpsActual, e.EpsEstimated),
+			RevenueActual:             e.RevenueActual,
+			RevenueEstimated:          e.RevenueEstimated,
+			RevenueSurprise:           e.RevenueActual - e.RevenueEstimated,
+			RevenueSurprisePercentage: surprisePercentage(e.RevenueActual, e.RevenueEstimated),
+		}
+		switch {
+		case s.EpsSurprise > 0:
+			s.Outcome = EarningsBeat
+		case s.EpsSurprise < 0:
+			s.Outcome = EarningsMiss
+		default:
+			s.Outcome = EarningsInLine
+		}
+		surprises = append(surprises, s)
+	}
+	sort.SliceStable(surprises, func(i, j int) bool {
+		return surprises[i].Date.Before(surprises[j].Date)
+	})
+
+	// Seasonal EPS changes, with one entry per quarter reported a year after another. Matching by
+	// date rather than position keeps gaps and duplicates in the history from misaligning them.
+	var changes []float64
+	for i := range surprises {
+		j, ok := yearEarlier(surprises[:i], surprises[i].Date)
+		if !ok {
+			continue
+		}
+		change := surprises[i].EpsActual - surprises[j].EpsActual
+		past := changes
+		if len(past) > SUEWindow {
+			past = past[len(past)-SUEWindow:]
+		}
+		if len(past) >= MinSUEHistory {
+			if sd := stddev(past); sd > 0 {
+				sue := change / sd
+				surprises[i].SUE = &sue
+			}
+		}
+		changes = append(changes, change)
+	}
+	return surprises
+}
+
+// yearEarlier returns the index of the surprise reported closest to a year before date, within
+// SeasonalTolerance.
+func yearEarlier(surprises []EarningsSurprise, date time.Time) (int, bool) {
+	target := date.AddDate(-1, 0, 0)
+	found, best := -1, SeasonalTolerance
+	for i := range surprises {
+		d := surprises[i].Date.Sub(target)
+		if d < 0 {
+			d = -d
+		}
+		if d <= best {
+			found, best = i, d
+		}
+	}
+	return found, found >= 0
+}
+
+// CurrentStreak returns the outcome of the latest quarter and the number of consecutive quarters
+// with the same outcome up to it.
+func CurrentStreak(surprises []EarningsSurprise) (EarningsOutcome, int) {
+	if len(surprises) == 0 {
+		return "", 0
+	}
+	outcome := surprises[len(surprises)-1].Outcome
+	n := 0
+	for i := len(surprises) - 1; i >= 0 && surprises[i].Outcome == outcome; i-- {
+		n++
+	}
+	return outcome, n
+}
+
+// LongestStreak returns the largest number of consecutive quarters with the given outcome.
+func LongestStreak(surprises []EarningsSurprise, outcome EarningsOutcome) int {
+	longest, n := 0, 0
+	for _, s := range surprises {
+		if s.Outcome != outcome {
+			n = 0
+			continue
+		}
+		n++
+		longest = max(longest, n)
+	}
+	return longest
+}
+
+// BeatRate returns the fraction of quarters that beat the consensus.
+func BeatRate(surprises []EarningsSurprise) float64 {
+	if len(surprises) == 0 {
+		return 0
+	}
+	beats := 0
+	for _, s := range surprises {
+		if s.Outcome == EarningsBeat {
+			beats++
+		}
+	}
+	return float64(beats) / float64(len(surprises))
+}
+
+// GetEarningsSurprises fetches the earnings of a company and computes the surprises of the
+// reported quarters, oldest first.
+func GetEarningsSurprises(ctx context.Context, c *ClientWithResponses, params *EarningsGetParams) ([]EarningsSurprise, error) {
+	resp, err := c.EarningsGetWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of earnings: %s", resp.Status())
+	}
+	return NewEarningsSurprises(*resp.JSON200), nil
+}
@@ -0,0 +1,94 @@
+package financialmodelingprep
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type earningsSuite struct {
+	suite.Suite
+	events []EarningEvent
+}
+
+func (r *earningsSuite) SetupTest() {
+	// Newest first, as served, with an upcoming quarter.
+	eps := []float64{1.10, 1.00, 1.05, 0.90, 1.00, 0.85, 0.95, 0.80, 0.90}
+	estimates := []float64{1.00, 1.00, 1.00, 0.95, 0.95, 0.80, 0.90, 0.85, 0.85}
+	events := []EarningEvent{
+		{Symbol: "ACME", Date: fixtureDate(2026, time.April, 30), EpsEstimated: 1.2, RevenueEstimated: 1200},
+	}
+	date := time.Date(2026, time.January, 30, 0, 0, 0, 0, time.UTC)
+	for i := range eps {
+		events = append(events, EarningEvent{
+			Symbol:           "ACME",
+			Date:             fixtureDate(date.Year(), date.Month(), date.Day()),
+			EpsActual:        eps[i],
+			EpsEstimated:     estimates[i],
+			RevenueActual:    1000,
+			RevenueEstimated: 1000,
+		})
+		date = date.AddDate(0, -3, 0)
+	}
+	r.events = events
+}
+
+func (r *earningsSuite) TestNewEarningsSurprises() {
+	surprises := NewEarningsSurprises(r.events)
+	r.Len(surprises, 9)
+
+	latest := surprises[8]
+	r.Equal(time.Date(2026, time.January, 30, 0, 0, 0, 0, time.UTC), latest.Date)
+	r.InDelta(0.1, latest.EpsSurprise, 1e-9)
+	r.InDelta(10, latest.EpsSurprisePercentage, 1e-9)
+	r.Equal(EarningsBeat, latest.Outcome)
+	r.Equal(EarningsInLine, surprises[7].Outcome)
+	r.Equal(EarningsMiss, surprises[1].Outcome)
+	r.Zero(latest.RevenueSurprisePercentage)
+
+	// Seasonal changes start with the fifth quarter, and SUE needs four of them beforehand.
+	for _, s := range surprises[:8] {
+		r.Nil(s.SUE)
+	}
+	// Changes 0.10, 0.10, 0.10, 0.15 before the latest change of 0.10.
+	r.InDelta(0.1/0.025, *latest.SUE, 1e-9)
+}
+
+func (r *earningsSuite) TestSUEWithGap() {
+	// Drop July 2024 and add October 2023, so quarters are no longer four entries apart.
+	var events []EarningEvent
+	for _, e := range r.events {
+		if !e.Date.Equal(time.Date(2024, time.July, 30, 0, 0, 0, 0, time.UTC)) {
+			events = append(events, e)
+		}
+	}
+	events = append(events, EarningEvent{Symbol: "ACME", Date: fixtureDate(2023, time.October, 30), EpsActual: 0.80, EpsEstimated: 0.80})
+
+	surprises := NewEarningsSurprises(events)
+	r.Require().Len(surprises, 9)
+	// July 2025 has nothing a year earlier, which leaves changes 0.05, 0.10, 0.10, 0.15 before the
+	// latest one of 0.10.
+	r.Nil(surprises[6].SUE)
+	r.InDelta(0.1/math.Sqrt(0.005/3), *surprises[8].SUE, 1e-9)
+}
+
+func (r *earningsSuite) TestStreaks() {
+	surprises := NewEarningsSurprises(r.events)
+
+	outcome, n := CurrentStreak(surprises)
+	r.Equal(EarningsBeat, outcome)
+	r.Equal(1, n)
+	r.Equal(3, LongestStreak(surprises, EarningsBeat))
+	r.Equal(1, LongestStreak(surprises, EarningsMiss))
+	r.InDelta(6.0/9, BeatRate(surprises), 1e-9)
+
+	outcome, n = CurrentStreak(nil)
+	r.Empty(outcome)
+	r.Zero(n)
+}
+
+func TestEarningsSuite(t *testing.T) {
+	suite.Run(t, new(earningsSuite))
+}
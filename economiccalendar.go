@@ -0,0 +1,285 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Impact is the expected market impact of an economic release, ordered from none to high.
+type Impact int
+
+const (
+	ImpactNone Impact = iota
+	ImpactLow
+	ImpactMedium
+	ImpactHigh
+)
+
+// ParseImpact parses the Impact of an EconomicEvent, case-insensitively. Unknown values, e.g.
+// "Holiday", parse as ImpactNone.
+func ParseImpact(s string) Impact {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "low":
+		return ImpactLow
+	case "medium":
+		return ImpactMedium
+	case "high":
+		return ImpactHigh
+	default:
+		return ImpactNone
+	}
+}
+
+func (i Impact) String() string {
+	switch i {
+	case ImpactLow:
+		return "Low"
+	case ImpactMedium:
+		return "Medium"
+	case ImpactHigh:
+		return "High"
+	default:
+		return "None"
+	}
+}
+
+// EconomicRelease is a parsed EconomicEvent.
+type EconomicRelease struct {
+	// Time is the release time, in UTC.
+	Time time.Time
+
+	Country  string
+	Currency string
+	Event    string
+	Impact   Impact
+
+	Actual           *float64
+	Estimate         *float64
+	Previous         *float64
+	Change           *float64
+	ChangePercentage float64
+
+	// Surprise is Actual minus Estimate, and SurpriseZ the surprise standardized by the surprises
+	// of the earlier releases of the same event. Both are nil when they cannot be computed, see
+	// ComputeSurprises.
+	Surprise  *float64
+	SurpriseZ *float64
+}
+
+// NewEconomicRelease parses an EconomicEvent. The date is expected in UTC.
+func NewEconomicRelease(e *EconomicEvent) (*EconomicRelease, error) {
+	t, err := time.Parse(time.DateTime, e.Date)
+	if err != nil {
+		if t, err = time.Parse(time.DateOnly, e.Date); err != nil {
+			return nil, fmt.Errorf("invalid date of %s %s: %w", e.Country, e.Event, err)
+		}
+	}
+	r := &EconomicRelease{
+		Time:             t,
+		Country:          e.Country,
+		Currency:         e.Currency,
+		Event:            e.Event,
+		Impact:           ParseImpact(e.Impact),
+		Actual:           e.Actual,
+		Estimate:         e.Estimate,
+		Previous:         e.Previous,
+		Change:           e.Change,
+		ChangePercentage: e.ChangePercentage,
+	}
+	if r.Actual != nil && r.Estimate != nil {
+		s := *r.Actual - *r.Estimate
+		r.Surprise = &s
+	}
+	return r, nil
+}
+
+// NewEconomicReleases parses EconomicEvent rows and sorts them by time.
+func NewEconomicReleases(events []EconomicEvent) ([]EconomicRelease, error) {
+	releases := make([]EconomicRelease, 0, len(events))
+	for i := range events {
+		r, err := NewEconomicRelease(&events[i])
+		if err != nil {
+			return nil, err
+		}
+		releases = append(releases, *r)
+	}
+	sort.SliceStable(releases, func(i, j int) bool {
+		return releases[i].Time.Before(releases[j].Time)
+	})
+	return releases, nil
+}
+
+// EconomicCalendarFilter selects releases. Empty fields match everything.
+type EconomicCalendarFilter struct {
+	// Countries and Currencies are matched case-insensitively.
+	Countries  []string
+	Currencies []string
+
+	MinImpact Impact
+
+	// Event matches releases whose event contains it, case-insensitively.
+	Event string
+
+	// From and To bound the release time, inclusively.
+	From, To time.Time
+}
+
+// Match reports whether a release passes the filter.
+func (f *EconomicCalendarFilter) Match(r *EconomicRelease) bool {
+	if len(f.Countries) > 0 && !containsFold(f.Countries, r.Country) {
+		return false
+	}
+	if len(f.Currencies) > 0 && !containsFold(f.Currencies, r.Currency) {
+		return false
+	}
+	if r.Impact < f.MinImpact {
+		return false
+	}
+	if f.Event != "" && !strings.Contains(strings.ToLower(r.Event), strings.ToLower(f.Event)) {
+		return false
+	}
+	if !f.From.IsZero() && r.Time.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && r.Time.After(f.To) {
+		return false
+	}
+	return true
+}
+
+// FilterEconomicReleases returns the releases passing the filter, in order.
+func FilterEconomicReleases(releases []EconomicRelease, f *EconomicCalendarFilter) []EconomicRelease {
+	var matched []EconomicRelease
+	for i := range releases {
+		if f.Match(&releases[i]) {
+			matched = append(matched, releases[i])
+		}
+	}
+	return matched
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// MinSurpriseHistory is the number of earlier surprises of an event required to standardize its
+// surprise.
+const MinSurpriseHistory = 4
+
+// ComputeSurprises standardizes the surprise of every release by the standard deviation of the
+// surprises of the earlier releases of the same country and event, in place. Releases with fewer
+// than MinSurpriseHistory earlier surprises, or whose earlier surprises do not vary, get no
+// SurpriseZ. The releases must be sorted by time, as returned by NewEconomicReleases.
+func ComputeSurprises(releases []EconomicRelease) {
+	history := map[[2]string][]float64{}
+	for i := range releases {
+		r := &releases[i]
+		r.SurpriseZ = nil
+		if r.Surprise == nil {
+			continue
+		}
+		key := [2]string{r.Country, r.Event}
+		past := history[key]
+		if len(past) >= MinSurpriseHistory {
+			if sd := stddev(past); sd > 0 {
+				z := *r.Surprise / sd
+				r.SurpriseZ = &z
+			}
+		}
+		history[key] = append(past, *r.Surprise)
+	}
+}
+
+// stddev returns the sample standard deviation.
+func stddev(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	var mean float64
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+	var ss float64
+	for _, v := range values {
+		ss += (v - mean) * (v - mean)
+	}
+	return math.Sqrt(ss / float64(len(values)-1))
+}
+
+// economicCalendarWindow is the longest range of days served by a single EconomicCalendarGet call.
+const economicCalendarWindow = 90
+
+// GetEconomicReleases fetches the economic calendar within [from, to], splitting the range into
+// windows the API accepts, and computes the surprises over the fetched history.
+func GetEconomicReleases(ctx context.Context, c *ClientWithResponses, from, to time.Time) ([]EconomicRelease, error) {
+	var events []EconomicEvent
+	for _, w := range dateWindows(from, to, economicCalendarWindow) {
+		params := &EconomicCalendarGetParams{
+			From: &w.From,
+			To:   &w.To,
+		}
+		resp, err := c.EconomicCalendarGetWithResponse(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected status of economic calendar: %s", resp.Status())
+		}
+		events = append(events, *resp.JSON200...)
+	}
+	releases, err := NewEconomicReleases(events)
+	if err != nil {
+		return nil, err
+	}
+	ComputeSurprises(releases)
+	return releases, nil
+}
+
+// ICalEvent converts the release to a calendar event.
+func (r *EconomicRelease) ICalEvent() ICalEvent {
+	var details []string
+	for _, v := range []struct {
+		name  string
+		value *float64
+	}{
+		{"Actual", r.Actual},
+		{"Estimate", r.Estimate},
+		{"Previous", r.Previous},
+		{"Surprise", r.Surprise},
+		{"Surprise z-score", r.SurpriseZ},
+	} {
+		if v.value != nil {
+			details = append(details, v.name+": "+strconv.FormatFloat(*v.value, 'f', -1, 64))
+		}
+	}
+	details = append(details, "Impact: "+r.Impact.String())
+
+	return ICalEvent{
+		UID:         icalUID("economic", r.Country, r.Event, r.Time.Format(time.DateTime)),
+		Start:       r.Time,
+		Summary:     fmt.Sprintf("[%s] %s", r.Country, r.Event),
+		Description: strings.Join(details, "\n"),
+		Categories:  []string{"Economic", r.Impact.String() + " impact", r.Currency},
+	}
+}
+
+// WriteEconomicCalendar writes the releases as an iCalendar (.ics) feed named name.
+func WriteEconomicCalendar(w io.Writer, name string, releases []EconomicRelease) error {
+	events := make([]ICalEvent, len(releases))
+	for i := range releases {
+		events[i] = releases[i].ICalEvent()
+	}
+	return WriteICalendar(w, name, events)
+}
@@ -0,0 +1,107 @@
+package financialmodelingprep
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type economicCalendarSuite struct {
+	suite.Suite
+	events []EconomicEvent
+}
+
+func float64Ptr(v float64) *float64 {
+	return &v
+}
+
+func (r *economicCalendarSuite) SetupTest() {
+	var events []EconomicEvent
+	// Monthly CPI releases with surprises of 0.1, -0.1, 0.2, -0.2 and finally 0.3.
+	for i, surprise := range []float64{0.1, -0.1, 0.2, -0.2, 0.3} {
+		events = append(events, EconomicEvent{
+			Date:     time.Date(2024, time.Month(i+1), 12, 12, 30, 0, 0, time.UTC).Format(time.DateTime),
+			Country:  "US",
+			Currency: "USD",
+			Event:    "CPI YoY",
+			Impact:   "High",
+			Estimate: float64Ptr(3),
+			Actual:   float64Ptr(3 + surprise),
+		})
+	}
+	events = append(events,
+		EconomicEvent{Date: "2024-03-01 03:35:00", Country: "JP", Currency: "JPY", Event: "3-Month Bill Auction", Impact: "Low", Previous: float64Ptr(-0.112), Actual: float64Ptr(-0.096)},
+		EconomicEvent{Date: "2024-02-01 09:00:00", Country: "DE", Currency: "EUR", Event: "Bank Holiday", Impact: "None"},
+	)
+	r.events = events
+}
+
+func (r *economicCalendarSuite) TestNewEconomicReleases() {
+	releases, err := NewEconomicReleases(r.events)
+	r.NoError(err)
+	r.Len(releases, 7)
+	r.Equal(time.Date(2024, time.January, 12, 12, 30, 0, 0, time.UTC), releases[0].Time)
+	r.Equal(ImpactHigh, releases[0].Impact)
+	r.InDelta(0.1, *releases[0].Surprise, 1e-9)
+
+	_, err = NewEconomicReleases([]EconomicEvent{{Date: "03/01/2024"}})
+	r.Error(err)
+
+	r.Equal(ImpactMedium, ParseImpact(" medium"))
+	r.Equal(ImpactNone, ParseImpact("Holiday"))
+}
+
+func (r *economicCalendarSuite) TestFilter() {
+	releases, err := NewEconomicReleases(r.events)
+	r.NoError(err)
+
+	matched := FilterEconomicReleases(releases, &EconomicCalendarFilter{Currencies: []string{"usd", "jpy"}, MinImpact: ImpactLow})
+	r.Len(matched, 6)
+
+	matched = FilterEconomicReleases(releases, &EconomicCalendarFilter{Countries: []string{"US"}, Event: "cpi", From: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)})
+	r.Len(matched, 3)
+
+	matched = FilterEconomicReleases(releases, &EconomicCalendarFilter{MinImpact: ImpactHigh, To: time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)})
+	r.Len(matched, 1)
+}
+
+func (r *economicCalendarSuite) TestComputeSurprises() {
+	releases, err := NewEconomicReleases(r.events)
+	r.NoError(err)
+	ComputeSurprises(releases)
+
+	cpi := FilterEconomicReleases(releases, &EconomicCalendarFilter{Event: "CPI"})
+	for _, release := range cpi[:MinSurpriseHistory] {
+		r.Nil(release.SurpriseZ)
+	}
+	// The sample standard deviation of 0.1, -0.1, 0.2 and -0.2 is sqrt(0.1 / 3).
+	r.InDelta(0.3/0.18257, *cpi[4].SurpriseZ, 1e-3)
+
+	// Without an estimate there is no surprise.
+	jp := FilterEconomicReleases(releases, &EconomicCalendarFilter{Countries: []string{"JP"}})
+	r.Nil(jp[0].Surprise)
+	r.Nil(jp[0].SurpriseZ)
+}
+
+func (r *economicCalendarSuite) TestWriteEconomicCalendar() {
+	releases, err := NewEconomicReleases(r.events)
+	r.NoError(err)
+	ComputeSurprises(releases)
+
+	var buf bytes.Buffer
+	r.NoError(WriteEconomicCalendar(&buf, "US macro", FilterEconomicReleases(releases, &EconomicCalendarFilter{Countries: []string{"US"}})))
+	ics := buf.String()
+	r.Equal(5, strings.Count(ics, "BEGIN:VEVENT"))
+	r.Contains(ics, "X-WR-CALNAME:US macro\r\n")
+	r.Contains(ics, "DTSTART:20240112T123000Z\r\n")
+	r.Contains(ics, "SUMMARY:[US] CPI YoY\r\n")
+	r.Contains(ics, `DESCRIPTION:Actual: 3.1\nEstimate: 3\nSurprise: `)
+	r.Contains(ics, "CATEGORIES:Economic,High impact,USD\r\n")
+}
+
+func TestEconomicCalendarSuite(t *testing.T) {
+	suite.Run(t, new(economicCalendarSuite))
+}
@@ -0,0 +1,210 @@
+package financialmodelingprep
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+// EstimateSnapshot is the consensus for a fiscal period as seen at a point in time.
+type EstimateSnapshot struct {
+	Taken    time.Time          `json:"taken"`
+	Estimate FinancialEstimates `json:"estimate"`
+}
+
+// EstimateRevision is a change of a consensus figure between two snapshots.
+type EstimateRevision struct {
+	Symbol string
+
+	// Date is the end of the estimated fiscal period.
+	Date time.Time
+
+	Field    string
+	From, To time.Time
+
+	Previous, Current float64
+
+	// Change is the relative change, (Current - Previous) / |Previous|, or zero when Previous is
+	// zero.
+	Change float64
+}
+
+type estimateKey struct {
+	symbol string
+	date   time.Time
+}
+
+// EstimateStore keeps snapshots of the analyst consensus, which AnalystEstimatesGet only serves
+// in its latest state, so revisions can be tracked over time. A snapshot is only stored when the
+// consensus changed since the previous one of the same fiscal period.
+type EstimateStore struct {
+	mu        sync.RWMutex
+	snapshots map[estimateKey][]EstimateSnapshot
+}
+
+// NewEstimateStore returns an empty store.
+func NewEstimateStore() *EstimateStore {
+	return &EstimateStore{snapshots: map[estimateKey][]EstimateSnapshot{}}
+}
+
+// Add records the estimates as seen at taken. It returns the number of snapshots stored.
+func (s *EstimateStore) Add(taken time.Time, estimates ...FinancialEstimates) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	added := 0
+	for _, e := range estimates {
+		key := estimateKey{symbol: e.Symbol, date: e.Date.Time}
+		snapshots := s.snapshots[key]
+
+		// Keep the snapshots sorted, and skip those equal to the consensus in effect at taken.
+		i := sort.Search(len(snapshots), func(i int) bool {
+			return snapshots[i].Taken.After(taken)
+		})
+		if i > 0 && snapshots[i-1].Estimate == e {
+			continue
+		}
+		snapshots = append(snapshots, EstimateSnapshot{})
+		copy(snapshots[i+1:], snapshots[i:])
+		snapshots[i] = EstimateSnapshot{Taken: taken, Estimate: e}
+		s.snapshots[key] = snapshots
+		added++
+	}
+	return added
+}
+
+// Snapshot fetches the current consensus with AnalystEstimatesGet and adds it to the store.
+func (s *EstimateStore) Snapshot(ctx context.Context, c *ClientWithResponses, params *AnalystEstimatesGetParams) (int, error) {
+	resp, err := c.AnalystEstimatesGetWithResponse(ctx, params)
+	if err != nil {
+		return 0, err
+	}
+	if resp.JSON200 == nil {
+		return 0, fmt.Errorf("unexpected status of analyst estimates: %s", resp.Status())
+	}
+	return s.Add(time.Now(), *resp.JSON200...), nil
+}
+
+// History returns the snapshots of a fiscal period, oldest first.
+func (s *EstimateStore) History(symbol string, date time.Time) []EstimateSnapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]EstimateSnapshot(nil), s.snapshots[estimateKey{symbol: symbol, date: date}]...)
+}
+
+// AsOf returns the consensus of every fiscal period of a company as seen at t, sorted by date.
+func (s *EstimateStore) AsOf(symbol string, t time.Time) []FinancialEstimates {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var estimates []FinancialEstimates
+	for key, snapshots := range s.snapshots {
+		if key.symbol != symbol {
+			continue
+		}
+		i := sort.Search(len(snapshots), func(i int) bool {
+			return snapshots[i].Taken.After(t)
+		})
+		if i > 0 {
+			estimates = append(estimates, snapshots[i-1].Estimate)
+		}
+	}
+	sort.Slice(estimates, func(i, j int) bool {
+		return estimates[i].Date.Before(estimates[j].Date.Time)
+	})
+	return estimates
+}
+
+// Revisions returns the changes of a consensus field, e.g. "epsAvg", across the snapshots of
+// every fiscal period of a company, sorted by fiscal period then time.
+func (s *EstimateStore) Revisions(symbol, field string) ([]EstimateRevision, error) {
+	index := numericFieldIndex(reflect.TypeOf(FinancialEstimates{}), field)
+	if index == nil {
+		return nil, fmt.Errorf("not supported estimate field: %s", field)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var revisions []EstimateRevision
+	for key, snapshots := range s.snapshots {
+		if key.symbol != symbol {
+			continue
+		}
+		for i := 1; i < len(snapshots); i++ {
+			previous := reflect.ValueOf(snapshots[i-1].Estimate).FieldByIndex(index).Float()
+			current := reflect.ValueOf(snapshots[i].Estimate).FieldByIndex(index).Float()
+			if previous == current {
+				continue
+			}
+			r := EstimateRevision{
+				Symbol:   symbol,
+				Date:     key.date,
+				Field:    field,
+				From:     snapshots[i-1].Taken,
+				To:       snapshots[i].Taken,
+				Previous: previous,
+				Current:  current,
+			}
+			if previous != 0 {
+				r.Change = (current - previous) / math.Abs(previous)
+			}
+			revisions = append(revisions, r)
+		}
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		if !revisions[i].Date.Equal(revisions[j].Date) {
+			return revisions[i].Date.Before(revisions[j].Date)
+		}
+		return revisions[i].To.Before(revisions[j].To)
+	})
+	return revisions, nil
+}
+
+// Save writes every snapshot as JSON lines.
+func (s *EstimateStore) Save(w io.Writer) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]estimateKey, 0, len(s.snapshots))
+	for key := range s.snapshots {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].symbol != keys[j].symbol {
+			return keys[i].symbol < keys[j].symbol
+		}
+		return keys[i].date.Before(keys[j].date)
+	})
+
+	enc := json.NewEncoder(w)
+	for _, key := range keys {
+		for i := range s.snapshots[key] {
+			if err := enc.Encode(&s.snapshots[key][i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Load reads snapshots written by Save and adds them to the store.
+func (s *EstimateStore) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var snapshot EstimateSnapshot
+		if err := json.Unmarshal(scanner.Bytes(), &snapshot); err != nil {
+			return err
+		}
+		s.Add(snapshot.Taken, snapshot.Estimate)
+	}
+	return scanner.Err()
+}
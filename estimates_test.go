@@ -0,0 +1,82 @@
+package financialmodelingprep
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type estimatesSuite struct {
+	suite.Suite
+}
+
+func (r *estimatesSuite) newStore() *EstimateStore {
+	s := NewEstimateStore()
+	fy2026 := FinancialEstimates{Symbol: "AAPL", Date: fixtureDate(2026, time.September, 27), EpsAvg: 8.0, RevenueAvg: 450e9, NumAnalystsEps: 30}
+	fy2027 := FinancialEstimates{Symbol: "AAPL", Date: fixtureDate(2027, time.September, 27), EpsAvg: 8.8, RevenueAvg: 480e9}
+
+	r.Equal(2, s.Add(time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC), fy2026, fy2027))
+	// Unchanged consensus is not stored again.
+	r.Equal(0, s.Add(time.Date(2025, time.October, 2, 0, 0, 0, 0, time.UTC), fy2026, fy2027))
+
+	fy2026.EpsAvg = 8.4
+	r.Equal(1, s.Add(time.Date(2025, time.November, 1, 0, 0, 0, 0, time.UTC), fy2026, fy2027))
+	fy2026.EpsAvg = 8.2
+	r.Equal(1, s.Add(time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC), fy2026))
+	return s
+}
+
+func (r *estimatesSuite) TestHistory() {
+	s := r.newStore()
+
+	history := s.History("AAPL", time.Date(2026, time.September, 27, 0, 0, 0, 0, time.UTC))
+	r.Len(history, 3)
+	r.InDelta(8.4, history[1].Estimate.EpsAvg, 1e-9)
+
+	consensus := s.AsOf("AAPL", time.Date(2025, time.November, 15, 0, 0, 0, 0, time.UTC))
+	r.Len(consensus, 2)
+	r.InDelta(8.4, consensus[0].EpsAvg, 1e-9)
+	r.InDelta(8.8, consensus[1].EpsAvg, 1e-9)
+
+	r.Empty(s.AsOf("AAPL", time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func (r *estimatesSuite) TestRevisions() {
+	s := r.newStore()
+
+	revisions, err := s.Revisions("AAPL", "epsAvg")
+	r.NoError(err)
+	r.Len(revisions, 2)
+	r.InDelta(0.05, revisions[0].Change, 1e-9)
+	r.InDelta(8.2, revisions[1].Current, 1e-9)
+	r.Equal(time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC), revisions[1].To)
+
+	revisions, err = s.Revisions("AAPL", "RevenueAvg")
+	r.NoError(err)
+	r.Empty(revisions)
+
+	_, err = s.Revisions("AAPL", "unknown")
+	r.Error(err)
+}
+
+func (r *estimatesSuite) TestSaveLoad() {
+	s := r.newStore()
+
+	var buf bytes.Buffer
+	r.NoError(s.Save(&buf))
+
+	loaded := NewEstimateStore()
+	r.NoError(loaded.Load(&buf))
+	date := time.Date(2026, time.September, 27, 0, 0, 0, 0, time.UTC)
+	r.Equal(len(s.History("AAPL", date)), len(loaded.History("AAPL", date)))
+
+	revisions, err := loaded.Revisions("AAPL", "epsAvg")
+	r.NoError(err)
+	r.Len(revisions, 2)
+}
+
+func TestEstimatesSuite(t *testing.T) {
+	suite.Run(t, new(estimatesSuite))
+}
@@ -0,0 +1,440 @@
+// Package etf looks through the holdings of exchange traded funds to the exposure of a portfolio
+// to the underlying stocks, sectors and countries, and screens funds by their profiles.
+package etf
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+// Unknown is the exposure bucket of the value that cannot be attributed, such as cash, the
+// holdings left out by the data, and companies without a sector or country.
+const Unknown = "Unknown"
+
+// DefaultMaxDepth is the default nesting of funds looked through.
+const DefaultMaxDepth = 3
+
+// Position is a holding of a portfolio by market value.
+type Position struct {
+	Symbol string
+	Value  float64
+}
+
+// Fund holds the data of an ETF.
+type Fund struct {
+	Symbol    string
+	Holdings  []fmp.ETFHolding
+	Sectors   []fmp.ETFSectorWeight
+	Countries []fmp.ETFCountryWeight
+}
+
+// Company holds the classification of a stock.
+type Company struct {
+	Symbol  string
+	Sector  string
+	Country string
+}
+
+// Universe holds the data looked through, by symbol. Symbols of Funds are ETFs, any other symbol
+// a stock.
+type Universe struct {
+	Funds     map[string]*Fund
+	Companies map[string]*Company
+}
+
+// NewUniverse returns an empty universe.
+func NewUniverse() *Universe {
+	return &Universe{Funds: map[string]*Fund{}, Companies: map[string]*Company{}}
+}
+
+// Weight is the exposure to a stock, sector or country.
+type Weight struct {
+	Name  string
+	Value float64
+
+	// Weight is the fraction of the portfolio value.
+	Weight float64
+}
+
+// Overlap compares the look-through holdings of two funds of the portfolio.
+type Overlap struct {
+	A, B string
+
+	// Common is the number of stocks held by both.
+	Common int
+
+	// Weight is the sum over the stocks of the smaller of their weights in either fund, from 0 for
+	// disjoint to 1 for identical funds.
+	Weight float64
+}
+
+// Exposure is the look-through exposure of a portfolio. Each dimension sums to Total, sorted by
+// value, largest first.
+type Exposure struct {
+	Total float64
+
+	Symbols   []Weight
+	Sectors   []Weight
+	Countries []Weight
+
+	// Overlaps compare every pair of funds held, largest overlap first.
+	Overlaps []Overlap
+}
+
+// Params defines parameters for LookThrough and GetExposure.
+type Params struct {
+	// MaxDepth is the nesting of funds looked through, DefaultMaxDepth when zero. Funds beyond it
+	// count as stocks.
+	MaxDepth int
+}
+
+func (p *Params) maxDepth() int {
+	if p == nil || p.MaxDepth <= 0 {
+		return DefaultMaxDepth
+	}
+	return p.MaxDepth
+}
+
+// weights are the fractions of a fund per name.
+type weights map[string]float64
+
+func (w weights) add(o weights, scale float64) {
+	for k, v := range o {
+		w[k] += v * scale
+	}
+}
+
+// expansion is the look-through weights of a fund.
+type expansion struct {
+	symbols, sectors, countries weights
+}
+
+// fundDepth keys the expansions, as a fund held deeper is cut off sooner.
+type fundDepth struct {
+	symbol string
+	depth  int
+}
+
+// lookThrough expands funds into weights, memoizing per fund and depth.
+type lookThrough struct {
+	u        *Universe
+	maxDepth int
+
+	expansions map[fundDepth]*expansion
+	visiting   map[string]bool
+}
+
+// parsePercentage parses weights such as "97.29%" or "97.29" into a fraction.
+func parsePercentage(s string) (float64, error) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "%")), 64)
+	if err != nil {
+		return 0, err
+	}
+	return v / 100, nil
+}
+
+// expand computes the look-through weights of a fund: its stocks, recursing into the funds it
+// holds, and its sectors and countries, where the weightings of the fund apply to the part not
+// held through other funds. It reports whether a cycle was cut, as such expansions depend on the
+// path to the fund and are not memoized.
+func (l *lookThrough) expand(symbol string, depth int) (*expansion, bool, error) {
+	key := fundDepth{symbol, depth}
+	if x, ok := l.expansions[key]; ok {
+		return x, false, nil
+	}
+	f := l.u.Funds[symbol]
+	l.visiting[symbol] = true
+	defer delete(l.visiting, symbol)
+
+	symbols, sectors, countries := weights{}, weights{}, weights{}
+	nested, held := 0.0, 0.0
+	cut := false
+	for _, h := range f.Holdings {
+		w := h.WeightPercentage / 100
+		held += w
+		asset := strings.ToUpper(strings.TrimSpace(h.Asset))
+		if _, ok := l.u.Funds[asset]; ok && depth < l.maxDepth {
+			if l.visiting[asset] {
+				cut = true
+			} else {
+				x, cyclic, err := l.expand(asset, depth+1)
+				if err != nil {
+					return nil, false, err
+				}
+				cut = cut || cyclic
+				symbols.add(x.symbols, w)
+				sectors.add(x.sectors, w)
+				countries.add(x.countries, w)
+				nested += w
+				continue
+			}
+		}
+		if asset == "" {
+			asset = Unknown
+		}
+		symbols[asset] += w
+	}
+	if held < 1 {
+		symbols[Unknown] += 1 - held
+	}
+
+	// The weightings cover the whole fund, so rescale them to the part held directly.
+	direct := 1 - nested
+	var sectorSum float64
+	for _, s := range f.Sectors {
+		w := s.WeightPercentage / 100 * direct
+		sectors[s.Sector] += w
+		sectorSum += w
+	}
+	if sectorSum < direct {
+		sectors[Unknown] += direct - sectorSum
+	}
+	var countrySum float64
+	for _, c := range f.Countries {
+		w, err := parsePercentage(c.WeightPercentage)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid country weight of %s in %s: %w", c.Country, symbol, err)
+		}
+		w *= direct
+		countries[c.Country] += w
+		countrySum += w
+	}
+	if countrySum < direct {
+		countries[Unknown] += direct - countrySum
+	}
+
+	x := &expansion{symbols: symbols, sectors: sectors, countries: countries}
+	if !cut {
+		l.expansions[key] = x
+	}
+	return x, cut, nil
+}
+
+func sortedWeights(values weights, total float64) []Weight {
+	list := make([]Weight, 0, len(values))
+	for name, v := range values {
+		if v == 0 {
+			continue
+		}
+		w := Weight{Name: name, Value: v}
+		if total != 0 {
+			w.Weight = v / total
+		}
+		list = append(list, w)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Value != list[j].Value {
+			return list[i].Value > list[j].Value
+		}
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// LookThrough aggregates the exposure of the positions through the funds of the universe. The
+// params may be nil.
+func LookThrough(positions []Position, u *Universe, params *Params) (*Exposure, error) {
+	l := &lookThrough{
+		u:          u,
+		maxDepth:   params.maxDepth(),
+		expansions: map[fundDepth]*expansion{},
+		visiting:   map[string]bool{},
+	}
+
+	e := &Exposure{}
+	symbols, sectors, countries := weights{}, weights{}, weights{}
+	var funds []string
+	expanded := map[string]weights{}
+	for _, p := range positions {
+		symbol := strings.ToUpper(strings.TrimSpace(p.Symbol))
+		e.Total += p.Value
+		if _, ok := u.Funds[symbol]; ok {
+			x, _, err := l.expand(symbol, 1)
+			if err != nil {
+				return nil, err
+			}
+			symbols.add(x.symbols, p.Value)
+			sectors.add(x.sectors, p.Value)
+			countries.add(x.countries, p.Value)
+			expanded[symbol] = x.symbols
+			funds = append(funds, symbol)
+			continue
+		}
+		symbols[symbol] += p.Value
+		sector, country := Unknown, Unknown
+		if c, ok := u.Companies[symbol]; ok {
+			if c.Sector != "" {
+				sector = c.Sector
+			}
+			if c.Country != "" {
+				country = c.Country
+			}
+		}
+		sectors[sector] += p.Value
+		countries[country] += p.Value
+	}
+	e.Symbols = sortedWeights(symbols, e.Total)
+	e.Sectors = sortedWeights(sectors, e.Total)
+	e.Countries = sortedWeights(countries, e.Total)
+
+	funds = uniqueSorted(funds)
+	for i := range funds {
+		for j := i + 1; j < len(funds); j++ {
+			e.Overlaps = append(e.Overlaps, overlap(funds[i], funds[j], expanded[funds[i]], expanded[funds[j]]))
+		}
+	}
+	sort.SliceStable(e.Overlaps, func(i, j int) bool {
+		return e.Overlaps[i].Weight > e.Overlaps[j].Weight
+	})
+	return e, nil
+}
+
+func uniqueSorted(values []string) []string {
+	sort.Strings(values)
+	var unique []string
+	for i, v := range values {
+		if i == 0 || v != values[i-1] {
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
+
+func overlap(a, b string, wa, wb weights) Overlap {
+	o := Overlap{A: a, B: b}
+	for symbol, w := range wa {
+		if symbol == Unknown {
+			continue
+		}
+		if v, ok := wb[symbol]; ok {
+			o.Common++
+			o.Weight += min(w, v)
+		}
+	}
+	return o
+}
+
+// Fetch fills the universe with the data of the positions: the holdings and the sector and
+// country weightings of the funds, recursing into the funds they hold up to the maximum depth,
+// and the profiles of the stocks held directly. ETFs are told from stocks by ETFListGet. The
+// params may be nil.
+func Fetch(ctx context.Context, c *fmp.ClientWithResponses, positions []Position, params *Params) (*Universe, error) {
+	resp, err := c.ETFListGetWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of ETF list: %s", resp.Status())
+	}
+	etfs := map[string]bool{}
+	for _, s := range *resp.JSON200 {
+		if s.Symbol != nil {
+			etfs[*s.Symbol] = true
+		}
+	}
+
+	u := NewUniverse()
+	maxDepth := params.maxDepth()
+	// fetched is the shallowest depth each fund was fetched at, as one fetched deeper may still
+	// have to recurse further when held at a shallower depth too.
+	fetched := map[string]int{}
+	var fetchFund func(symbol string, depth int) error
+	fetchFund = func(symbol string, depth int) error {
+		if d, ok := fetched[symbol]; ok && d <= depth {
+			return nil
+		}
+		fetched[symbol] = depth
+		f, ok := u.Funds[symbol]
+		if !ok {
+			var err error
+			if f, err = GetFund(ctx, c, symbol); err != nil {
+				return err
+			}
+			u.Funds[symbol] = f
+		}
+		if depth >= maxDepth {
+			return nil
+		}
+		for _, h := range f.Holdings {
+			if asset := strings.ToUpper(strings.TrimSpace(h.Asset)); etfs[asset] {
+				if err := fetchFund(asset, depth+1); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	for _, p := range positions {
+		symbol := strings.ToUpper(strings.TrimSpace(p.Symbol))
+		if etfs[symbol] {
+			if err := fetchFund(symbol, 1); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if _, ok := u.Companies[symbol]; ok {
+			continue
+		}
+		resp, err := c.ProfileGetWithResponse(ctx, &fmp.ProfileGetParams{Symbol: symbol})
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected status of profile: %s", resp.Status())
+		}
+		company := &Company{Symbol: symbol}
+		if profiles := *resp.JSON200; len(profiles) > 0 {
+			company.Sector, company.Country = profiles[0].Sector, profiles[0].Country
+		}
+		u.Companies[symbol] = company
+	}
+	return u, nil
+}
+
+// GetFund fetches the holdings and the sector and country weightings of an ETF.
+func GetFund(ctx context.Context, c *fmp.ClientWithResponses, symbol string) (*Fund, error) {
+	f := &Fund{Symbol: symbol}
+
+	holdings, err := c.ETFHoldingsGetWithResponse(ctx, &fmp.ETFHoldingsGetParams{Symbol: symbol})
+	if err != nil {
+		return nil, err
+	}
+	if holdings.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of ETF holdings: %s", holdings.Status())
+	}
+	f.Holdings = *holdings.JSON200
+
+	sectors, err := c.ETFSectorWeightingsGetWithResponse(ctx, &fmp.ETFSectorWeightingsGetParams{Symbol: symbol})
+	if err != nil {
+		return nil, err
+	}
+	if sectors.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of ETF sector weightings: %s", sectors.Status())
+	}
+	f.Sectors = *sectors.JSON200
+
+	countries, err := c.ETFCountryWeightingsGetWithResponse(ctx, &fmp.ETFCountryWeightingsGetParams{Symbol: symbol})
+	if err != nil {
+		return nil, err
+	}
+	if countries.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of ETF country weightings: %s", countries.Status())
+	}
+	f.Countries = *countries.JSON200
+	return f, nil
+}
+
+// GetExposure fetches the data of the positions and looks through them. The params may be nil.
+func GetExposure(ctx context.Context, c *fmp.ClientWithResponses, positions []Position, params *Params) (*Exposure, error) {
+	u, err := Fetch(ctx, c, positions, params)
+	if err != nil {
+		return nil, err
+	}
+	return LookThrough(positions, u, params)
+}
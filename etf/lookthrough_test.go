@@ -0,0 +1,137 @@
+package etf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+type lookThroughSuite struct {
+	suite.Suite
+	u *Universe
+}
+
+func holding(fund, asset string, weight float64) fmp.ETFHolding {
+	return fmp.ETFHolding{Symbol: fund, Asset: asset, WeightPercentage: weight}
+}
+
+func weightOf(weights []Weight, name string) float64 {
+	for _, w := range weights {
+		if w.Name == name {
+			return w.Value
+		}
+	}
+	return 0
+}
+
+func (r *lookThroughSuite) SetupTest() {
+	u := NewUniverse()
+	u.Funds["FUNDA"] = &Fund{
+		Symbol:    "FUNDA",
+		Holdings:  []fmp.ETFHolding{holding("FUNDA", "AAPL", 50), holding("FUNDA", "MSFT", 30), holding("FUNDA", "FUNDB", 20)},
+		Sectors:   []fmp.ETFSectorWeight{{Symbol: "FUNDA", Sector: "Technology", WeightPercentage: 100}},
+		Countries: []fmp.ETFCountryWeight{{Country: "United States", WeightPercentage: "100%"}},
+	}
+	// FUNDB leaves a fifth of its weight out.
+	u.Funds["FUNDB"] = &Fund{
+		Symbol:   "FUNDB",
+		Holdings: []fmp.ETFHolding{holding("FUNDB", "AAPL", 40), holding("FUNDB", "XOM", 40)},
+		Sectors: []fmp.ETFSectorWeight{
+			{Symbol: "FUNDB", Sector: "Technology", WeightPercentage: 40},
+			{Symbol: "FUNDB", Sector: "Energy", WeightPercentage: 40},
+		},
+		Countries: []fmp.ETFCountryWeight{{Country: "United States", WeightPercentage: "80.00%"}},
+	}
+	u.Companies["JPM"] = &Company{Symbol: "JPM", Sector: "Financial Services", Country: "US"}
+	r.u = u
+}
+
+func (r *lookThroughSuite) TestLookThrough() {
+	positions := []Position{{Symbol: "FUNDA", Value: 1000}, {Symbol: "fundb", Value: 500}, {Symbol: "JPM", Value: 500}}
+	e, err := LookThrough(positions, r.u, nil)
+	r.Require().NoError(err)
+	r.Equal(2000.0, e.Total)
+
+	r.Equal("AAPL", e.Symbols[0].Name)
+	r.InDelta(780, e.Symbols[0].Value, 1e-9)
+	r.InDelta(0.39, e.Symbols[0].Weight, 1e-9)
+	r.InDelta(300, weightOf(e.Symbols, "MSFT"), 1e-9)
+	r.InDelta(280, weightOf(e.Symbols, "XOM"), 1e-9)
+	r.InDelta(500, weightOf(e.Symbols, "JPM"), 1e-9)
+	r.InDelta(140, weightOf(e.Symbols, Unknown), 1e-9)
+	r.Zero(weightOf(e.Symbols, "FUNDB"))
+
+	r.InDelta(1080, weightOf(e.Sectors, "Technology"), 1e-9)
+	r.InDelta(280, weightOf(e.Sectors, "Energy"), 1e-9)
+	r.InDelta(500, weightOf(e.Sectors, "Financial Services"), 1e-9)
+	r.InDelta(140, weightOf(e.Sectors, Unknown), 1e-9)
+
+	r.InDelta(1360, weightOf(e.Countries, "United States"), 1e-9)
+	r.InDelta(500, weightOf(e.Countries, "US"), 1e-9)
+	r.InDelta(140, weightOf(e.Countries, Unknown), 1e-9)
+
+	for _, dimension := range [][]Weight{e.Symbols, e.Sectors, e.Countries} {
+		var sum float64
+		for _, w := range dimension {
+			sum += w.Value
+		}
+		r.InDelta(e.Total, sum, 1e-9)
+	}
+
+	r.Require().Len(e.Overlaps, 1)
+	r.Equal("FUNDA", e.Overlaps[0].A)
+	r.Equal("FUNDB", e.Overlaps[0].B)
+	r.Equal(2, e.Overlaps[0].Common)
+	r.InDelta(0.48, e.Overlaps[0].Weight, 1e-9)
+}
+
+func (r *lookThroughSuite) TestMaxDepth() {
+	e, err := LookThrough([]Position{{Symbol: "FUNDA", Value: 100}}, r.u, &Params{MaxDepth: 1})
+	r.Require().NoError(err)
+	r.InDelta(20, weightOf(e.Symbols, "FUNDB"), 1e-9)
+	r.InDelta(100, weightOf(e.Sectors, "Technology"), 1e-9)
+}
+
+func (r *lookThroughSuite) TestCycle() {
+	u := NewUniverse()
+	u.Funds["FUNDC"] = &Fund{Symbol: "FUNDC", Holdings: []fmp.ETFHolding{holding("FUNDC", "FUNDD", 50), holding("FUNDC", "X", 50)}}
+	u.Funds["FUNDD"] = &Fund{Symbol: "FUNDD", Holdings: []fmp.ETFHolding{holding("FUNDD", "FUNDC", 50), holding("FUNDD", "Y", 50)}}
+
+	e, err := LookThrough([]Position{{Symbol: "FUNDC", Value: 100}}, u, nil)
+	r.Require().NoError(err)
+	r.InDelta(50, weightOf(e.Symbols, "X"), 1e-9)
+	r.InDelta(25, weightOf(e.Symbols, "Y"), 1e-9)
+	r.InDelta(25, weightOf(e.Symbols, "FUNDC"), 1e-9)
+	r.InDelta(100, weightOf(e.Sectors, Unknown), 1e-9)
+}
+
+func (r *lookThroughSuite) TestDepthOrder() {
+	u := NewUniverse()
+	u.Funds["FUNDA"] = &Fund{Symbol: "FUNDA", Holdings: []fmp.ETFHolding{holding("FUNDA", "FUNDB", 50), holding("FUNDA", "AAPL", 50)}}
+	u.Funds["FUNDB"] = &Fund{Symbol: "FUNDB", Holdings: []fmp.ETFHolding{holding("FUNDB", "FUNDC", 50), holding("FUNDB", "MSFT", 50)}}
+	u.Funds["FUNDC"] = &Fund{Symbol: "FUNDC", Holdings: []fmp.ETFHolding{holding("FUNDC", "XOM", 100)}}
+
+	// FUNDB is cut off at FUNDC within FUNDA but not when held directly, whatever the order.
+	for _, positions := range [][]Position{
+		{{Symbol: "FUNDA", Value: 100}, {Symbol: "FUNDB", Value: 100}},
+		{{Symbol: "FUNDB", Value: 100}, {Symbol: "FUNDA", Value: 100}},
+	} {
+		e, err := LookThrough(positions, u, &Params{MaxDepth: 2})
+		r.Require().NoError(err)
+		r.InDelta(50, weightOf(e.Symbols, "AAPL"), 1e-9)
+		r.InDelta(75, weightOf(e.Symbols, "MSFT"), 1e-9)
+		r.InDelta(25, weightOf(e.Symbols, "FUNDC"), 1e-9)
+		r.InDelta(50, weightOf(e.Symbols, "XOM"), 1e-9)
+	}
+}
+
+func (r *lookThroughSuite) TestInvalidCountryWeight() {
+	r.u.Funds["FUNDB"].Countries[0].WeightPercentage = "n/a"
+	_, err := LookThrough([]Position{{Symbol: "FUNDA", Value: 100}}, r.u, nil)
+	r.Error(err)
+}
+
+func TestLookThroughSuite(t *testing.T) {
+	suite.Run(t, new(lookThroughSuite))
+}
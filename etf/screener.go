@@ -0,0 +1,355 @@
+package etf
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+// DefaultInterval is the default minimum interval between the requests of a Screener.
+const DefaultInterval = 200 * time.Millisecond
+
+// ProfileCache caches the profiles of ETFs by symbol.
+type ProfileCache struct {
+	mu       sync.RWMutex
+	profiles map[string]cachedProfile
+}
+
+type cachedProfile struct {
+	Fetched time.Time      `json:"fetched"`
+	Profile fmp.ETFProfile `json:"profile"`
+}
+
+// NewProfileCache returns an empty cache.
+func NewProfileCache() *ProfileCache {
+	return &ProfileCache{profiles: map[string]cachedProfile{}}
+}
+
+// Put caches a profile fetched at the given time, replacing an older one.
+func (c *ProfileCache) Put(fetched time.Time, p *fmp.ETFProfile) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	symbol := strings.ToUpper(p.Symbol)
+	if old, ok := c.profiles[symbol]; ok && old.Fetched.After(fetched) {
+		return
+	}
+	c.profiles[symbol] = cachedProfile{Fetched: fetched, Profile: *p}
+}
+
+// Get returns the cached profile of a symbol and when it was fetched.
+func (c *ProfileCache) Get(symbol string) (*fmp.ETFProfile, time.Time, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	cached, ok := c.profiles[strings.ToUpper(symbol)]
+	if !ok {
+		return nil, time.Time{}, false
+	}
+	return &cached.Profile, cached.Fetched, true
+}
+
+// Len returns the number of cached profiles.
+func (c *ProfileCache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.profiles)
+}
+
+// Save writes the cached profiles as JSON lines, sorted by symbol.
+func (c *ProfileCache) Save(w io.Writer) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	symbols := make([]string, 0, len(c.profiles))
+	for symbol := range c.profiles {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	enc := json.NewEncoder(w)
+	for _, symbol := range symbols {
+		cached := c.profiles[symbol]
+		if err := enc.Encode(&cached); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load reads profiles written by Save into the cache.
+func (c *ProfileCache) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var cached cachedProfile
+		if err := json.Unmarshal(scanner.Bytes(), &cached); err != nil {
+			return err
+		}
+		c.Put(cached.Fetched, &cached.Profile)
+	}
+	return scanner.Err()
+}
+
+// ScreenerConfig defines the configuration of a Screener.
+type ScreenerConfig struct {
+	// Cache is a new ProfileCache when nil.
+	Cache *ProfileCache
+
+	// TTL is how long cached profiles are used before they are fetched again. Zero keeps them
+	// forever.
+	TTL time.Duration
+
+	// Interval is the minimum interval between requests, DefaultInterval when zero. A negative
+	// interval disables the rate limiting.
+	Interval time.Duration
+}
+
+// Screener searches across the profiles of ETFs, which it loads one request per fund, rate
+// limited and cached.
+type Screener struct {
+	c       *fmp.ClientWithResponses
+	cache   *ProfileCache
+	ttl     time.Duration
+	limiter *fmp.RateLimiter
+}
+
+// NewScreener returns a screener using the client. The config may be nil.
+func NewScreener(c *fmp.ClientWithResponses, cfg *ScreenerConfig) *Screener {
+	var conf ScreenerConfig
+	if cfg != nil {
+		conf = *cfg
+	}
+	if conf.Cache == nil {
+		conf.Cache = NewProfileCache()
+	}
+	switch {
+	case conf.Interval == 0:
+		conf.Interval = DefaultInterval
+	case conf.Interval < 0:
+		conf.Interval = 0
+	}
+	return &Screener{c: c, cache: conf.Cache, ttl: conf.TTL, limiter: fmp.NewRateLimiter(conf.Interval)}
+}
+
+// Cache returns the profile cache of the screener.
+func (s *Screener) Cache() *ProfileCache {
+	return s.cache
+}
+
+// Symbols fetches the symbols of all the ETFs of ETFListGet, sorted.
+func (s *Screener) Symbols(ctx context.Context) ([]string, error) {
+	if err := s.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	resp, err := s.c.ETFListGetWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of ETF list: %s", resp.Status())
+	}
+	var symbols []string
+	for _, e := range *resp.JSON200 {
+		if e.Symbol != nil && *e.Symbol != "" {
+			symbols = append(symbols, *e.Symbol)
+		}
+	}
+	return uniqueSorted(symbols), nil
+}
+
+// Profile returns the profile of an ETF, from the cache unless it is missing or older than the
+// TTL. It returns nil for funds without a profile.
+func (s *Screener) Profile(ctx context.Context, symbol string) (*fmp.ETFProfile, error) {
+	if p, fetched, ok := s.cache.Get(symbol); ok && (s.ttl == 0 || time.Since(fetched) < s.ttl) {
+		return p, nil
+	}
+	if err := s.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	resp, err := s.c.ETFInfoGetWithResponse(ctx, &fmp.ETFInfoGetParams{Symbol: symbol})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of ETF info: %s", resp.Status())
+	}
+	if len(*resp.JSON200) == 0 {
+		return nil, nil
+	}
+	p := &(*resp.JSON200)[0]
+	if p.Symbol == "" {
+		p.Symbol = symbol
+	}
+	s.cache.Put(time.Now(), p)
+	return p, nil
+}
+
+// Load returns the profiles of the symbols, or of all the ETFs of ETFListGet when symbols is
+// empty. Funds without a profile are skipped.
+func (s *Screener) Load(ctx context.Context, symbols []string) ([]fmp.ETFProfile, error) {
+	if len(symbols) == 0 {
+		var err error
+		if symbols, err = s.Symbols(ctx); err != nil {
+			return nil, err
+		}
+	}
+	var profiles []fmp.ETFProfile
+	for _, symbol := range symbols {
+		p, err := s.Profile(ctx, symbol)
+		if err != nil {
+			return nil, err
+		}
+		if p != nil {
+			profiles = append(profiles, *p)
+		}
+	}
+	return profiles, nil
+}
+
+// Screen loads the profiles of the symbols, or of all the ETFs when empty, and returns those
+// passing the criteria, which may be nil, largest assets under management first.
+func (s *Screener) Screen(ctx context.Context, symbols []string, criteria *Criteria) ([]fmp.ETFProfile, error) {
+	profiles, err := s.Load(ctx, symbols)
+	if err != nil {
+		return nil, err
+	}
+	return Screen(profiles, criteria), nil
+}
+
+// Holdings fetches the holdings of an ETF, rate limited.
+func (s *Screener) Holdings(ctx context.Context, symbol string) ([]fmp.ETFHolding, error) {
+	if err := s.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	resp, err := s.c.ETFHoldingsGetWithResponse(ctx, &fmp.ETFHoldingsGetParams{Symbol: symbol})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of ETF holdings: %s", resp.Status())
+	}
+	return *resp.JSON200, nil
+}
+
+// Compare fetches the holdings of a shortlist of ETFs and returns their HoldingsOverlap.
+func (s *Screener) Compare(ctx context.Context, symbols []string) ([]Overlap, error) {
+	funds := make([]*Fund, len(symbols))
+	for i, symbol := range symbols {
+		holdings, err := s.Holdings(ctx, symbol)
+		if err != nil {
+			return nil, err
+		}
+		funds[i] = &Fund{Symbol: symbol, Holdings: holdings}
+	}
+	return HoldingsOverlap(funds), nil
+}
+
+// Criteria selects ETFs by their profile. Empty fields match everything.
+type Criteria struct {
+	// AssetClasses and Issuers, the EtfCompany of the profile, are matched case-insensitively.
+	AssetClasses []string
+	Issuers      []string
+
+	// ExpenseRatio is in percent, as in the profile.
+	ExpenseRatio fmp.Range
+
+	AUM fmp.Range
+
+	// Sectors bounds the exposure to sectors of SectorsList, by name matched case-insensitively,
+	// in percent. Funds without a sector count as having no exposure to it.
+	Sectors map[string]fmp.Range
+
+	// ActiveOnly skips funds not actively trading.
+	ActiveOnly bool
+}
+
+// SectorExposure returns the exposure of the profile to a sector, in percent.
+func SectorExposure(p *fmp.ETFProfile, sector string) float64 {
+	var exposure float64
+	for _, s := range p.SectorsList {
+		if strings.EqualFold(strings.TrimSpace(s.Industry), strings.TrimSpace(sector)) {
+			exposure += float64(s.Exposure)
+		}
+	}
+	return exposure
+}
+
+func containsFold(values []string, v string) bool {
+	for _, s := range values {
+		if strings.EqualFold(strings.TrimSpace(s), strings.TrimSpace(v)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Match reports whether a profile passes the criteria.
+func (c *Criteria) Match(p *fmp.ETFProfile) bool {
+	if c.ActiveOnly && !p.IsActivelyTrading {
+		return false
+	}
+	if len(c.AssetClasses) > 0 && !containsFold(c.AssetClasses, p.AssetClass) {
+		return false
+	}
+	if len(c.Issuers) > 0 && !containsFold(c.Issuers, p.EtfCompany) {
+		return false
+	}
+	if !c.ExpenseRatio.Contains(float64(p.ExpenseRatio)) || !c.AUM.Contains(p.AssetsUnderManagement) {
+		return false
+	}
+	for sector, r := range c.Sectors {
+		if !r.Contains(SectorExposure(p, sector)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Screen returns the profiles passing the criteria, which may be nil, largest assets under
+// management first.
+func Screen(profiles []fmp.ETFProfile, criteria *Criteria) []fmp.ETFProfile {
+	if criteria == nil {
+		criteria = &Criteria{}
+	}
+	var matched []fmp.ETFProfile
+	for i := range profiles {
+		if criteria.Match(&profiles[i]) {
+			matched = append(matched, profiles[i])
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].AssetsUnderManagement > matched[j].AssetsUnderManagement
+	})
+	return matched
+}
+
+// HoldingsOverlap compares the direct holdings of every pair of funds, largest overlap first.
+// Unlike the overlaps of LookThrough, holdings of nested funds are not expanded.
+func HoldingsOverlap(funds []*Fund) []Overlap {
+	holdings := make([]weights, len(funds))
+	for i, f := range funds {
+		holdings[i] = weights{}
+		for _, h := range f.Holdings {
+			if asset := strings.ToUpper(strings.TrimSpace(h.Asset)); asset != "" {
+				holdings[i][asset] += h.WeightPercentage / 100
+			}
+		}
+	}
+	var overlaps []Overlap
+	for i := range funds {
+		for j := i + 1; j < len(funds); j++ {
+			overlaps = append(overlaps, overlap(funds[i].Symbol, funds[j].Symbol, holdings[i], holdings[j]))
+		}
+	}
+	sort.SliceStable(overlaps, func(i, j int) bool {
+		return overlaps[i].Weight > overlaps[j].Weight
+	})
+	return overlaps
+}
@@ -0,0 +1,167 @@
+package etf
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+type screenerSuite struct {
+	suite.Suite
+}
+
+func float64Ptr(v float64) *float64 {
+	return &v
+}
+
+func profile(symbol, assetClass, issuer string, expenseRatio float32, aum float64, sectors map[string]float32) fmp.ETFProfile {
+	p := fmp.ETFProfile{
+		Symbol:                symbol,
+		AssetClass:            assetClass,
+		EtfCompany:            issuer,
+		ExpenseRatio:          expenseRatio,
+		AssetsUnderManagement: aum,
+		IsActivelyTrading:     true,
+	}
+	for industry, exposure := range sectors {
+		p.SectorsList = append(p.SectorsList, struct {
+			Exposure float32 `json:"exposure"`
+			Industry string  `json:"industry"`
+		}{Exposure: exposure, Industry: industry})
+	}
+	return p
+}
+
+func profiles() []fmp.ETFProfile {
+	return []fmp.ETFProfile{
+		profile("SPY", "Equity", "SPDR", 0.0945, 600e9, map[string]float32{"Technology": 31, "Energy": 3}),
+		profile("QQQ", "Equity", "Invesco", 0.2, 300e9, map[string]float32{"Technology": 50}),
+		profile("XLE", "Equity", "SPDR", 0.09, 35e9, map[string]float32{"Energy": 99}),
+		profile("AGG", "Fixed Income", "iShares", 0.03, 120e9, nil),
+	}
+}
+
+func (r *screenerSuite) TestScreen() {
+	all := Screen(profiles(), nil)
+	r.Len(all, 4)
+	r.Equal("SPY", all[0].Symbol)
+	r.Equal("XLE", all[3].Symbol)
+
+	matched := Screen(profiles(), &Criteria{AssetClasses: []string{"equity"}, Issuers: []string{"spdr"}})
+	r.Len(matched, 2)
+
+	matched = Screen(profiles(), &Criteria{ExpenseRatio: fmp.Range{Max: float64Ptr(0.1)}, AUM: fmp.Range{Min: float64Ptr(100e9)}})
+	r.Len(matched, 2)
+	r.Equal("SPY", matched[0].Symbol)
+	r.Equal("AGG", matched[1].Symbol)
+
+	matched = Screen(profiles(), &Criteria{Sectors: map[string]fmp.Range{"technology": {Min: float64Ptr(40)}}})
+	r.Len(matched, 1)
+	r.Equal("QQQ", matched[0].Symbol)
+
+	matched = Screen(profiles(), &Criteria{AssetClasses: []string{"Equity"}, Sectors: map[string]fmp.Range{"Energy": {Max: float64Ptr(5)}}})
+	r.Len(matched, 2)
+
+	inactive := profiles()
+	inactive[0].IsActivelyTrading = false
+	r.Len(Screen(inactive, &Criteria{ActiveOnly: true}), 3)
+}
+
+func (r *screenerSuite) TestHoldingsOverlap() {
+	funds := []*Fund{
+		{Symbol: "A", Holdings: []fmp.ETFHolding{holding("A", "AAPL", 60), holding("A", "MSFT", 40)}},
+		{Symbol: "B", Holdings: []fmp.ETFHolding{holding("B", "AAPL", 30), holding("B", "MSFT", 30), holding("B", "XOM", 40)}},
+		{Symbol: "C", Holdings: []fmp.ETFHolding{holding("C", "XOM", 100)}},
+	}
+	overlaps := HoldingsOverlap(funds)
+	r.Require().Len(overlaps, 3)
+	r.Equal("A", overlaps[0].A)
+	r.Equal("B", overlaps[0].B)
+	r.Equal(2, overlaps[0].Common)
+	r.InDelta(0.6, overlaps[0].Weight, 1e-9)
+	r.Equal("B", overlaps[1].A)
+	r.Equal("C", overlaps[1].B)
+	r.InDelta(0.4, overlaps[1].Weight, 1e-9)
+	r.Zero(overlaps[2].Common)
+}
+
+func (r *screenerSuite) TestCacheSaveLoad() {
+	cache := NewProfileCache()
+	fetched := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	for _, p := range profiles() {
+		cache.Put(fetched, &p)
+	}
+	// Older profiles do not replace newer ones.
+	stale := profile("SPY", "Equity", "SPDR", 1, 0, nil)
+	cache.Put(fetched.Add(-time.Hour), &stale)
+
+	var buf bytes.Buffer
+	r.Require().NoError(cache.Save(&buf))
+
+	loaded := NewProfileCache()
+	r.Require().NoError(loaded.Load(&buf))
+	r.Equal(4, loaded.Len())
+	p, at, ok := loaded.Get("spy")
+	r.Require().True(ok)
+	r.True(at.Equal(fetched))
+	r.InDelta(0.0945, p.ExpenseRatio, 1e-6)
+	r.Len(p.SectorsList, 2)
+}
+
+func (r *screenerSuite) TestScreener() {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/etf-list":
+			_ = json.NewEncoder(w).Encode([]map[string]string{{"symbol": "SPY"}, {"symbol": "QQQ"}, {"symbol": "XLE"}})
+		case "/etf/info":
+			symbol := req.URL.Query().Get("symbol")
+			for _, p := range profiles() {
+				if p.Symbol == symbol {
+					_ = json.NewEncoder(w).Encode([]fmp.ETFProfile{p})
+					return
+				}
+			}
+			_, _ = w.Write([]byte("[]"))
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+	defer server.Close()
+
+	c, err := fmp.NewClientWithResponses(server.URL)
+	r.Require().NoError(err)
+	s := NewScreener(c, &ScreenerConfig{Interval: 20 * time.Millisecond})
+
+	start := time.Now()
+	matched, err := s.Screen(context.Background(), nil, &Criteria{Issuers: []string{"SPDR"}})
+	r.Require().NoError(err)
+	r.Len(matched, 2)
+	r.Equal(int32(4), requests.Load())
+	r.GreaterOrEqual(time.Since(start), 60*time.Millisecond)
+
+	// Profiles are served from the cache.
+	_, err = s.Load(context.Background(), []string{"SPY", "QQQ", "XLE"})
+	r.Require().NoError(err)
+	r.Equal(int32(4), requests.Load())
+	r.Equal(3, s.Cache().Len())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = s.Profile(ctx, "AGG")
+	r.ErrorIs(err, context.Canceled)
+}
+
+func TestScreenerSuite(t *testing.T) {
+	suite.Run(t, new(screenerSuite))
+}
@@ -0,0 +1,259 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// EventKind is the kind of a corporate Event.
+type EventKind string
+
+const (
+	EventEarnings EventKind = "earnings"
+	EventDividend EventKind = "dividend"
+	EventSplit    EventKind = "split"
+)
+
+// Event is a corporate event of the earnings, dividends or splits calendar. Exactly one of
+// Earnings, Dividend and Split is set, according to Kind.
+type Event struct {
+	Kind   EventKind `json:"kind"`
+	Symbol string    `json:"symbol"`
+
+	// Date is the announcement date for earnings, the ex-dividend date for dividends and the
+	// effective date for splits.
+	Date openapi_types.Date `json:"date"`
+
+	Earnings *EarningEvent  `json:"earnings,omitempty"`
+	Dividend *DividendEvent `json:"dividend,omitempty"`
+	Split    *SplitEvent    `json:"split,omitempty"`
+}
+
+// NewEarningsEvents converts earnings calendar rows to events.
+func NewEarningsEvents(earnings []EarningEvent) []Event {
+	events := make([]Event, len(earnings))
+	for i := range earnings {
+		events[i] = Event{Kind: EventEarnings, Symbol: earnings[i].Symbol, Date: earnings[i].Date, Earnings: &earnings[i]}
+	}
+	return events
+}
+
+// NewDividendEvents converts dividends calendar rows to events.
+func NewDividendEvents(dividends []DividendEvent) []Event {
+	events := make([]Event, len(dividends))
+	for i := range dividends {
+		events[i] = Event{Kind: EventDividend, Symbol: dividends[i].Symbol, Date: dividends[i].Date, Dividend: &dividends[i]}
+	}
+	return events
+}
+
+// NewSplitEvents converts splits calendar rows to events.
+func NewSplitEvents(splits []SplitEvent) []Event {
+	events := make([]Event, len(splits))
+	for i := range splits {
+		events[i] = Event{Kind: EventSplit, Symbol: splits[i].Symbol, Date: splits[i].Date, Split: &splits[i]}
+	}
+	return events
+}
+
+// MergeEvents merges event lists into one, sorted by date, then kind and symbol.
+func MergeEvents(lists ...[]Event) []Event {
+	var events []Event
+	for _, l := range lists {
+		events = append(events, l...)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := &events[i], &events[j]
+		if !a.Date.Equal(b.Date.Time) {
+			return a.Date.Before(b.Date.Time)
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Symbol < b.Symbol
+	})
+	return events
+}
+
+// Title returns a one-line description of the event, e.g. "AAPL 4:1 split".
+func (e *Event) Title() string {
+	switch e.Kind {
+	case EventEarnings:
+		return e.Symbol + " earnings"
+	case EventDividend:
+		return fmt.Sprintf("%s ex-dividend %g", e.Symbol, e.Dividend.Dividend)
+	case EventSplit:
+		return fmt.Sprintf("%s %g:%g split", e.Symbol, e.Split.Numerator, e.Split.Denominator)
+	}
+	return e.Symbol + " " + string(e.Kind)
+}
+
+// Details returns the figures of the event, one per line.
+func (e *Event) Details() string {
+	var lines []string
+	switch e.Kind {
+	case EventEarnings:
+		ev := e.Earnings
+		lines = append(lines, fmt.Sprintf("EPS estimate: %g", ev.EpsEstimated))
+		if ev.EpsActual != 0 {
+			lines = append(lines, fmt.Sprintf("EPS actual: %g", ev.EpsActual))
+		}
+		lines = append(lines, fmt.Sprintf("Revenue estimate: %g", ev.RevenueEstimated))
+		if ev.RevenueActual != 0 {
+			lines = append(lines, fmt.Sprintf("Revenue actual: %g", ev.RevenueActual))
+		}
+	case EventDividend:
+		ev := e.Dividend
+		lines = append(lines, fmt.Sprintf("Dividend: %g (adjusted %g)", ev.Dividend, ev.AdjDividend))
+		if ev.Frequency != "" {
+			lines = append(lines, "Frequency: "+ev.Frequency)
+		}
+		if ev.RecordDate != "" {
+			lines = append(lines, "Record date: "+ev.RecordDate)
+		}
+		if ev.PaymentDate != "" {
+			lines = append(lines, "Payment date: "+ev.PaymentDate)
+		}
+		if ev.Yield != 0 {
+			lines = append(lines, fmt.Sprintf("Yield: %g%%", ev.Yield))
+		}
+	case EventSplit:
+		lines = append(lines, fmt.Sprintf("Ratio: %g for %g", e.Split.Numerator, e.Split.Denominator))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (e *Event) id() string {
+	return icalUID(string(e.Kind), e.Symbol, e.Date.Format(time.DateOnly))
+}
+
+// ICalEvent converts the event to an all-day calendar event.
+func (e *Event) ICalEvent() ICalEvent {
+	return ICalEvent{
+		UID:         e.id(),
+		Start:       e.Date.Time,
+		AllDay:      true,
+		Summary:     e.Title(),
+		Description: e.Details(),
+		Categories:  []string{string(e.Kind), e.Symbol},
+	}
+}
+
+// WriteEventsICalendar writes the events as an iCalendar (.ics) feed named name.
+func WriteEventsICalendar(w io.Writer, name string, events []Event) error {
+	ical := make([]ICalEvent, len(events))
+	for i := range events {
+		ical[i] = events[i].ICalEvent()
+	}
+	return WriteICalendar(w, name, ical)
+}
+
+// WriteEventsJSONFeed writes the events as a JSON Feed named title. Each item carries the event
+// under the "_event" extension.
+func WriteEventsJSONFeed(w io.Writer, title string, events []Event) error {
+	feed := &jsonFeed{Title: title}
+	for i := range events {
+		e := &events[i]
+		date := e.Date.Time
+		feed.Items = append(feed.Items, jsonFeedItem{
+			ID:            e.id(),
+			Title:         e.Title(),
+			ContentText:   e.Details(),
+			DatePublished: &date,
+			Tags:          []string{string(e.Kind), e.Symbol},
+			Extension:     map[string]any{"_event": e},
+		})
+	}
+	return writeJSONFeed(w, feed)
+}
+
+// EventFilter selects events. Empty fields match everything.
+type EventFilter struct {
+	// Symbols is a watchlist, matched case-insensitively.
+	Symbols []string
+
+	Kinds []EventKind
+}
+
+// Match reports whether an event passes the filter.
+func (f *EventFilter) Match(e *Event) bool {
+	if len(f.Symbols) > 0 && !containsFold(f.Symbols, e.Symbol) {
+		return false
+	}
+	return f.matchKind(e.Kind)
+}
+
+func (f *EventFilter) matchKind(kind EventKind) bool {
+	if len(f.Kinds) == 0 {
+		return true
+	}
+	for _, k := range f.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterEvents returns the events passing the filter, in order.
+func FilterEvents(events []Event, f *EventFilter) []Event {
+	var matched []Event
+	for i := range events {
+		if f.Match(&events[i]) {
+			matched = append(matched, events[i])
+		}
+	}
+	return matched
+}
+
+// eventsCalendarWindow is the longest range of days served by a single calendar call.
+const eventsCalendarWindow = 90
+
+// GetEvents fetches the earnings, dividends and splits calendars within [from, to] and merges the
+// events passing the filter, which may be nil. Calendars of kinds excluded by the filter are not
+// fetched.
+func GetEvents(ctx context.Context, c *ClientWithResponses, from, to time.Time, f *EventFilter) ([]Event, error) {
+	if f == nil {
+		f = &EventFilter{}
+	}
+	var lists [][]Event
+	for _, w := range dateWindows(from, to, eventsCalendarWindow) {
+		if f.matchKind(EventEarnings) {
+			resp, err := c.GetEarningsCalendarWithResponse(ctx, &GetEarningsCalendarParams{From: &w.From, To: &w.To})
+			if err != nil {
+				return nil, err
+			}
+			if resp.JSON200 == nil {
+				return nil, fmt.Errorf("unexpected status of earnings calendar: %s", resp.Status())
+			}
+			lists = append(lists, NewEarningsEvents(*resp.JSON200))
+		}
+		if f.matchKind(EventDividend) {
+			resp, err := c.DividendsCalendarGetWithResponse(ctx, &DividendsCalendarGetParams{From: &w.From, To: &w.To})
+			if err != nil {
+				return nil, err
+			}
+			if resp.JSON200 == nil {
+				return nil, fmt.Errorf("unexpected status of dividends calendar: %s", resp.Status())
+			}
+			lists = append(lists, NewDividendEvents(*resp.JSON200))
+		}
+		if f.matchKind(EventSplit) {
+			resp, err := c.GetSplitsCalendarWithResponse(ctx, &GetSplitsCalendarParams{From: &w.From, To: &w.To})
+			if err != nil {
+				return nil, err
+			}
+			if resp.JSON200 == nil {
+				return nil, fmt.Errorf("unexpected status of splits calendar: %s", resp.Status())
+			}
+			lists = append(lists, NewSplitEvents(*resp.JSON200))
+		}
+	}
+	return FilterEvents(MergeEvents(lists...), f), nil
+}
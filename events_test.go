@@ -0,0 +1,98 @@
+package financialmodelingprep
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type eventsSuite struct {
+	suite.Suite
+}
+
+func (r *eventsSuite) events() []Event {
+	earnings := []EarningEvent{
+		{Symbol: "AAPL", Date: fixtureDate(2024, time.October, 31), EpsEstimated: 1.6, RevenueEstimated: 94.5e9},
+		{Symbol: "MSFT", Date: fixtureDate(2024, time.October, 30), EpsEstimated: 3.1, EpsActual: 3.3},
+	}
+	dividends := []DividendEvent{
+		{Symbol: "AAPL", Date: fixtureDate(2024, time.November, 8), Dividend: 0.25, AdjDividend: 0.25, Frequency: "Quarterly", PaymentDate: "2024-11-14"},
+	}
+	splits := []SplitEvent{
+		{Symbol: "NVDA", Date: fixtureDate(2024, time.June, 10), Numerator: 10, Denominator: 1},
+	}
+	return MergeEvents(NewEarningsEvents(earnings), NewDividendEvents(dividends), NewSplitEvents(splits))
+}
+
+func (r *eventsSuite) TestMergeEvents() {
+	events := r.events()
+	r.Len(events, 4)
+	r.Equal(EventSplit, events[0].Kind)
+	r.Equal("MSFT", events[1].Symbol)
+	r.Equal("AAPL", events[2].Symbol)
+	r.Equal(EventDividend, events[3].Kind)
+
+	r.Equal("NVDA 10:1 split", events[0].Title())
+	r.Equal("AAPL ex-dividend 0.25", events[3].Title())
+	r.Contains(events[3].Details(), "Payment date: 2024-11-14")
+	r.Contains(events[1].Details(), "EPS actual: 3.3")
+}
+
+func (r *eventsSuite) TestFilterEvents() {
+	events := r.events()
+
+	matched := FilterEvents(events, &EventFilter{Symbols: []string{"aapl"}})
+	r.Len(matched, 2)
+
+	matched = FilterEvents(events, &EventFilter{Symbols: []string{"AAPL", "NVDA"}, Kinds: []EventKind{EventSplit, EventDividend}})
+	r.Len(matched, 2)
+	r.Equal(EventSplit, matched[0].Kind)
+
+	r.Len(FilterEvents(events, &EventFilter{}), 4)
+}
+
+func (r *eventsSuite) TestWriteEventsICalendar() {
+	var buf bytes.Buffer
+	r.NoError(WriteEventsICalendar(&buf, "Watchlist", r.events()))
+	ics := buf.String()
+	r.Equal(4, strings.Count(ics, "BEGIN:VEVENT"))
+	r.Contains(ics, "DTSTART;VALUE=DATE:20240610\r\n")
+	r.Contains(ics, "SUMMARY:NVDA 10:1 split\r\n")
+}
+
+func (r *eventsSuite) TestWriteEventsJSONFeed() {
+	var buf bytes.Buffer
+	r.NoError(WriteEventsJSONFeed(&buf, "Watchlist", r.events()))
+
+	var feed struct {
+		Version string `json:"version"`
+		Title   string `json:"title"`
+		Items   []struct {
+			ID    string   `json:"id"`
+			Title string   `json:"title"`
+			Tags  []string `json:"tags"`
+			Event Event    `json:"_event"`
+		} `json:"items"`
+	}
+	r.NoError(json.Unmarshal(buf.Bytes(), &feed))
+	r.Equal("https://jsonfeed.org/version/1.1", feed.Version)
+	r.Len(feed.Items, 4)
+	r.Equal("NVDA 10:1 split", feed.Items[0].Title)
+	r.Equal([]string{"split", "NVDA"}, feed.Items[0].Tags)
+	r.Equal(EventSplit, feed.Items[0].Event.Kind)
+	r.InDelta(10, feed.Items[0].Event.Split.Numerator, 1e-9)
+	r.NotEqual(feed.Items[0].ID, feed.Items[1].ID)
+
+	// An empty feed still has an items array.
+	buf.Reset()
+	r.NoError(WriteEventsJSONFeed(&buf, "Empty", nil))
+	r.Contains(buf.String(), `"items": []`)
+}
+
+func TestEventsSuite(t *testing.T) {
+	suite.Run(t, new(eventsSuite))
+}
@@ -0,0 +1,218 @@
+package financialmodelingprep
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/url"
+	"time"
+)
+
+// jsonFeedVersion is the version URL of the JSON Feed format, see https://www.jsonfeed.org.
+const jsonFeedVersion = "https://jsonfeed.org/version/1.1"
+
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url,omitempty"`
+	FeedURL     string         `json:"feed_url,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string     `json:"id"`
+	URL           string     `json:"url,omitempty"`
+	Title         string     `json:"title,omitempty"`
+	ContentText   string     `json:"content_text"`
+	Summary       string     `json:"summary,omitempty"`
+	Image         string     `json:"image,omitempty"`
+	DatePublished *time.Time `json:"date_published,omitempty"`
+	Tags          []string   `json:"tags,omitempty"`
+
+	// Extension holds the source record, under a key starting with an underscore as the format
+	// requires for extensions.
+	Extension map[string]any `json:"-"`
+}
+
+func (i jsonFeedItem) MarshalJSON() ([]byte, error) {
+	type item jsonFeedItem
+	b, err := json.Marshal(item(i))
+	if err != nil || len(i.Extension) == 0 {
+		return b, err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range i.Extension {
+		m[k] = v
+	}
+	return json.Marshal(m)
+}
+
+func writeJSONFeed(w io.Writer, feed *jsonFeed) error {
+	feed.Version = jsonFeedVersion
+	if feed.Items == nil {
+		feed.Items = []jsonFeedItem{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(feed)
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	Description string    `xml:"description"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title,omitempty"`
+	Link        string   `xml:"link,omitempty"`
+	Description string   `xml:"description,omitempty"`
+	Categories  []string `xml:"category"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// writeRSS writes the feed as RSS 2.0, see https://www.rssboard.org/rss-specification.
+func writeRSS(w io.Writer, feed *jsonFeed) error {
+	rss := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       feed.Title,
+			Link:        feed.HomePageURL,
+			Description: feed.Description,
+		},
+	}
+	for _, i := range feed.Items {
+		item := rssItem{
+			Title:       i.Title,
+			Link:        i.URL,
+			Description: i.ContentText,
+			Categories:  i.Tags,
+			GUID:        rssGUID{Value: i.ID},
+		}
+		if item.Description == "" {
+			item.Description = i.Summary
+		}
+		if i.DatePublished != nil {
+			item.PubDate = i.DatePublished.UTC().Format(time.RFC1123Z)
+		}
+		rss.Channel.Items = append(rss.Channel.Items, item)
+	}
+	return writeXML(w, &rss)
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Updated    string         `xml:"updated"`
+	Published  string         `xml:"published,omitempty"`
+	Links      []atomLink     `xml:"link"`
+	Summary    string         `xml:"summary,omitempty"`
+	Content    string         `xml:"content,omitempty"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// atomID turns an identifier into an IRI, as Atom requires for ids.
+func atomID(id string) string {
+	u, err := url.Parse(id)
+	if err == nil && u.Scheme != "" {
+		return id
+	}
+	return "urn:" + url.PathEscape(id)
+}
+
+// writeAtom writes the feed as Atom, see RFC 4287. The feed is updated at its latest item, or now
+// without dated items.
+func writeAtom(w io.Writer, feed *jsonFeed) error {
+	var updated time.Time
+	for _, i := range feed.Items {
+		if i.DatePublished != nil && i.DatePublished.After(updated) {
+			updated = *i.DatePublished
+		}
+	}
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+
+	atom := atomFeed{
+		ID:      atomID(feed.FeedURL),
+		Title:   feed.Title,
+		Updated: updated.UTC().Format(time.RFC3339),
+	}
+	if feed.FeedURL == "" {
+		atom.ID = atomID(feed.Title)
+	} else {
+		atom.Links = append(atom.Links, atomLink{Href: feed.FeedURL, Rel: "self"})
+	}
+	if feed.HomePageURL != "" {
+		atom.Links = append(atom.Links, atomLink{Href: feed.HomePageURL})
+	}
+	for _, i := range feed.Items {
+		entry := atomEntry{
+			ID:      atomID(i.ID),
+			Title:   i.Title,
+			Updated: atom.Updated,
+			Summary: i.Summary,
+			Content: i.ContentText,
+		}
+		if i.DatePublished != nil {
+			entry.Updated = i.DatePublished.UTC().Format(time.RFC3339)
+			entry.Published = entry.Updated
+		}
+		if i.URL != "" {
+			entry.Links = append(entry.Links, atomLink{Href: i.URL})
+		}
+		for _, tag := range i.Tags {
+			entry.Categories = append(entry.Categories, atomCategory{Term: tag})
+		}
+		atom.Entries = append(atom.Entries, entry)
+	}
+	return writeXML(w, &atom)
+}
+
+func writeXML(w io.Writer, v any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,514 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FinancialPeriod identifies a reported fiscal period, e.g. FY 2024 or Q3 2024.
+type FinancialPeriod struct {
+	FiscalYear string
+	Period     string
+}
+
+func (p FinancialPeriod) String() string {
+	return p.Period + " " + p.FiscalYear
+}
+
+// year returns the fiscal year as an integer, or 0 when it cannot be parsed.
+func (p FinancialPeriod) year() int {
+	y, err := strconv.Atoi(p.FiscalYear)
+	if err != nil {
+		return 0
+	}
+	return y
+}
+
+// quarter returns 1-4 for quarterly periods and 0 for fiscal years.
+func (p FinancialPeriod) quarter() int {
+	switch Period(p.Period) {
+	case Q1:
+		return 1
+	case Q2:
+		return 2
+	case Q3:
+		return 3
+	case Q4:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// IsQuarter reports whether the period is a fiscal quarter.
+func (p FinancialPeriod) IsQuarter() bool {
+	return p.quarter() > 0
+}
+
+// Before reports whether p comes before q in fiscal order. Within the same year the quarters come
+// before the fiscal year itself.
+func (p FinancialPeriod) Before(q FinancialPeriod) bool {
+	if p.year() != q.year() {
+		return p.year() < q.year()
+	}
+	pq, qq := p.quarter(), q.quarter()
+	if pq == 0 {
+		pq = 5
+	}
+	if qq == 0 {
+		qq = 5
+	}
+	return pq < qq
+}
+
+// FinancialStatements holds the statements reported for a single fiscal period. Any of the
+// statements may be nil when the period is missing from the corresponding source.
+type FinancialStatements struct {
+	FinancialPeriod
+
+	IncomeStatement       *IncomeStatement
+	BalanceSheetStatement *BalanceSheetStatement
+	CashFlowStatement     *CashFlowStatement
+}
+
+// Date returns the period end date of the first available statement.
+func (s *FinancialStatements) Date() time.Time {
+	switch {
+	case s.IncomeStatement != nil:
+		return s.IncomeStatement.Date.Time
+	case s.BalanceSheetStatement != nil:
+		return s.BalanceSheetStatement.Date.Time
+	case s.CashFlowStatement != nil:
+		return s.CashFlowStatement.Date.Time
+	}
+	return time.Time{}
+}
+
+// Complete reports whether all three statements are available.
+func (s *FinancialStatements) Complete() bool {
+	return s.IncomeStatement != nil && s.BalanceSheetStatement != nil && s.CashFlowStatement != nil
+}
+
+// FinancialValue is a single observation of a financial time series.
+type FinancialValue struct {
+	FinancialPeriod
+
+	Date  time.Time
+	Value float64
+}
+
+// FinancialGap describes a fiscal period that is absent, or only partially reported.
+type FinancialGap struct {
+	FinancialPeriod
+
+	// Missing lists the statements that are absent for the period, e.g. "cash-flow-statement".
+	Missing []string
+}
+
+// Restatement describes a fiscal period that has been reported more than once.
+type Restatement struct {
+	FinancialPeriod
+
+	// Statement is the statement kind, e.g. "income-statement".
+	Statement string
+
+	// Original and Restated are the accepted dates of the first and the latest filing.
+	Original string
+	Restated string
+
+	// FilingDates lists the filing dates of every version, oldest first.
+	FilingDates []time.Time
+}
+
+// Financials aligns the income, balance sheet and cash flow statements of a company by fiscal
+// period.
+type Financials struct {
+	Symbol string
+
+	// Periods are sorted in fiscal order, oldest first.
+	Periods []FinancialStatements
+
+	restatements []Restatement
+}
+
+const (
+	incomeStatementName       = "income-statement"
+	balanceSheetStatementName = "balance-sheet-statement"
+	cashFlowStatementName     = "cash-flow-statement"
+)
+
+// NewFinancials aligns the given statements by fiscal period. When a period is reported more than
+// once, the version with the latest accepted date is kept and the period is recorded as a
+// restatement.
+func NewFinancials(symbol string, incomes []IncomeStatement, balances []BalanceSheetStatement, cashFlows []CashFlowStatement) *Financials {
+	f := &Financials{
+		Symbol: symbol,
+	}
+	index := map[FinancialPeriod]*FinancialStatements{}
+	periodOf := func(fp FinancialPeriod) *FinancialStatements {
+		s, ok := index[fp]
+		if !ok {
+			s = &FinancialStatements{FinancialPeriod: fp}
+			index[fp] = s
+		}
+		return s
+	}
+
+	// Group the versions of each period, so restatements can be told apart from the latest filing.
+	type version struct {
+		acceptedDate string
+		filingDate   time.Time
+	}
+	versions := map[string]map[FinancialPeriod][]version{}
+	record := func(name string, fp FinancialPeriod, acceptedDate string, filingDate time.Time) bool {
+		if versions[name] == nil {
+			versions[name] = map[FinancialPeriod][]version{}
+		}
+		vs := versions[name][fp]
+		latest := true
+		for _, v := range vs {
+			if v.acceptedDate > acceptedDate {
+				latest = false
+			}
+		}
+		versions[name][fp] = append(vs, version{acceptedDate: acceptedDate, filingDate: filingDate})
+		return latest
+	}
+
+	for i := range incomes {
+		st := &incomes[i]
+		fp := FinancialPeriod{FiscalYear: st.FiscalYear, Period: st.Period}
+		if record(incomeStatementName, fp, st.AcceptedDate, st.FilingDate.Time) {
+			periodOf(fp).IncomeStatement = st
+		}
+	}
+	for i := range balances {
+		st := &balances[i]
+		fp := FinancialPeriod{FiscalYear: st.FiscalYear, Period: st.Period}
+		if record(balanceSheetStatementName, fp, st.AcceptedDate, st.FilingDate.Time) {
+			periodOf(fp).BalanceSheetStatement = st
+		}
+	}
+	for i := range cashFlows {
+		st := &cashFlows[i]
+		fp := FinancialPeriod{FiscalYear: st.FiscalYear, Period: st.Period}
+		if record(cashFlowStatementName, fp, st.AcceptedDate, st.FilingDate.Time) {
+			periodOf(fp).CashFlowStatement = st
+		}
+	}
+
+	for _, s := range index {
+		f.Periods = append(f.Periods, *s)
+	}
+	sort.Slice(f.Periods, func(i, j int) bool {
+		return f.Periods[i].Before(f.Periods[j].FinancialPeriod)
+	})
+
+	// Collect restatements.
+	for _, name := range []string{incomeStatementName, balanceSheetStatementName, cashFlowStatementName} {
+		for fp, vs := range versions[name] {
+			if len(vs) < 2 {
+				continue
+			}
+			sort.Slice(vs, func(i, j int) bool {
+				return vs[i].acceptedDate < vs[j].acceptedDate
+			})
+			r := Restatement{
+				FinancialPeriod: fp,
+				Statement:       name,
+				Original:        vs[0].acceptedDate,
+				Restated:        vs[len(vs)-1].acceptedDate,
+			}
+			for _, v := range vs {
+				r.FilingDates = append(r.FilingDates, v.filingDate)
+			}
+			f.restatements = append(f.restatements, r)
+		}
+	}
+	sort.Slice(f.restatements, func(i, j int) bool {
+		if f.restatements[i].FinancialPeriod != f.restatements[j].FinancialPeriod {
+			return f.restatements[i].Before(f.restatements[j].FinancialPeriod)
+		}
+		return f.restatements[i].Statement < f.restatements[j].Statement
+	})
+
+	return f
+}
+
+// FinancialsGetParams defines parameters for GetFinancials.
+type FinancialsGetParams struct {
+	Symbol string
+	Limit  *int
+	Period *Period
+
+	// Concurrent fetches the three statements in parallel.
+	Concurrent bool
+}
+
+// GetFinancials fetches the income, balance sheet and cash flow statements of a company and aligns
+// them by fiscal period.
+func GetFinancials(ctx context.Context, c *ClientWithResponses, params *FinancialsGetParams) (*Financials, error) {
+	var (
+		incomes   []IncomeStatement
+		balances  []BalanceSheetStatement
+		cashFlows []CashFlowStatement
+	)
+	fetches := []func() error{
+		func() error {
+			resp, err := c.IncomeStatementGetWithResponse(ctx, &IncomeStatementGetParams{
+				Symbol: params.Symbol,
+				Limit:  params.Limit,
+				Period: params.Period,
+			})
+			if err != nil {
+				return err
+			}
+			if resp.JSON200 == nil {
+				return fmt.Errorf("unexpected status of %s: %s", incomeStatementName, resp.Status())
+			}
+			incomes = *resp.JSON200
+			return nil
+		},
+		func() error {
+			resp, err := c.BalanceSheetStatementGetWithResponse(ctx, &BalanceSheetStatementGetParams{
+				Symbol: params.Symbol,
+				Limit:  params.Limit,
+				Period: params.Period,
+			})
+			if err != nil {
+				return err
+			}
+			if resp.JSON200 == nil {
+				return fmt.Errorf("unexpected status of %s: %s", balanceSheetStatementName, resp.Status())
+			}
+			balances = *resp.JSON200
+			return nil
+		},
+		func() error {
+			resp, err := c.CashFlowStatementGetWithResponse(ctx, &CashFlowStatementGetParams{
+				Symbol: params.Symbol,
+				Limit:  params.Limit,
+				Period: params.Period,
+			})
+			if err != nil {
+				return err
+			}
+			if resp.JSON200 == nil {
+				return fmt.Errorf("unexpected status of %s: %s", cashFlowStatementName, resp.Status())
+			}
+			cashFlows = *resp.JSON200
+			return nil
+		},
+	}
+
+	errs := make([]error, len(fetches))
+	if params.Concurrent {
+		var wg sync.WaitGroup
+		for i, fetch := range fetches {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = fetch()
+			}()
+		}
+		wg.Wait()
+	} else {
+		for i, fetch := range fetches {
+			if errs[i] = fetch(); errs[i] != nil {
+				break
+			}
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewFinancials(params.Symbol, incomes, balances, cashFlows), nil
+}
+
+// Lookup returns the statements of the given fiscal period.
+func (f *Financials) Lookup(fp FinancialPeriod) (*FinancialStatements, bool) {
+	for i := range f.Periods {
+		if f.Periods[i].FinancialPeriod == fp {
+			return &f.Periods[i], true
+		}
+	}
+	return nil, false
+}
+
+// Series returns the named field as a time series, oldest first. The field is looked up by its JSON
+// name (e.g. "netIncome") or its Go name (e.g. "NetIncome") in the income, balance sheet and cash
+// flow statements, in that order. Periods without the statement carrying the field are skipped.
+func (f *Financials) Series(field string) ([]FinancialValue, error) {
+	var (
+		source int
+		index  []int
+	)
+	for i, t := range []reflect.Type{
+		reflect.TypeOf(IncomeStatement{}),
+		reflect.TypeOf(BalanceSheetStatement{}),
+		reflect.TypeOf(CashFlowStatement{}),
+	} {
+		if index = numericFieldIndex(t, field); index != nil {
+			source = i
+			break
+		}
+	}
+	if index == nil {
+		return nil, fmt.Errorf("not supported financial field: %s", field)
+	}
+
+	var series []FinancialValue
+	for _, s := range f.Periods {
+		var v reflect.Value
+		switch {
+		case source == 0 && s.IncomeStatement != nil:
+			v = reflect.ValueOf(s.IncomeStatement).Elem()
+		case source == 1 && s.BalanceSheetStatement != nil:
+			v = reflect.ValueOf(s.BalanceSheetStatement).Elem()
+		case source == 2 && s.CashFlowStatement != nil:
+			v = reflect.ValueOf(s.CashFlowStatement).Elem()
+		default:
+			continue
+		}
+		series = append(series, FinancialValue{
+			FinancialPeriod: s.FinancialPeriod,
+			Date:            s.Date(),
+			Value:           v.FieldByIndex(index).Float(),
+		})
+	}
+	return series, nil
+}
+
+// Gaps reports the fiscal periods that are missing between the first and the last reported period,
+// as well as the reported periods that lack one or more statements. Quarters and fiscal years are
+// checked independently.
+func (f *Financials) Gaps() []FinancialGap {
+	var gaps []FinancialGap
+
+	var quarters, years []FinancialPeriod
+	present := map[FinancialPeriod]*FinancialStatements{}
+	for i := range f.Periods {
+		s := &f.Periods[i]
+		present[s.FinancialPeriod] = s
+		if s.IsQuarter() {
+			quarters = append(quarters, s.FinancialPeriod)
+		} else {
+			years = append(years, s.FinancialPeriod)
+		}
+	}
+
+	var expected []FinancialPeriod
+	if len(quarters) > 0 {
+		first, last := quarters[0], quarters[len(quarters)-1]
+		for y, q := first.year(), first.quarter(); y < last.year() || (y == last.year() && q <= last.quarter()); {
+			expected = append(expected, FinancialPeriod{FiscalYear: strconv.Itoa(y), Period: "Q" + strconv.Itoa(q)})
+			if q++; q > 4 {
+				y, q = y+1, 1
+			}
+		}
+	}
+	if len(years) > 0 {
+		first, last := years[0], years[len(years)-1]
+		for y := first.year(); y <= last.year(); y++ {
+			expected = append(expected, FinancialPeriod{FiscalYear: strconv.Itoa(y), Period: first.Period})
+		}
+	}
+	sort.SliceStable(expected, func(i, j int) bool {
+		return expected[i].Before(expected[j])
+	})
+
+	for _, fp := range expected {
+		s, ok := present[fp]
+		if !ok {
+			gaps = append(gaps, FinancialGap{
+				FinancialPeriod: fp,
+				Missing:         []string{incomeStatementName, balanceSheetStatementName, cashFlowStatementName},
+			})
+			continue
+		}
+		if s.Complete() {
+			continue
+		}
+		gap := FinancialGap{FinancialPeriod: fp}
+		if s.IncomeStatement == nil {
+			gap.Missing = append(gap.Missing, incomeStatementName)
+		}
+		if s.BalanceSheetStatement == nil {
+			gap.Missing = append(gap.Missing, balanceSheetStatementName)
+		}
+		if s.CashFlowStatement == nil {
+			gap.Missing = append(gap.Missing, cashFlowStatementName)
+		}
+		gaps = append(gaps, gap)
+	}
+	return gaps
+}
+
+// Restatements reports the periods that were reported more than once in the source statements,
+// as well as the periods whose statements were accepted at different times.
+func (f *Financials) Restatements() []Restatement {
+	restatements := append([]Restatement(nil), f.restatements...)
+	type key struct {
+		FinancialPeriod
+		statement string
+	}
+	seen := map[key]bool{}
+	for _, r := range f.restatements {
+		seen[key{r.FinancialPeriod, r.Statement}] = true
+	}
+	for _, s := range f.Periods {
+		if !s.Complete() {
+			continue
+		}
+		accepted := []struct {
+			name  string
+			date  string
+			filed time.Time
+		}{
+			{incomeStatementName, s.IncomeStatement.AcceptedDate, s.IncomeStatement.FilingDate.Time},
+			{balanceSheetStatementName, s.BalanceSheetStatement.AcceptedDate, s.BalanceSheetStatement.FilingDate.Time},
+			{cashFlowStatementName, s.CashFlowStatement.AcceptedDate, s.CashFlowStatement.FilingDate.Time},
+		}
+		sort.SliceStable(accepted, func(i, j int) bool {
+			return accepted[i].date < accepted[j].date
+		})
+		first := accepted[0]
+		for _, a := range accepted[1:] {
+			if a.date == first.date || seen[key{s.FinancialPeriod, a.name}] {
+				continue
+			}
+			restatements = append(restatements, Restatement{
+				FinancialPeriod: s.FinancialPeriod,
+				Statement:       a.name,
+				Original:        first.date,
+				Restated:        a.date,
+				FilingDates:     []time.Time{first.filed, a.filed},
+			})
+		}
+	}
+	return restatements
+}
+
+// numericFieldIndex returns the index of the floating-point field with the given JSON or Go name.
+func numericFieldIndex(t reflect.Type, name string) []int {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if k := sf.Type.Kind(); k != reflect.Float64 && k != reflect.Float32 {
+			continue
+		}
+		jsonName, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if sf.Name == name || jsonName == name {
+			return sf.Index
+		}
+	}
+	return nil
+}
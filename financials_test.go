@@ -0,0 +1,122 @@
+package financialmodelingprep
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type financialsSuite struct {
+	suite.Suite
+}
+
+func fixtureDate(year int, month time.Month, day int) openapi_types.Date {
+	return openapi_types.Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+func (r *financialsSuite) newFinancials() *Financials {
+	incomes := []IncomeStatement{
+		{Symbol: "AAPL", FiscalYear: "2024", Period: "Q2", Date: fixtureDate(2024, time.March, 30), AcceptedDate: "2024-05-03 06:01:36", FilingDate: fixtureDate(2024, time.May, 3), NetIncome: 23636},
+		{Symbol: "AAPL", FiscalYear: "2024", Period: "Q1", Date: fixtureDate(2023, time.December, 30), AcceptedDate: "2024-02-02 06:01:36", FilingDate: fixtureDate(2024, time.February, 2), NetIncome: 33916},
+		{Symbol: "AAPL", FiscalYear: "2023", Period: "Q3", Date: fixtureDate(2023, time.July, 1), AcceptedDate: "2023-08-04 06:01:36", FilingDate: fixtureDate(2023, time.August, 4), NetIncome: 19881},
+	}
+	balances := []BalanceSheetStatement{
+		{Symbol: "AAPL", FiscalYear: "2024", Period: "Q2", Date: fixtureDate(2024, time.March, 30), AcceptedDate: "2024-05-03 06:01:36", FilingDate: fixtureDate(2024, time.May, 3), TotalAssets: 337411},
+		{Symbol: "AAPL", FiscalYear: "2024", Period: "Q1", Date: fixtureDate(2023, time.December, 30), AcceptedDate: "2024-02-02 06:01:36", FilingDate: fixtureDate(2024, time.February, 2), TotalAssets: 353514},
+		{Symbol: "AAPL", FiscalYear: "2024", Period: "Q1", Date: fixtureDate(2023, time.December, 30), AcceptedDate: "2024-03-01 06:01:36", FilingDate: fixtureDate(2024, time.March, 1), TotalAssets: 353600},
+		{Symbol: "AAPL", FiscalYear: "2023", Period: "Q3", Date: fixtureDate(2023, time.July, 1), AcceptedDate: "2023-08-04 06:01:36", FilingDate: fixtureDate(2023, time.August, 4), TotalAssets: 335038},
+	}
+	cashFlows := []CashFlowStatement{
+		{Symbol: "AAPL", FiscalYear: "2024", Period: "Q2", Date: fixtureDate(2024, time.March, 30), AcceptedDate: "2024-05-03 06:01:36", FilingDate: fixtureDate(2024, time.May, 3), FreeCashFlow: 20694},
+		{Symbol: "AAPL", FiscalYear: "2024", Period: "Q1", Date: fixtureDate(2023, time.December, 30), AcceptedDate: "2024-02-02 06:01:36", FilingDate: fixtureDate(2024, time.February, 2), FreeCashFlow: 37503},
+	}
+	return NewFinancials("AAPL", incomes, balances, cashFlows)
+}
+
+func (r *financialsSuite) TestAlignment() {
+	f := r.newFinancials()
+	r.Len(f.Periods, 3)
+	r.Equal(FinancialPeriod{FiscalYear: "2023", Period: "Q3"}, f.Periods[0].FinancialPeriod)
+	r.Equal(FinancialPeriod{FiscalYear: "2024", Period: "Q2"}, f.Periods[2].FinancialPeriod)
+
+	s, ok := f.Lookup(FinancialPeriod{FiscalYear: "2024", Period: "Q1"})
+	r.True(ok)
+	r.True(s.Complete())
+	r.InDelta(353600, s.BalanceSheetStatement.TotalAssets, 1e-9)
+}
+
+func (r *financialsSuite) TestSeries() {
+	f := r.newFinancials()
+
+	netIncome, err := f.Series("netIncome")
+	r.NoError(err)
+	r.Len(netIncome, 3)
+	r.InDelta(19881, netIncome[0].Value, 1e-9)
+	r.Equal(time.Date(2024, time.March, 30, 0, 0, 0, 0, time.UTC), netIncome[2].Date)
+
+	fcf, err := f.Series("FreeCashFlow")
+	r.NoError(err)
+	r.Len(fcf, 2)
+
+	_, err = f.Series("symbol")
+	r.Error(err)
+}
+
+func (r *financialsSuite) TestNumericFieldIndex() {
+	type fields struct {
+		Revenue  float64 `json:"revenue"`
+		Surprise float32 `json:"surprise,omitempty"`
+		Count    int     `json:"count"`
+		Symbol   string  `json:"symbol"`
+	}
+	t := reflect.TypeOf(fields{})
+	r.Equal([]int{0}, numericFieldIndex(t, "revenue"))
+	r.Equal([]int{0}, numericFieldIndex(t, "Revenue"))
+
+	// float32 fields, as in some FMP schemas, are numeric too.
+	r.Equal([]int{1}, numericFieldIndex(t, "surprise"))
+
+	r.Nil(numericFieldIndex(t, "count"))
+	r.Nil(numericFieldIndex(t, "symbol"))
+	r.Nil(numericFieldIndex(t, "missing"))
+}
+
+func (r *financialsSuite) TestGaps() {
+	gaps := r.newFinancials().Gaps()
+	r.Len(gaps, 2)
+
+	r.Equal(FinancialPeriod{FiscalYear: "2023", Period: "Q3"}, gaps[0].FinancialPeriod)
+	r.Equal([]string{cashFlowStatementName}, gaps[0].Missing)
+
+	r.Equal(FinancialPeriod{FiscalYear: "2023", Period: "Q4"}, gaps[1].FinancialPeriod)
+	r.Len(gaps[1].Missing, 3)
+}
+
+func (r *financialsSuite) TestRestatements() {
+	restatements := r.newFinancials().Restatements()
+	r.Len(restatements, 1)
+
+	r.Equal(balanceSheetStatementName, restatements[0].Statement)
+	r.Equal("2024-02-02 06:01:36", restatements[0].Original)
+	r.Equal("2024-03-01 06:01:36", restatements[0].Restated)
+	r.Len(restatements[0].FilingDates, 2)
+
+	// A statement accepted later than its siblings is reported as well.
+	f := NewFinancials("AAPL", []IncomeStatement{
+		{FiscalYear: "2024", Period: "FY", AcceptedDate: "2024-11-01 06:01:36"},
+	}, []BalanceSheetStatement{
+		{FiscalYear: "2024", Period: "FY", AcceptedDate: "2024-11-01 06:01:36"},
+	}, []CashFlowStatement{
+		{FiscalYear: "2024", Period: "FY", AcceptedDate: "2025-01-31 06:01:36"},
+	})
+	restatements = f.Restatements()
+	r.Len(restatements, 1)
+	r.Equal(cashFlowStatementName, restatements[0].Statement)
+}
+
+func TestFinancialsSuite(t *testing.T) {
+	suite.Run(t, new(financialsSuite))
+}
@@ -0,0 +1,374 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Rating is an analyst grade normalized onto a 5-point scale, from RatingStrongSell to
+// RatingStrongBuy.
+type Rating int
+
+const (
+	RatingUnknown Rating = iota
+	RatingStrongSell
+	RatingSell
+	RatingHold
+	RatingBuy
+	RatingStrongBuy
+)
+
+func (r Rating) String() string {
+	switch r {
+	case RatingStrongSell:
+		return "Strong Sell"
+	case RatingSell:
+		return "Sell"
+	case RatingHold:
+		return "Hold"
+	case RatingBuy:
+		return "Buy"
+	case RatingStrongBuy:
+		return "Strong Buy"
+	default:
+		return "Unknown"
+	}
+}
+
+// GradeRatings maps the grades of the rating firms, normalized by normalizeGrade, onto the
+// 5-point scale. It may be extended with firm specific grades.
+var GradeRatings = map[string]Rating{
+	"strong buy":        RatingStrongBuy,
+	"conviction buy":    RatingStrongBuy,
+	"top pick":          RatingStrongBuy,
+	"strong outperform": RatingStrongBuy,
+
+	"buy":                 RatingBuy,
+	"outperform":          RatingBuy,
+	"outperformer":        RatingBuy,
+	"market outperform":   RatingBuy,
+	"sector outperform":   RatingBuy,
+	"overweight":          RatingBuy,
+	"accumulate":          RatingBuy,
+	"add":                 RatingBuy,
+	"positive":            RatingBuy,
+	"moderate buy":        RatingBuy,
+	"speculative buy":     RatingBuy,
+	"long term buy":       RatingBuy,
+	"above average":       RatingBuy,
+	"sector overweight":   RatingBuy,
+	"industry outperform": RatingBuy,
+	"market overweight":   RatingBuy,
+	"outperform market":   RatingBuy,
+	"buy speculative":     RatingBuy,
+
+	"hold":             RatingHold,
+	"neutral":          RatingHold,
+	"equal weight":     RatingHold,
+	"equalweight":      RatingHold,
+	"market perform":   RatingHold,
+	"sector perform":   RatingHold,
+	"peer perform":     RatingHold,
+	"perform":          RatingHold,
+	"in line":          RatingHold,
+	"inline":           RatingHold,
+	"sector weight":    RatingHold,
+	"market weight":    RatingHold,
+	"mixed":            RatingHold,
+	"average":          RatingHold,
+	"fair value":       RatingHold,
+	"industry perform": RatingHold,
+
+	"sell":                  RatingSell,
+	"underperform":          RatingSell,
+	"underperformer":        RatingSell,
+	"market underperform":   RatingSell,
+	"sector underperform":   RatingSell,
+	"underweight":           RatingSell,
+	"reduce":                RatingSell,
+	"negative":              RatingSell,
+	"moderate sell":         RatingSell,
+	"below average":         RatingSell,
+	"industry underperform": RatingSell,
+
+	"strong sell": RatingStrongSell,
+}
+
+// normalizeGrade lowercases a grade and reduces its punctuation to single spaces.
+func normalizeGrade(grade string) string {
+	var words []string
+	for _, t := range textTokens(grade) {
+		words = append(words, t.word)
+	}
+	return strings.Join(words, " ")
+}
+
+// ParseRating normalizes a grade such as "Outperform" or "Equal-Weight" onto the 5-point scale.
+// Unknown grades parse as RatingUnknown.
+func ParseRating(grade string) Rating {
+	return GradeRatings[normalizeGrade(grade)]
+}
+
+// GradeAction classifies a grade change.
+type GradeAction string
+
+const (
+	GradeUpgrade   GradeAction = "upgrade"
+	GradeDowngrade GradeAction = "downgrade"
+	GradeMaintain  GradeAction = "maintain"
+	GradeInitiate  GradeAction = "initiate"
+	GradeUnknown   GradeAction = ""
+)
+
+// ClassifyGradeChange classifies a change from the previous to the current grade by their
+// ratings. A missing previous grade is an initiation of coverage. When a rating is unknown, the
+// action given by the firm, such as "upgrade" or "init", decides.
+func ClassifyGradeChange(previous, current, action string) GradeAction {
+	p, n := ParseRating(previous), ParseRating(current)
+	switch {
+	case strings.TrimSpace(previous) == "" && n != RatingUnknown:
+		return GradeInitiate
+	case p != RatingUnknown && n != RatingUnknown:
+		switch {
+		case n > p:
+			return GradeUpgrade
+		case n < p:
+			return GradeDowngrade
+		default:
+			return GradeMaintain
+		}
+	}
+	switch a := strings.ToLower(strings.TrimSpace(action)); {
+	case strings.HasPrefix(a, "up"):
+		return GradeUpgrade
+	case strings.HasPrefix(a, "down"):
+		return GradeDowngrade
+	case strings.HasPrefix(a, "init"):
+		return GradeInitiate
+	case a == "maintain" || a == "hold" || a == "reiterate" || a == "reiterated":
+		return GradeMaintain
+	default:
+		return GradeUnknown
+	}
+}
+
+// GradeChange is a normalized grade change of a rating firm.
+type GradeChange struct {
+	Symbol string
+	Firm   string
+	Time   time.Time
+
+	PreviousGrade  string
+	NewGrade       string
+	PreviousRating Rating
+	NewRating      Rating
+	Action         GradeAction
+
+	// PriceWhenPosted and URL are only set for changes from GradesLatestNewsGet.
+	PriceWhenPosted float64
+	URL             string
+}
+
+func sortGradeChanges(changes []GradeChange) {
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Time.Before(changes[j].Time)
+	})
+}
+
+// NewGradeChanges normalizes the grade changes of GradesLatestNewsGet, oldest first.
+func NewGradeChanges(news []GradeNews) []GradeChange {
+	changes := make([]GradeChange, len(news))
+	for i := range news {
+		n := &news[i]
+		changes[i] = GradeChange{
+			Symbol:          n.Symbol,
+			Firm:            n.GradingCompany,
+			Time:            n.PublishedDate,
+			PreviousGrade:   n.PreviousGrade,
+			NewGrade:        n.NewGrade,
+			PreviousRating:  ParseRating(n.PreviousGrade),
+			NewRating:       ParseRating(n.NewGrade),
+			Action:          ClassifyGradeChange(n.PreviousGrade, n.NewGrade, n.Action),
+			PriceWhenPosted: float64(n.PriceWhenPosted),
+			URL:             n.NewsURL,
+		}
+	}
+	sortGradeChanges(changes)
+	return changes
+}
+
+// NewGradeChangesFromGrades normalizes the grade changes of GradesGet, oldest first.
+func NewGradeChangesFromGrades(grades []Grade) []GradeChange {
+	changes := make([]GradeChange, len(grades))
+	for i := range grades {
+		g := &grades[i]
+		changes[i] = GradeChange{
+			Symbol:         g.Symbol,
+			Firm:           g.GradingCompany,
+			Time:           g.Date.Time,
+			PreviousGrade:  g.PreviousGrade,
+			NewGrade:       g.NewGrade,
+			PreviousRating: ParseRating(g.PreviousGrade),
+			NewRating:      ParseRating(g.NewGrade),
+			Action:         ClassifyGradeChange(g.PreviousGrade, g.NewGrade, g.Action),
+		}
+	}
+	sortGradeChanges(changes)
+	return changes
+}
+
+// GradeHistory indexes grade changes by company and rating firm.
+type GradeHistory struct {
+	// Changes are all the changes, oldest first.
+	Changes []GradeChange
+}
+
+// NewGradeHistory builds a history from changes, dropping exact duplicates, which overlapping
+// pages may return.
+func NewGradeHistory(changes ...[]GradeChange) *GradeHistory {
+	h := &GradeHistory{}
+	seen := map[GradeChange]bool{}
+	for _, l := range changes {
+		for _, c := range l {
+			if !seen[c] {
+				seen[c] = true
+				h.Changes = append(h.Changes, c)
+			}
+		}
+	}
+	sortGradeChanges(h.Changes)
+	return h
+}
+
+// BySymbol returns the changes of a company, oldest first.
+func (h *GradeHistory) BySymbol(symbol string) []GradeChange {
+	var changes []GradeChange
+	for _, c := range h.Changes {
+		if strings.EqualFold(c.Symbol, symbol) {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// ByFirm returns the changes of a rating firm, oldest first.
+func (h *GradeHistory) ByFirm(firm string) []GradeChange {
+	var changes []GradeChange
+	for _, c := range h.Changes {
+		if strings.EqualFold(c.Firm, firm) {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// Symbols returns the companies of the history, sorted.
+func (h *GradeHistory) Symbols() []string {
+	symbols := make([]string, len(h.Changes))
+	for i := range h.Changes {
+		symbols[i] = h.Changes[i].Symbol
+	}
+	return sortedUnique(symbols)
+}
+
+// Firms returns the rating firms of the history, sorted.
+func (h *GradeHistory) Firms() []string {
+	firms := make([]string, len(h.Changes))
+	for i := range h.Changes {
+		firms[i] = h.Changes[i].Firm
+	}
+	return sortedUnique(firms)
+}
+
+// ConsensusPoint is the mean rating of a company at a point in time.
+type ConsensusPoint struct {
+	Time time.Time
+
+	// Ratings is the number of ratings averaged, and Mean their mean on the 5-point scale.
+	Ratings int
+	Mean    float64
+}
+
+// Consensus returns the drift of the consensus of a company: after each of its changes, the mean
+// of the latest known rating of every firm. Ratings older than maxAge at that time are left out,
+// unless maxAge is zero.
+func (h *GradeHistory) Consensus(symbol string, maxAge time.Duration) []ConsensusPoint {
+	latest := map[string]GradeChange{}
+	var points []ConsensusPoint
+	for _, c := range h.BySymbol(symbol) {
+		if c.NewRating == RatingUnknown {
+			continue
+		}
+		latest[c.Firm] = c
+		p := ConsensusPoint{Time: c.Time}
+		for _, l := range latest {
+			if maxAge > 0 && c.Time.Sub(l.Time) > maxAge {
+				continue
+			}
+			p.Ratings++
+			p.Mean += float64(l.NewRating)
+		}
+		p.Mean /= float64(p.Ratings)
+		points = append(points, p)
+	}
+	return points
+}
+
+// HistoricalConsensus returns the mean rating of the analyst rating counts of
+// GradesHistoricalGet, oldest first. Dates without ratings are skipped.
+func HistoricalConsensus(grades []HistoricalGrade) []ConsensusPoint {
+	var points []ConsensusPoint
+	for _, g := range grades {
+		counts := map[Rating]int{
+			RatingStrongSell: g.AnalystRatingsStrongSell,
+			RatingSell:       g.AnalystRatingsSell,
+			RatingHold:       g.AnalystRatingsHold,
+			RatingBuy:        g.AnalystRatingsBuy,
+			RatingStrongBuy:  g.AnalystRatingsStrongBuy,
+		}
+		p := ConsensusPoint{Time: g.Date.Time}
+		for rating, n := range counts {
+			p.Ratings += n
+			p.Mean += float64(rating) * float64(n)
+		}
+		if p.Ratings == 0 {
+			continue
+		}
+		p.Mean /= float64(p.Ratings)
+		points = append(points, p)
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Time.Before(points[j].Time)
+	})
+	return points
+}
+
+// GetGradeHistory fetches pages of GradesLatestNewsGet, starting at params.Page, until an empty
+// page or maxPages pages, and builds their history.
+func GetGradeHistory(ctx context.Context, c *ClientWithResponses, params *GradesLatestNewsGetParams, maxPages int) (*GradeHistory, error) {
+	p := *params
+	page := 0
+	if p.Page != nil {
+		page = *p.Page
+	}
+	var pages [][]GradeChange
+	for n := 0; n < maxPages; n++ {
+		current := page + n
+		p.Page = &current
+		resp, err := c.GradesLatestNewsGetWithResponse(ctx, &p)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected status of grades latest news: %s", resp.Status())
+		}
+		if len(*resp.JSON200) == 0 {
+			break
+		}
+		pages = append(pages, NewGradeChanges(*resp.JSON200))
+	}
+	return NewGradeHistory(pages...), nil
+}
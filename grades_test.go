@@ -0,0 +1,114 @@
+package financialmodelingprep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type gradesSuite struct {
+	suite.Suite
+}
+
+func gradeNews(symbol, firm string, day int, previous, current, action string) GradeNews {
+	return GradeNews{
+		Symbol:         symbol,
+		GradingCompany: firm,
+		PublishedDate:  time.Date(2025, time.February, day, 12, 0, 0, 0, time.UTC),
+		PreviousGrade:  previous,
+		NewGrade:       current,
+		Action:         action,
+	}
+}
+
+func (r *gradesSuite) TestParseRating() {
+	r.Equal(RatingBuy, ParseRating("Outperform"))
+	r.Equal(RatingBuy, ParseRating("Overweight"))
+	r.Equal(RatingHold, ParseRating("Equal-Weight"))
+	r.Equal(RatingHold, ParseRating(" market  perform "))
+	r.Equal(RatingSell, ParseRating("Underweight"))
+	r.Equal(RatingStrongBuy, ParseRating("STRONG BUY"))
+	r.Equal(RatingStrongSell, ParseRating("Strong Sell"))
+	r.Equal(RatingUnknown, ParseRating("Speculative Hold"))
+	r.Equal("Hold", RatingHold.String())
+}
+
+func (r *gradesSuite) TestClassifyGradeChange() {
+	r.Equal(GradeUpgrade, ClassifyGradeChange("Neutral", "Outperform", "upgrade"))
+	r.Equal(GradeDowngrade, ClassifyGradeChange("Buy", "Hold", "hold"))
+	// Different words for the same rating.
+	r.Equal(GradeMaintain, ClassifyGradeChange("Overweight", "Outperform", "upgrade"))
+	r.Equal(GradeInitiate, ClassifyGradeChange("", "Buy", "init"))
+	// Unknown grades fall back on the action.
+	r.Equal(GradeUpgrade, ClassifyGradeChange("Speculative Hold", "Conviction List", "upgrade"))
+	r.Equal(GradeMaintain, ClassifyGradeChange("Speculative Hold", "Speculative Hold", "reiterated"))
+	r.Equal(GradeUnknown, ClassifyGradeChange("?", "?", ""))
+}
+
+func (r *gradesSuite) history() *GradeHistory {
+	page1 := NewGradeChanges([]GradeNews{
+		gradeNews("AAPL", "Morgan Stanley", 10, "Equal-Weight", "Overweight", "upgrade"),
+		gradeNews("AAPL", "Jefferies", 5, "Hold", "Underperform", "downgrade"),
+	})
+	page2 := NewGradeChanges([]GradeNews{
+		gradeNews("AAPL", "Jefferies", 5, "Hold", "Underperform", "downgrade"),
+		gradeNews("MSFT", "Morgan Stanley", 3, "", "Overweight", "init"),
+		gradeNews("AAPL", "Morgan Stanley", 1, "Underweight", "Equal-Weight", "upgrade"),
+	})
+	return NewGradeHistory(page1, page2)
+}
+
+func (r *gradesSuite) TestHistory() {
+	h := r.history()
+	r.Len(h.Changes, 4)
+	r.Equal([]string{"AAPL", "MSFT"}, h.Symbols())
+	r.Equal([]string{"Jefferies", "Morgan Stanley"}, h.Firms())
+
+	aapl := h.BySymbol("aapl")
+	r.Len(aapl, 3)
+	r.Equal(GradeUpgrade, aapl[0].Action)
+	r.Equal(GradeDowngrade, aapl[1].Action)
+	r.Equal(RatingSell, aapl[1].NewRating)
+
+	ms := h.ByFirm("Morgan Stanley")
+	r.Len(ms, 3)
+	r.Equal("MSFT", ms[1].Symbol)
+	r.Equal(GradeInitiate, ms[1].Action)
+
+	changes := NewGradeChangesFromGrades([]Grade{
+		{Symbol: "AAPL", GradingCompany: "Loop Capital", Date: fixtureDate(2025, time.January, 2), PreviousGrade: "Buy", NewGrade: "Hold", Action: "downgrade"},
+	})
+	r.Equal(GradeDowngrade, changes[0].Action)
+	r.Equal(RatingHold, changes[0].NewRating)
+}
+
+func (r *gradesSuite) TestConsensus() {
+	points := r.history().Consensus("AAPL", 0)
+	r.Len(points, 3)
+	r.Equal(1, points[0].Ratings)
+	r.InDelta(3, points[0].Mean, 1e-9)
+	r.Equal(2, points[1].Ratings)
+	r.InDelta(2.5, points[1].Mean, 1e-9)
+	r.InDelta(3, points[2].Mean, 1e-9)
+
+	// The first Morgan Stanley rating is stale by the Jefferies downgrade.
+	points = r.history().Consensus("AAPL", 72*time.Hour)
+	r.Equal(1, points[1].Ratings)
+	r.InDelta(2, points[1].Mean, 1e-9)
+
+	points = HistoricalConsensus([]HistoricalGrade{
+		{Symbol: "AAPL", Date: fixtureDate(2025, time.February, 1), AnalystRatingsStrongBuy: 1, AnalystRatingsBuy: 2, AnalystRatingsHold: 1},
+		{Symbol: "AAPL", Date: fixtureDate(2025, time.January, 1), AnalystRatingsSell: 1, AnalystRatingsHold: 1},
+		{Symbol: "AAPL", Date: fixtureDate(2024, time.December, 1)},
+	})
+	r.Len(points, 2)
+	r.Equal(fixtureDate(2025, time.January, 1).Time, points[0].Time)
+	r.InDelta(2.5, points[0].Mean, 1e-9)
+	r.Equal(4, points[1].Ratings)
+	r.InDelta(4, points[1].Mean, 1e-9)
+}
+
+func TestGradesSuite(t *testing.T) {
+	suite.Run(t, new(gradesSuite))
+}
@@ -0,0 +1,113 @@
+package financialmodelingprep
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"io"
+	"strings"
+	"time"
+)
+
+// ICalEvent is a single VEVENT of an iCalendar feed.
+type ICalEvent struct {
+	// UID must be unique and stable, so that calendar clients update rather than duplicate events
+	// on refresh.
+	UID string
+
+	Start time.Time
+
+	// End may be zero for events without duration.
+	End time.Time
+
+	// AllDay writes the start and end as dates, ignoring the time of day.
+	AllDay bool
+
+	Summary     string
+	Description string
+
+	// Categories are written in order, skipping empty ones.
+	Categories []string
+}
+
+const (
+	icalDateTimeLayout = "20060102T150405Z"
+	icalDateLayout     = "20060102"
+
+	// icalLineLength is the maximum number of octets per line before folding, see RFC 5545 3.1.
+	icalLineLength = 75
+)
+
+// WriteICalendar writes the events as an iCalendar (.ics) feed named name.
+func WriteICalendar(w io.Writer, name string, events []ICalEvent) error {
+	bw := bufio.NewWriter(w)
+	line := func(s string) {
+		// Fold long lines without splitting UTF-8 sequences. Continuation lines start with a space,
+		// which counts towards their length.
+		for limit := icalLineLength; len(s) > limit; limit = icalLineLength - 1 {
+			cut := limit
+			for cut > 0 && s[cut]&0xC0 == 0x80 {
+				cut--
+			}
+			bw.WriteString(s[:cut])
+			bw.WriteString("\r\n ")
+			s = s[cut:]
+		}
+		bw.WriteString(s)
+		bw.WriteString("\r\n")
+	}
+
+	stamp := time.Now().UTC().Format(icalDateTimeLayout)
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//go-financialmodelingprep//EN")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	line("X-WR-CALNAME:" + icalEscape(name))
+	for _, e := range events {
+		line("BEGIN:VEVENT")
+		line("UID:" + icalEscape(e.UID))
+		line("DTSTAMP:" + stamp)
+		if e.AllDay {
+			line("DTSTART;VALUE=DATE:" + e.Start.Format(icalDateLayout))
+			end := e.End
+			if end.IsZero() || !end.After(e.Start) {
+				end = e.Start.AddDate(0, 0, 1)
+			}
+			line("DTEND;VALUE=DATE:" + end.Format(icalDateLayout))
+		} else {
+			line("DTSTART:" + e.Start.UTC().Format(icalDateTimeLayout))
+			if !e.End.IsZero() {
+				line("DTEND:" + e.End.UTC().Format(icalDateTimeLayout))
+			}
+		}
+		line("SUMMARY:" + icalEscape(e.Summary))
+		if e.Description != "" {
+			line("DESCRIPTION:" + icalEscape(e.Description))
+		}
+		var categories []string
+		for _, c := range e.Categories {
+			if c = strings.TrimSpace(c); c != "" {
+				categories = append(categories, icalEscape(c))
+			}
+		}
+		if len(categories) > 0 {
+			line("CATEGORIES:" + strings.Join(categories, ","))
+		}
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	return bw.Flush()
+}
+
+var icalEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func icalEscape(s string) string {
+	return icalEscaper.Replace(s)
+}
+
+// icalUID builds a stable UID from the parts identifying an event.
+func icalUID(parts ...string) string {
+	sum := sha1.Sum([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:]) + "@financialmodelingprep.com"
+}
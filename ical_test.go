@@ -0,0 +1,74 @@
+package financialmodelingprep
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type icalSuite struct {
+	suite.Suite
+}
+
+func (r *icalSuite) TestWriteICalendar() {
+	events := []ICalEvent{
+		{
+			UID:         icalUID("dividend", "AAPL", "2024-11-14"),
+			Start:       time.Date(2024, time.November, 14, 0, 0, 0, 0, time.UTC),
+			AllDay:      true,
+			Summary:     "AAPL ex-dividend; $0.25, quarterly",
+			Description: strings.Repeat("long description ", 10),
+		},
+		{
+			UID:        "fixed",
+			Start:      time.Date(2024, time.November, 14, 14, 30, 0, 0, time.FixedZone("EST", -5*3600)),
+			End:        time.Date(2024, time.November, 14, 15, 30, 0, 0, time.FixedZone("EST", -5*3600)),
+			Categories: []string{"Economic", "", "Low impact", " "},
+		},
+		{
+			UID:        "uncategorized",
+			Start:      time.Date(2024, time.November, 15, 0, 0, 0, 0, time.UTC),
+			AllDay:     true,
+			Categories: []string{""},
+		},
+	}
+
+	var buf bytes.Buffer
+	r.NoError(WriteICalendar(&buf, "Events", events))
+	ics := buf.String()
+
+	r.True(strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	r.True(strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+	r.Contains(ics, "DTSTART;VALUE=DATE:20241114\r\nDTEND;VALUE=DATE:20241115\r\n")
+	r.Contains(ics, `SUMMARY:AAPL ex-dividend\; $0.25\, quarterly`)
+	r.Contains(ics, "DTSTART:20241114T193000Z\r\nDTEND:20241114T203000Z\r\n")
+
+	// Empty categories are dropped, and the property with them when none is left.
+	r.Contains(ics, "CATEGORIES:Economic,Low impact\r\n")
+	r.Equal(1, strings.Count(ics, "CATEGORIES:"))
+
+	// Lines are folded at 75 octets.
+	for _, line := range strings.Split(ics, "\r\n") {
+		r.LessOrEqual(len(line), 75)
+	}
+	r.Contains(strings.ReplaceAll(ics, "\r\n ", ""), "DESCRIPTION:"+strings.Repeat("long description ", 10))
+
+	// UIDs are stable.
+	r.Equal(events[0].UID, icalUID("dividend", "AAPL", "2024-11-14"))
+	r.NotEqual(events[0].UID, icalUID("dividend", "AAPL", "2024-11-15"))
+}
+
+func (r *icalSuite) TestDateWindows() {
+	windows := dateWindows(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, time.April, 15, 0, 0, 0, 0, time.UTC), 90)
+	r.Len(windows, 2)
+	r.Equal(time.Date(2024, time.March, 30, 0, 0, 0, 0, time.UTC), windows[0].To.Time)
+	r.Equal(time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC), windows[1].From.Time)
+	r.Equal(time.Date(2024, time.April, 15, 0, 0, 0, 0, time.UTC), windows[1].To.Time)
+}
+
+func TestICalSuite(t *testing.T) {
+	suite.Run(t, new(icalSuite))
+}
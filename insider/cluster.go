@@ -0,0 +1,155 @@
+package insider
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Defaults applied to the zero values of ClusterParams.
+const (
+	DefaultClusterDays     = 14
+	DefaultClusterInsiders = 3
+)
+
+// ClusterParams defines parameters for ClusterBuys.
+type ClusterParams struct {
+	// Days is the longest span, in days, between the first and the last purchase of a cluster.
+	Days int
+
+	// MinInsiders is the number of distinct reporting owners required.
+	MinInsiders int
+
+	// MinValue is the total purchase value required.
+	MinValue float64
+}
+
+func (p *ClusterParams) withDefaults() ClusterParams {
+	q := ClusterParams{Days: DefaultClusterDays, MinInsiders: DefaultClusterInsiders}
+	if p != nil {
+		if p.Days > 0 {
+			q.Days = p.Days
+		}
+		if p.MinInsiders > 0 {
+			q.MinInsiders = p.MinInsiders
+		}
+		q.MinValue = p.MinValue
+	}
+	return q
+}
+
+// Signal is a cluster buy: several insiders of a company buying on the open market within a few
+// days of each other.
+type Signal struct {
+	Symbol string
+
+	// From and To are the dates of the first and the last purchase.
+	From, To time.Time
+
+	// Insiders are the names of the distinct reporting owners, in the order of their first
+	// purchase, and Ciks their CIKs.
+	Insiders []string
+	Ciks     []string
+
+	Buys   int
+	Shares float64
+	Value  float64
+
+	// Score ranks signals: the number of insiders times the log10 of the purchase value, so
+	// breadth counts more than size.
+	Score float64
+}
+
+func (s *Signal) String() string {
+	return fmt.Sprintf("%s cluster buy: %d insiders bought %.0f shares for %.0f between %s and %s (%s)",
+		s.Symbol, len(s.Insiders), s.Shares, s.Value,
+		s.From.Format(time.DateOnly), s.To.Format(time.DateOnly), strings.Join(s.Insiders, ", "))
+}
+
+// ClusterBuys detects cluster buys in the open market purchases, ranked by score. Overlapping
+// qualifying windows of a company merge into a single signal. The params may be nil.
+func ClusterBuys(transactions []Transaction, params *ClusterParams) []Signal {
+	p := params.withDefaults()
+
+	bySymbol := map[string][]*Transaction{}
+	for i := range transactions {
+		if t := &transactions[i]; t.Buy() {
+			bySymbol[t.Symbol] = append(bySymbol[t.Symbol], t)
+		}
+	}
+
+	var signals []Signal
+	for _, buys := range bySymbol {
+		sort.SliceStable(buys, func(i, j int) bool {
+			return buys[i].Date.Before(buys[j].Date)
+		})
+
+		// Extend the cluster while each window starting within it qualifies.
+		start, end := -1, -1
+		for i := range buys {
+			j := i
+			for j+1 < len(buys) && !buys[j+1].Date.After(buys[i].Date.AddDate(0, 0, p.Days)) {
+				j++
+			}
+			if distinctInsiders(buys[i:j+1]) < p.MinInsiders {
+				continue
+			}
+			if start >= 0 && i <= end {
+				end = max(end, j)
+				continue
+			}
+			if start >= 0 {
+				signals = appendSignal(signals, buys[start:end+1], p.MinValue)
+			}
+			start, end = i, j
+		}
+		if start >= 0 {
+			signals = appendSignal(signals, buys[start:end+1], p.MinValue)
+		}
+	}
+	Rank(signals)
+	return signals
+}
+
+func distinctInsiders(buys []*Transaction) int {
+	ciks := map[string]bool{}
+	for _, t := range buys {
+		ciks[t.ReportingCik] = true
+	}
+	return len(ciks)
+}
+
+func appendSignal(signals []Signal, buys []*Transaction, minValue float64) []Signal {
+	s := Signal{Symbol: buys[0].Symbol, From: buys[0].Date, To: buys[len(buys)-1].Date, Buys: len(buys)}
+	seen := map[string]bool{}
+	for _, t := range buys {
+		if !seen[t.ReportingCik] {
+			seen[t.ReportingCik] = true
+			s.Insiders = append(s.Insiders, t.ReportingName)
+			s.Ciks = append(s.Ciks, t.ReportingCik)
+		}
+		s.Shares += t.Shares
+		s.Value += t.Value()
+	}
+	if s.Value < minValue {
+		return signals
+	}
+	s.Score = float64(len(s.Insiders)) * math.Log10(1+s.Value)
+	return append(signals, s)
+}
+
+// Rank sorts signals by score, highest first, then by the latest purchase and symbol.
+func Rank(signals []Signal) {
+	sort.SliceStable(signals, func(i, j int) bool {
+		a, b := &signals[i], &signals[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if !a.To.Equal(b.To) {
+			return a.To.After(b.To)
+		}
+		return a.Symbol < b.Symbol
+	})
+}
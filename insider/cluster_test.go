@@ -0,0 +1,65 @@
+package insider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+type clusterSuite struct {
+	suite.Suite
+}
+
+func (r *clusterSuite) TestClusterBuys() {
+	transactions := NewTransactions([]fmp.InsiderTransaction{
+		// Three insiders within a week, then a fourth extending the cluster.
+		row("XYZ", "1", "Alpha", 1, "P-Purchase", "A", 1000, 10),
+		row("XYZ", "2", "Beta", 3, "P-Purchase", "A", 1000, 10),
+		row("XYZ", "1", "Alpha", 4, "P-Purchase", "A", 500, 10),
+		row("XYZ", "3", "Gamma", 7, "P-Purchase", "A", 1000, 10),
+		row("XYZ", "4", "Delta", 15, "P-Purchase", "A", 1000, 10),
+		// Sales do not count.
+		row("XYZ", "5", "Epsilon", 5, "S-Sale", "D", 1000, 10),
+		// Two insiders only.
+		row("ABC", "6", "Zeta", 1, "P-Purchase", "A", 100000, 10),
+		row("ABC", "7", "Eta", 2, "P-Purchase", "A", 100000, 10),
+		// Three insiders, but too far apart.
+		row("DEF", "8", "Theta", 1, "P-Purchase", "A", 1000, 10),
+		row("DEF", "9", "Iota", 10, "P-Purchase", "A", 1000, 10),
+		row("DEF", "10", "Kappa", 25, "P-Purchase", "A", 1000, 10),
+		// Three insiders with a larger value.
+		row("GHI", "11", "Lambda", 20, "P-Purchase", "A", 10000, 10),
+		row("GHI", "12", "Mu", 21, "P-Purchase", "A", 10000, 10),
+		row("GHI", "13", "Nu", 22, "P-Purchase", "A", 10000, 10),
+	})
+
+	signals := ClusterBuys(transactions, nil)
+	r.Len(signals, 2)
+
+	r.Equal("XYZ", signals[0].Symbol)
+	r.Equal([]string{"Alpha", "Beta", "Gamma", "Delta"}, signals[0].Insiders)
+	r.Equal([]string{"1", "2", "3", "4"}, signals[0].Ciks)
+	r.Equal(5, signals[0].Buys)
+	r.InDelta(45000, signals[0].Value, 1e-9)
+	r.Equal(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), signals[0].From)
+	r.Equal(time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC), signals[0].To)
+	r.Contains(signals[0].String(), "XYZ cluster buy: 4 insiders")
+
+	r.Equal("GHI", signals[1].Symbol)
+	r.Greater(signals[0].Score, signals[1].Score)
+
+	signals = ClusterBuys(transactions, &ClusterParams{Days: 30})
+	r.Len(signals, 3)
+
+	signals = ClusterBuys(transactions, &ClusterParams{MinInsiders: 2, MinValue: 100000})
+	// Breadth outranks size.
+	r.Len(signals, 2)
+	r.Equal("GHI", signals[0].Symbol)
+	r.Equal("ABC", signals[1].Symbol)
+}
+
+func TestClusterSuite(t *testing.T) {
+	suite.Run(t, new(clusterSuite))
+}
@@ -0,0 +1,312 @@
+// Package insider decodes the Form 4 transactions of InsiderTradingSearchGet and
+// InsiderTradingLatestGet, nets the open market buying and selling of insiders, and detects
+// cluster buys.
+package insider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+// Code is a Form 4 transaction code.
+type Code string
+
+const (
+	CodeUnknown Code = ""
+
+	// General transaction codes.
+	CodePurchase  Code = "P"
+	CodeSale      Code = "S"
+	CodeVoluntary Code = "V"
+
+	// Rule 16b-3 transaction codes.
+	CodeAward          Code = "A"
+	CodeDisposition    Code = "D"
+	CodeTaxWithholding Code = "F"
+	CodeDiscretionary  Code = "I"
+	CodeExercise       Code = "M"
+
+	// Derivative securities codes.
+	CodeConversion      Code = "C"
+	CodeExpirationShort Code = "E"
+	CodeExpirationLong  Code = "H"
+	CodeOutOfTheMoney   Code = "O"
+	CodeInTheMoney      Code = "X"
+
+	// Other codes.
+	CodeGift             Code = "G"
+	CodeSmallAcquisition Code = "L"
+	CodeWill             Code = "W"
+	CodeTrust            Code = "Z"
+	CodeOther            Code = "J"
+	CodeEquitySwap       Code = "K"
+	CodeTender           Code = "U"
+)
+
+var codeDescriptions = map[Code]string{
+	CodePurchase:         "Open market or private purchase",
+	CodeSale:             "Open market or private sale",
+	CodeVoluntary:        "Voluntarily reported transaction",
+	CodeAward:            "Grant or award",
+	CodeDisposition:      "Disposition to the issuer",
+	CodeTaxWithholding:   "Payment of exercise price or tax by delivering securities",
+	CodeDiscretionary:    "Discretionary transaction",
+	CodeExercise:         "Exercise or conversion of derivative security exempted under Rule 16b-3",
+	CodeConversion:       "Conversion of derivative security",
+	CodeExpirationShort:  "Expiration of short derivative position",
+	CodeExpirationLong:   "Expiration of long derivative position",
+	CodeOutOfTheMoney:    "Exercise of out-of-the-money derivative security",
+	CodeInTheMoney:       "Exercise of in-the-money or at-the-money derivative security",
+	CodeGift:             "Bona fide gift",
+	CodeSmallAcquisition: "Small acquisition",
+	CodeWill:             "Acquisition or disposition by will or the laws of descent",
+	CodeTrust:            "Deposit into or withdrawal from voting trust",
+	CodeOther:            "Other acquisition or disposition",
+	CodeEquitySwap:       "Equity swap or similar instrument",
+	CodeTender:           "Disposition pursuant to a tender of shares in a change of control",
+}
+
+// ParseCode parses the TransactionType of an InsiderTransaction, e.g. "P-Purchase" or
+// "M-Exempt", from its leading code letter. Unknown values parse as CodeUnknown.
+func ParseCode(s string) Code {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		s = s[:i]
+	}
+	code := Code(strings.ToUpper(s))
+	if _, ok := codeDescriptions[code]; !ok {
+		return CodeUnknown
+	}
+	return code
+}
+
+// Description returns the meaning of the code as given in the Form 4 instructions.
+func (c Code) Description() string {
+	if d, ok := codeDescriptions[c]; ok {
+		return d
+	}
+	return "Unknown"
+}
+
+// OpenMarket reports whether the code is an open market or private purchase or sale, the only
+// transactions where insiders trade at their own discretion.
+func (c Code) OpenMarket() bool {
+	return c == CodePurchase || c == CodeSale
+}
+
+// Transaction is a decoded InsiderTransaction.
+type Transaction struct {
+	Symbol        string
+	ReportingCik  string
+	ReportingName string
+	TypeOfOwner   string
+
+	Date       time.Time
+	FilingDate time.Time
+
+	Code Code
+
+	// Acquired is set for acquisitions, from AcquisitionOrDisposition "A".
+	Acquired bool
+
+	// Direct is set for securities owned directly, from DirectOrIndirect "D".
+	Direct bool
+
+	Shares      float64
+	Price       float64
+	SharesOwned float64
+
+	URL string
+}
+
+// NewTransaction decodes an InsiderTransaction.
+func NewTransaction(t *fmp.InsiderTransaction) *Transaction {
+	return &Transaction{
+		Symbol:        t.Symbol,
+		ReportingCik:  t.ReportingCik,
+		ReportingName: t.ReportingName,
+		TypeOfOwner:   t.TypeOfOwner,
+		Date:          t.TransactionDate.Time,
+		FilingDate:    t.FilingDate.Time,
+		Code:          ParseCode(t.TransactionType),
+		Acquired:      strings.EqualFold(strings.TrimSpace(t.AcquisitionOrDisposition), "A"),
+		Direct:        strings.EqualFold(strings.TrimSpace(t.DirectOrIndirect), "D"),
+		Shares:        t.SecuritiesTransacted,
+		Price:         float64(t.Price),
+		SharesOwned:   t.SecuritiesOwned,
+		URL:           t.Url,
+	}
+}
+
+// NewTransactions decodes InsiderTransaction rows and sorts them by transaction date, oldest
+// first.
+func NewTransactions(rows []fmp.InsiderTransaction) []Transaction {
+	transactions := make([]Transaction, len(rows))
+	for i := range rows {
+		transactions[i] = *NewTransaction(&rows[i])
+	}
+	sort.SliceStable(transactions, func(i, j int) bool {
+		return transactions[i].Date.Before(transactions[j].Date)
+	})
+	return transactions
+}
+
+// Value returns the value of the transaction, shares times price.
+func (t *Transaction) Value() float64 {
+	return t.Shares * t.Price
+}
+
+// Buy reports whether the transaction is an open market purchase.
+func (t *Transaction) Buy() bool {
+	return t.Code == CodePurchase
+}
+
+// Sell reports whether the transaction is an open market sale.
+func (t *Transaction) Sell() bool {
+	return t.Code == CodeSale
+}
+
+// Activity nets the open market purchases and sales of an insider or a company within a window.
+type Activity struct {
+	Symbol string
+
+	// ReportingCik and ReportingName are empty for the activity of a company.
+	ReportingCik  string
+	ReportingName string
+
+	// From and To bound the transaction dates, inclusively. Zero bounds are open.
+	From, To time.Time
+
+	Buys       int
+	BuyShares  float64
+	BuyValue   float64
+	Sells      int
+	SellShares float64
+	SellValue  float64
+
+	// NetShares and NetValue are the bought minus the sold shares and value.
+	NetShares float64
+	NetValue  float64
+}
+
+func inWindow(t, from, to time.Time) bool {
+	return (from.IsZero() || !t.Before(from)) && (to.IsZero() || !t.After(to))
+}
+
+func net(transactions []Transaction, from, to time.Time, byInsider bool) []Activity {
+	index := map[[2]string]int{}
+	var activities []Activity
+	for i := range transactions {
+		t := &transactions[i]
+		if !(t.Buy() || t.Sell()) || !inWindow(t.Date, from, to) {
+			continue
+		}
+		key := [2]string{t.Symbol}
+		if byInsider {
+			key[1] = t.ReportingCik
+		}
+		j, ok := index[key]
+		if !ok {
+			j = len(activities)
+			index[key] = j
+			a := Activity{Symbol: t.Symbol, From: from, To: to}
+			if byInsider {
+				a.ReportingCik, a.ReportingName = t.ReportingCik, t.ReportingName
+			}
+			activities = append(activities, a)
+		}
+		a := &activities[j]
+		if t.Buy() {
+			a.Buys++
+			a.BuyShares += t.Shares
+			a.BuyValue += t.Value()
+		} else {
+			a.Sells++
+			a.SellShares += t.Shares
+			a.SellValue += t.Value()
+		}
+		a.NetShares = a.BuyShares - a.SellShares
+		a.NetValue = a.BuyValue - a.SellValue
+	}
+	sort.SliceStable(activities, func(i, j int) bool {
+		if activities[i].NetValue != activities[j].NetValue {
+			return activities[i].NetValue > activities[j].NetValue
+		}
+		if activities[i].Symbol != activities[j].Symbol {
+			return activities[i].Symbol < activities[j].Symbol
+		}
+		return activities[i].ReportingCik < activities[j].ReportingCik
+	})
+	return activities
+}
+
+// NetByInsider nets the open market transactions within [from, to] per insider and company, net
+// buyers first. Zero bounds are open.
+func NetByInsider(transactions []Transaction, from, to time.Time) []Activity {
+	return net(transactions, from, to, true)
+}
+
+// NetBySymbol nets the open market transactions within [from, to] per company, net buyers first.
+// Zero bounds are open.
+func NetBySymbol(transactions []Transaction, from, to time.Time) []Activity {
+	return net(transactions, from, to, false)
+}
+
+// GetTransactions fetches pages of InsiderTradingSearchGet, starting at params.Page, until an
+// empty page or maxPages pages, and decodes them.
+func GetTransactions(ctx context.Context, c *fmp.ClientWithResponses, params *fmp.InsiderTradingSearchGetParams, maxPages int) ([]Transaction, error) {
+	p := *params
+	page := 0
+	if p.Page != nil {
+		page = *p.Page
+	}
+	var rows []fmp.InsiderTransaction
+	for n := 0; n < maxPages; n++ {
+		current := page + n
+		p.Page = &current
+		resp, err := c.InsiderTradingSearchGetWithResponse(ctx, &p)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected status of insider trading search: %s", resp.Status())
+		}
+		if len(*resp.JSON200) == 0 {
+			break
+		}
+		rows = append(rows, *resp.JSON200...)
+	}
+	return NewTransactions(rows), nil
+}
+
+// GetLatestTransactions fetches pages of InsiderTradingLatestGet, starting at params.Page, until
+// an empty page or maxPages pages, and decodes them.
+func GetLatestTransactions(ctx context.Context, c *fmp.ClientWithResponses, params *fmp.InsiderTradingLatestGetParams, maxPages int) ([]Transaction, error) {
+	p := *params
+	page := 0
+	if p.Page != nil {
+		page = *p.Page
+	}
+	var rows []fmp.InsiderTransaction
+	for n := 0; n < maxPages; n++ {
+		current := page + n
+		p.Page = &current
+		resp, err := c.InsiderTradingLatestGetWithResponse(ctx, &p)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected status of latest insider trading: %s", resp.Status())
+		}
+		if len(*resp.JSON200) == 0 {
+			break
+		}
+		rows = append(rows, *resp.JSON200...)
+	}
+	return NewTransactions(rows), nil
+}
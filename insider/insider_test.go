@@ -0,0 +1,87 @@
+package insider
+
+import (
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/suite"
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+type insiderSuite struct {
+	suite.Suite
+}
+
+func row(symbol, cik, name string, day int, transactionType, acquisition string, shares float64, price float32) fmp.InsiderTransaction {
+	return fmp.InsiderTransaction{
+		Symbol:                   symbol,
+		ReportingCik:             cik,
+		ReportingName:            name,
+		TransactionDate:          openapi_types.Date{Time: time.Date(2025, time.March, day, 0, 0, 0, 0, time.UTC)},
+		TransactionType:          transactionType,
+		AcquisitionOrDisposition: acquisition,
+		DirectOrIndirect:         "D",
+		SecuritiesTransacted:     shares,
+		Price:                    price,
+	}
+}
+
+func (r *insiderSuite) TestParseCode() {
+	r.Equal(CodePurchase, ParseCode("P-Purchase"))
+	r.Equal(CodeSale, ParseCode("S-Sale+OE"))
+	r.Equal(CodeExercise, ParseCode("M-Exempt"))
+	r.Equal(CodeGift, ParseCode("g"))
+	r.Equal(CodeUnknown, ParseCode(""))
+	r.Equal(CodeUnknown, ParseCode("Q-Unknown"))
+	r.Equal("Bona fide gift", CodeGift.Description())
+	r.True(CodeSale.OpenMarket())
+	r.False(CodeAward.OpenMarket())
+}
+
+func (r *insiderSuite) TestNewTransactions() {
+	transactions := NewTransactions([]fmp.InsiderTransaction{
+		row("AAPL", "1", "Cook Timothy", 10, "S-Sale", "D", 100, 200),
+		row("AAPL", "2", "Adams Katherine", 3, "A-Award", "A", 500, 0),
+	})
+	r.Len(transactions, 2)
+	r.Equal(CodeAward, transactions[0].Code)
+	r.True(transactions[0].Acquired)
+	r.True(transactions[0].Direct)
+	r.True(transactions[1].Sell())
+	r.False(transactions[1].Acquired)
+	r.InDelta(20000, transactions[1].Value(), 1e-9)
+}
+
+func (r *insiderSuite) TestNet() {
+	transactions := NewTransactions([]fmp.InsiderTransaction{
+		row("AAPL", "1", "Cook Timothy", 3, "S-Sale", "D", 100, 200),
+		row("AAPL", "1", "Cook Timothy", 5, "P-Purchase", "A", 10, 190),
+		row("AAPL", "2", "Adams Katherine", 6, "P-Purchase", "A", 50, 195),
+		// Awards and exercises are not open market trades.
+		row("AAPL", "2", "Adams Katherine", 7, "M-Exempt", "A", 1000, 0),
+		row("MSFT", "3", "Nadella Satya", 20, "P-Purchase", "A", 10, 400),
+	})
+
+	insiders := NetByInsider(transactions, time.Time{}, time.Time{})
+	r.Len(insiders, 3)
+	r.Equal("2", insiders[0].ReportingCik)
+	r.InDelta(9750, insiders[0].NetValue, 1e-9)
+	r.Equal("1", insiders[2].ReportingCik)
+	r.Equal(1, insiders[2].Buys)
+	r.Equal(1, insiders[2].Sells)
+	r.InDelta(-90, insiders[2].NetShares, 1e-9)
+	r.InDelta(1900-20000, insiders[2].NetValue, 1e-9)
+
+	symbols := NetBySymbol(transactions, time.Date(2025, time.March, 4, 0, 0, 0, 0, time.UTC), time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC))
+	r.Len(symbols, 1)
+	r.Equal("AAPL", symbols[0].Symbol)
+	r.Empty(symbols[0].ReportingCik)
+	r.Equal(2, symbols[0].Buys)
+	r.Zero(symbols[0].Sells)
+	r.InDelta(1900+9750, symbols[0].NetValue, 1e-9)
+}
+
+func TestInsiderSuite(t *testing.T) {
+	suite.Run(t, new(insiderSuite))
+}
@@ -0,0 +1,467 @@
+package financialmodelingprep
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// maxCalendarDays bounds the days searched for a session, so calendars without any trading day
+// do not loop forever.
+const maxCalendarDays = 370
+
+// clockLayouts are the layouts of opening and closing hours, e.g. "09:30 AM -04:00" and "01:00 PM".
+var clockLayouts = []string{"03:04 PM -07:00", "03:04 PM", "15:04"}
+
+// parseClock parses an hour of FMP into the time since midnight.
+func parseClock(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range clockLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid hour %q", s)
+}
+
+// Session is a trading session of an exchange.
+type Session struct {
+	Open  time.Time
+	Close time.Time
+}
+
+// Contains reports whether the exchange is open at t.
+func (s Session) Contains(t time.Time) bool {
+	return !t.Before(s.Open) && t.Before(s.Close)
+}
+
+// Holiday is a day an exchange is closed or trades adjusted hours.
+type Holiday struct {
+	Name string
+
+	// Closed is set when there is no session. Otherwise Open and Close are the adjusted hours
+	// since midnight, zero when the regular hours apply.
+	Closed bool
+	Open   time.Duration
+	Close  time.Duration
+}
+
+// ExchangeCalendar answers when an exchange trades, in its time zone.
+type ExchangeCalendar struct {
+	Exchange string
+	Location *time.Location
+
+	// Open and Close are the regular hours since midnight.
+	Open  time.Duration
+	Close time.Duration
+
+	// Weekend are the days without a session, Saturday and Sunday by default.
+	Weekend []time.Weekday
+
+	// Holidays are keyed by date, formatted as "2006-01-02".
+	Holidays map[string]Holiday
+}
+
+// NewExchangeCalendar builds the calendar of an exchange from its market hours and holidays. The
+// time zone falls back to the offset of the opening hour when it is unknown.
+func NewExchangeCalendar(hours *ExchangeMarketHours, holidays []ExchangeHoliday) (*ExchangeCalendar, error) {
+	open, err := parseClock(hours.OpeningHour)
+	if err != nil {
+		return nil, fmt.Errorf("opening hour of %s: %w", hours.Exchange, err)
+	}
+	closing, err := parseClock(hours.ClosingHour)
+	if err != nil {
+		return nil, fmt.Errorf("closing hour of %s: %w", hours.Exchange, err)
+	}
+
+	loc, err := time.LoadLocation(hours.Timezone)
+	if hours.Timezone == "" || err != nil {
+		t, err := time.Parse(clockLayouts[0], strings.TrimSpace(hours.OpeningHour))
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone %q of %s", hours.Timezone, hours.Exchange)
+		}
+		_, offset := t.Zone()
+		loc = time.FixedZone(hours.Timezone, offset)
+	}
+
+	c := &ExchangeCalendar{
+		Exchange: hours.Exchange,
+		Location: loc,
+		Open:     open,
+		Close:    closing,
+		Weekend:  []time.Weekday{time.Saturday, time.Sunday},
+		Holidays: make(map[string]Holiday, len(holidays)),
+	}
+	for i := range holidays {
+		h := &holidays[i]
+		date, err := time.Parse(time.DateOnly, h.Date)
+		if err != nil {
+			return nil, fmt.Errorf("holiday %q of %s: %w", h.Name, hours.Exchange, err)
+		}
+		holiday := Holiday{Name: h.Name, Closed: h.IsClosed}
+		if !h.IsClosed && h.AdjOpenTime != nil && *h.AdjOpenTime != "" {
+			if holiday.Open, err = parseClock(*h.AdjOpenTime); err != nil {
+				return nil, fmt.Errorf("holiday %q of %s: %w", h.Name, hours.Exchange, err)
+			}
+		}
+		if !h.IsClosed && h.AdjCloseTime != nil && *h.AdjCloseTime != "" {
+			if holiday.Close, err = parseClock(*h.AdjCloseTime); err != nil {
+				return nil, fmt.Errorf("holiday %q of %s: %w", h.Name, hours.Exchange, err)
+			}
+		}
+		c.Holidays[date.Format(time.DateOnly)] = holiday
+	}
+	return c, nil
+}
+
+// date returns midnight of the calendar date of t in the time zone of the exchange.
+func (c *ExchangeCalendar) date(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, c.Location)
+}
+
+// at returns the time of day d on date.
+func (c *ExchangeCalendar) at(date time.Time, d time.Duration) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), int(d/time.Hour), int(d%time.Hour/time.Minute), 0, 0, c.Location)
+}
+
+// session returns the session on a date, midnight in the time zone of the exchange.
+func (c *ExchangeCalendar) session(date time.Time) (Session, bool) {
+	for _, d := range c.Weekend {
+		if date.Weekday() == d {
+			return Session{}, false
+		}
+	}
+	open, closing := c.Open, c.Close
+	if h, ok := c.Holidays[date.Format(time.DateOnly)]; ok {
+		if h.Closed {
+			return Session{}, false
+		}
+		if h.Open != 0 {
+			open = h.Open
+		}
+		if h.Close != 0 {
+			closing = h.Close
+		}
+	}
+	s := Session{Open: c.at(date, open), Close: c.at(date, closing)}
+	if !s.Close.After(s.Open) {
+		// Sessions over midnight close the next day.
+		s.Close = c.at(date.AddDate(0, 0, 1), closing)
+	}
+	return s, true
+}
+
+// Session returns the session on the calendar date of day, regardless of its time zone.
+func (c *ExchangeCalendar) Session(day time.Time) (Session, bool) {
+	return c.session(c.date(day))
+}
+
+// IsTradingDay reports whether there is a session on the calendar date of day, regardless of its
+// time zone.
+func (c *ExchangeCalendar) IsTradingDay(day time.Time) bool {
+	_, ok := c.Session(day)
+	return ok
+}
+
+// IsOpen reports whether the exchange is open at t.
+func (c *ExchangeCalendar) IsOpen(t time.Time) bool {
+	date := c.date(t.In(c.Location))
+	for _, d := range []time.Time{date, date.AddDate(0, 0, -1)} {
+		if s, ok := c.session(d); ok && s.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextOpen returns the opening of the first session opening after t. It reports false when there is
+// no session within a year.
+func (c *ExchangeCalendar) NextOpen(t time.Time) (time.Time, bool) {
+	date := c.date(t.In(c.Location))
+	for i := 0; i < maxCalendarDays; i++ {
+		if s, ok := c.session(date.AddDate(0, 0, i)); ok && s.Open.After(t) {
+			return s.Open, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// PreviousSession returns the last session closed at t. It reports false when there is no session
+// within a year.
+func (c *ExchangeCalendar) PreviousSession(t time.Time) (Session, bool) {
+	date := c.date(t.In(c.Location))
+	for i := 0; i < maxCalendarDays; i++ {
+		if s, ok := c.session(date.AddDate(0, 0, -i)); ok && !s.Close.After(t) {
+			return s, true
+		}
+	}
+	return Session{}, false
+}
+
+// TradingDays returns the dates with a session between the calendar dates of from and to
+// inclusive, as midnight in the time zone of the exchange.
+func (c *ExchangeCalendar) TradingDays(from, to time.Time) []time.Time {
+	var days []time.Time
+	last := c.date(to)
+	for date := c.date(from); !date.After(last); date = date.AddDate(0, 0, 1) {
+		if _, ok := c.session(date); ok {
+			days = append(days, date)
+		}
+	}
+	return days
+}
+
+// GetExchangeMarketHours fetches the market hours of an exchange, e.g. "NASDAQ".
+func GetExchangeMarketHours(ctx context.Context, c *ClientWithResponses, exchange string) (*ExchangeMarketHours, error) {
+	resp, err := c.ExchangeMarketHoursGetWithResponse(ctx, &ExchangeMarketHoursGetParams{Exchange: exchange})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of exchange market hours: %s", resp.Status())
+	}
+	if len(*resp.JSON200) == 0 {
+		return nil, fmt.Errorf("no market hours of exchange %s", exchange)
+	}
+	return &(*resp.JSON200)[0], nil
+}
+
+// GetAllExchangeMarketHours fetches the market hours of all exchanges.
+func GetAllExchangeMarketHours(ctx context.Context, c *ClientWithResponses) ([]ExchangeMarketHours, error) {
+	resp, err := c.AllExchangeMarketHoursGetWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of all exchange market hours: %s", resp.Status())
+	}
+	return *resp.JSON200, nil
+}
+
+// GetExchangeHolidays fetches the holidays of an exchange between from and to. Zero dates use the
+// range of FMP.
+func GetExchangeHolidays(ctx context.Context, c *ClientWithResponses, exchange string, from, to time.Time) ([]ExchangeHoliday, error) {
+	p := HolidaysByExchangeGetParams{Exchange: exchange}
+	if !from.IsZero() {
+		p.From = &openapi_types.Date{Time: from}
+	}
+	if !to.IsZero() {
+		p.To = &openapi_types.Date{Time: to}
+	}
+	resp, err := c.HolidaysByExchangeGetWithResponse(ctx, &p)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of holidays by exchange: %s", resp.Status())
+	}
+	return *resp.JSON200, nil
+}
+
+// calendarRecord is a line of MarketCalendar.Save.
+type calendarRecord struct {
+	Hours   *ExchangeMarketHours `json:"hours,omitempty"`
+	Holiday *ExchangeHoliday     `json:"holiday,omitempty"`
+
+	// NoHolidays names an exchange whose holidays were fetched and found empty.
+	NoHolidays string `json:"noHolidays,omitempty"`
+}
+
+// MarketCalendar caches market hours and holidays of exchanges, so calendars can be answered
+// offline, e.g. by pollers deciding whether to call at all. Exchanges are named as in the
+// Exchange field of Exchange, e.g. "NASDAQ".
+type MarketCalendar struct {
+	mu       sync.RWMutex
+	hours    map[string]ExchangeMarketHours
+	holidays map[string]map[string]ExchangeHoliday
+}
+
+// NewMarketCalendar returns an empty calendar.
+func NewMarketCalendar() *MarketCalendar {
+	return &MarketCalendar{
+		hours:    map[string]ExchangeMarketHours{},
+		holidays: map[string]map[string]ExchangeHoliday{},
+	}
+}
+
+// AddHours adds or replaces market hours.
+func (m *MarketCalendar) AddHours(hours ...ExchangeMarketHours) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, h := range hours {
+		m.hours[strings.ToUpper(h.Exchange)] = h
+	}
+}
+
+// AddHolidays adds or replaces holidays by exchange and date.
+func (m *MarketCalendar) AddHolidays(holidays ...ExchangeHoliday) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, h := range holidays {
+		exchange := strings.ToUpper(h.Exchange)
+		if m.holidays[exchange] == nil {
+			m.holidays[exchange] = map[string]ExchangeHoliday{}
+		}
+		m.holidays[exchange][h.Date] = h
+	}
+}
+
+// Exchanges returns the exchanges with market hours, sorted.
+func (m *MarketCalendar) Exchanges() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	exchanges := make([]string, 0, len(m.hours))
+	for exchange := range m.hours {
+		exchanges = append(exchanges, exchange)
+	}
+	sort.Strings(exchanges)
+	return exchanges
+}
+
+// Calendar builds the calendar of an exchange from the cache without calling FMP.
+func (m *MarketCalendar) Calendar(exchange string) (*ExchangeCalendar, error) {
+	exchange = strings.ToUpper(exchange)
+
+	m.mu.RLock()
+	hours, ok := m.hours[exchange]
+	holidays := make([]ExchangeHoliday, 0, len(m.holidays[exchange]))
+	for _, h := range m.holidays[exchange] {
+		holidays = append(holidays, h)
+	}
+	m.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("no market hours of exchange %s", exchange)
+	}
+	return NewExchangeCalendar(&hours, holidays)
+}
+
+// Refresh fetches the market hours of all exchanges, and the holidays of the given exchanges
+// between from and to, into the cache. Zero dates use the range of FMP.
+func (m *MarketCalendar) Refresh(ctx context.Context, c *ClientWithResponses, exchanges []string, from, to time.Time) error {
+	hours, err := GetAllExchangeMarketHours(ctx, c)
+	if err != nil {
+		return err
+	}
+	m.AddHours(hours...)
+	for _, exchange := range exchanges {
+		holidays, err := GetExchangeHolidays(ctx, c, exchange, from, to)
+		if err != nil {
+			return err
+		}
+		m.AddHolidays(holidays...)
+	}
+	return nil
+}
+
+// Fetch returns the calendar of an exchange, fetching its market hours and holidays first when
+// they are not cached.
+func (m *MarketCalendar) Fetch(ctx context.Context, c *ClientWithResponses, exchange string) (*ExchangeCalendar, error) {
+	key := strings.ToUpper(exchange)
+	m.mu.RLock()
+	_, hasHours := m.hours[key]
+	_, hasHolidays := m.holidays[key]
+	m.mu.RUnlock()
+
+	if !hasHours {
+		hours, err := GetExchangeMarketHours(ctx, c, exchange)
+		if err != nil {
+			return nil, err
+		}
+		m.AddHours(*hours)
+	}
+	if !hasHolidays {
+		holidays, err := GetExchangeHolidays(ctx, c, exchange, time.Time{}, time.Time{})
+		if err != nil {
+			return nil, err
+		}
+		m.mu.Lock()
+		if m.holidays[key] == nil {
+			// Remember exchanges without holidays, so they are not fetched again.
+			m.holidays[key] = map[string]ExchangeHoliday{}
+		}
+		m.mu.Unlock()
+		m.AddHolidays(holidays...)
+	}
+	return m.Calendar(exchange)
+}
+
+// Save writes the market hours and holidays as JSON lines, sorted by exchange then date. Exchanges
+// known to have no holidays are marked, so Fetch does not request them again after Load.
+func (m *MarketCalendar) Save(w io.Writer) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	enc := json.NewEncoder(w)
+	exchanges := make([]string, 0, len(m.hours))
+	for exchange := range m.hours {
+		exchanges = append(exchanges, exchange)
+	}
+	sort.Strings(exchanges)
+	for _, exchange := range exchanges {
+		hours := m.hours[exchange]
+		if err := enc.Encode(&calendarRecord{Hours: &hours}); err != nil {
+			return err
+		}
+	}
+
+	exchanges = exchanges[:0]
+	for exchange := range m.holidays {
+		exchanges = append(exchanges, exchange)
+	}
+	sort.Strings(exchanges)
+	for _, exchange := range exchanges {
+		dates := make([]string, 0, len(m.holidays[exchange]))
+		for date := range m.holidays[exchange] {
+			dates = append(dates, date)
+		}
+		sort.Strings(dates)
+		if len(dates) == 0 {
+			if err := enc.Encode(&calendarRecord{NoHolidays: exchange}); err != nil {
+				return err
+			}
+		}
+		for _, date := range dates {
+			holiday := m.holidays[exchange][date]
+			if err := enc.Encode(&calendarRecord{Holiday: &holiday}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Load reads market hours and holidays written by Save and adds them to the cache.
+func (m *MarketCalendar) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var record calendarRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return err
+		}
+		if record.Hours != nil {
+			m.AddHours(*record.Hours)
+		}
+		if record.Holiday != nil {
+			m.AddHolidays(*record.Holiday)
+		}
+		if record.NoHolidays != "" {
+			m.mu.Lock()
+			exchange := strings.ToUpper(record.NoHolidays)
+			if m.holidays[exchange] == nil {
+				m.holidays[exchange] = map[string]ExchangeHoliday{}
+			}
+			m.mu.Unlock()
+		}
+	}
+	return scanner.Err()
+}
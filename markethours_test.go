@@ -0,0 +1,183 @@
+package financialmodelingprep
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type marketHoursSuite struct {
+	suite.Suite
+	cal *ExchangeCalendar
+}
+
+func nasdaqHours() ExchangeMarketHours {
+	return ExchangeMarketHours{
+		Exchange:    "NASDAQ",
+		Name:        "NASDAQ Global Market",
+		OpeningHour: "09:30 AM -04:00",
+		ClosingHour: "04:00 PM -04:00",
+		Timezone:    "America/New_York",
+	}
+}
+
+func nasdaqHolidays() []ExchangeHoliday {
+	return []ExchangeHoliday{
+		{Exchange: "NASDAQ", Date: "2025-11-27", Name: "Thanksgiving Day", IsClosed: true},
+		{Exchange: "NASDAQ", Date: "2025-11-28", Name: "Day After Thanksgiving", AdjCloseTime: stringPtr("01:00 PM")},
+		{Exchange: "NASDAQ", Date: "2025-12-25", Name: "Christmas", IsClosed: true},
+	}
+}
+
+func utc(y int, m time.Month, d, h, min int) time.Time {
+	return time.Date(y, m, d, h, min, 0, 0, time.UTC)
+}
+
+func (r *marketHoursSuite) SetupTest() {
+	hours := nasdaqHours()
+	cal, err := NewExchangeCalendar(&hours, nasdaqHolidays())
+	r.Require().NoError(err)
+	r.cal = cal
+}
+
+func (r *marketHoursSuite) TestIsOpen() {
+	for _, tc := range []struct {
+		t    time.Time
+		open bool
+	}{
+		{utc(2025, 12, 22, 14, 29), false},
+		{utc(2025, 12, 22, 14, 30), true},
+		{utc(2025, 12, 22, 20, 59), true},
+		{utc(2025, 12, 22, 21, 0), false},
+		{utc(2025, 12, 25, 15, 0), false},
+		{utc(2025, 12, 27, 15, 0), false},
+		{utc(2025, 11, 28, 17, 59), true},
+		{utc(2025, 11, 28, 18, 0), false},
+		// Daylight saving time.
+		{utc(2025, 3, 7, 14, 0), false},
+		{utc(2025, 3, 10, 14, 0), true},
+	} {
+		r.Equal(tc.open, r.cal.IsOpen(tc.t), tc.t.String())
+	}
+}
+
+func (r *marketHoursSuite) TestNextOpen() {
+	for _, tc := range []struct {
+		t    time.Time
+		next time.Time
+	}{
+		{utc(2025, 12, 22, 10, 0), utc(2025, 12, 22, 14, 30)},
+		{utc(2025, 12, 22, 15, 0), utc(2025, 12, 23, 14, 30)},
+		{utc(2025, 12, 24, 22, 0), utc(2025, 12, 26, 14, 30)},
+		{utc(2025, 12, 26, 21, 30), utc(2025, 12, 29, 14, 30)},
+	} {
+		next, ok := r.cal.NextOpen(tc.t)
+		r.True(ok)
+		r.True(tc.next.Equal(next), "%s: %s", tc.t, next)
+	}
+}
+
+func (r *marketHoursSuite) TestPreviousSession() {
+	for _, t := range []time.Time{utc(2025, 12, 29, 14, 0), utc(2025, 12, 29, 15, 0), utc(2025, 12, 27, 12, 0)} {
+		s, ok := r.cal.PreviousSession(t)
+		r.True(ok)
+		r.True(utc(2025, 12, 26, 14, 30).Equal(s.Open), t.String())
+		r.True(utc(2025, 12, 26, 21, 0).Equal(s.Close), t.String())
+	}
+
+	s, ok := r.cal.PreviousSession(utc(2025, 12, 1, 12, 0))
+	r.True(ok)
+	r.True(utc(2025, 11, 28, 18, 0).Equal(s.Close))
+}
+
+func (r *marketHoursSuite) TestTradingDays() {
+	days := r.cal.TradingDays(fixtureDate(2025, 12, 22).Time, fixtureDate(2025, 12, 28).Time)
+	r.Len(days, 4)
+	for i, d := range []int{22, 23, 24, 26} {
+		r.Equal(d, days[i].Day())
+		r.Equal(r.cal.Location, days[i].Location())
+	}
+	r.True(r.cal.IsTradingDay(fixtureDate(2025, 12, 24).Time))
+	r.False(r.cal.IsTradingDay(fixtureDate(2025, 12, 25).Time))
+	r.Empty(r.cal.TradingDays(fixtureDate(2025, 12, 27).Time, fixtureDate(2025, 12, 28).Time))
+}
+
+func (r *marketHoursSuite) TestFixedZone() {
+	hours := ExchangeMarketHours{Exchange: "XETRA", OpeningHour: "09:00 AM +01:00", ClosingHour: "05:30 PM +01:00", Timezone: "Unknown/Zone"}
+	cal, err := NewExchangeCalendar(&hours, nil)
+	r.Require().NoError(err)
+	r.True(cal.IsOpen(utc(2025, 12, 22, 8, 0)))
+	r.False(cal.IsOpen(utc(2025, 12, 22, 16, 30)))
+
+	hours.OpeningHour = "9 o'clock"
+	_, err = NewExchangeCalendar(&hours, nil)
+	r.Error(err)
+}
+
+func (r *marketHoursSuite) TestMarketCalendar() {
+	m := NewMarketCalendar()
+	_, err := m.Calendar("NASDAQ")
+	r.Error(err)
+
+	m.AddHours(nasdaqHours(), ExchangeMarketHours{Exchange: "LSE", OpeningHour: "08:00 AM +00:00", ClosingHour: "04:30 PM +00:00", Timezone: "Europe/London"})
+	m.AddHolidays(nasdaqHolidays()...)
+	r.Equal([]string{"LSE", "NASDAQ"}, m.Exchanges())
+
+	var buf bytes.Buffer
+	r.Require().NoError(m.Save(&buf))
+
+	loaded := NewMarketCalendar()
+	r.Require().NoError(loaded.Load(&buf))
+	r.Equal([]string{"LSE", "NASDAQ"}, loaded.Exchanges())
+
+	cal, err := loaded.Calendar("nasdaq")
+	r.Require().NoError(err)
+	r.Len(cal.Holidays, 3)
+	r.False(cal.IsOpen(utc(2025, 12, 25, 15, 0)))
+
+	cal, err = loaded.Calendar("LSE")
+	r.Require().NoError(err)
+	r.True(cal.IsOpen(utc(2025, 12, 25, 9, 0)))
+}
+
+func (r *marketHoursSuite) TestMarketCalendarFetch() {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		paths = append(paths, req.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/exchange-market-hours":
+			json.NewEncoder(w).Encode([]ExchangeMarketHours{{Exchange: "JPX", OpeningHour: "09:00 AM +09:00", ClosingHour: "03:30 PM +09:00", Timezone: "Asia/Tokyo"}})
+		default:
+			json.NewEncoder(w).Encode([]ExchangeHoliday{})
+		}
+	}))
+	defer server.Close()
+	c, err := NewClientWithResponses(server.URL)
+	r.Require().NoError(err)
+
+	m := NewMarketCalendar()
+	_, err = m.Fetch(context.Background(), c, "JPX")
+	r.Require().NoError(err)
+	r.Equal([]string{"/exchange-market-hours", "/holidays-by-exchange"}, paths)
+
+	// An exchange without holidays is not requested again, even after a round trip.
+	var buf bytes.Buffer
+	r.Require().NoError(m.Save(&buf))
+	loaded := NewMarketCalendar()
+	r.Require().NoError(loaded.Load(&buf))
+	cal, err := loaded.Fetch(context.Background(), c, "jpx")
+	r.Require().NoError(err)
+	r.Empty(cal.Holidays)
+	r.Len(paths, 2)
+}
+
+func TestMarketHoursSuite(t *testing.T) {
+	suite.Run(t, new(marketHoursSuite))
+}
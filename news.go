@@ -0,0 +1,517 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Article is a parsed NewsArticle.
+type Article struct {
+	Title     string
+	Text      string
+	URL       string
+	Image     string
+	Site      string
+	Publisher string
+
+	// Published is the publication time, in UTC.
+	Published time.Time
+
+	// CanonicalURL is the URL stripped of the variations of syndication and tracking, see
+	// CanonicalURL.
+	CanonicalURL string
+
+	// Symbols are the tickers of the article, from its Symbol and tagging, sorted.
+	Symbols []string
+}
+
+// NewArticle parses a NewsArticle. Published dates without a zone are expected in UTC.
+func NewArticle(n *NewsArticle) (*Article, error) {
+	var (
+		t   time.Time
+		err error
+	)
+	for _, layout := range []string{time.DateTime, time.RFC3339, "2006-01-02T15:04:05"} {
+		if t, err = time.Parse(layout, n.PublishedDate); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid published date of %s: %w", n.Url, err)
+	}
+	a := &Article{
+		Title:        n.Title,
+		Text:         n.Text,
+		URL:          n.Url,
+		Image:        n.Image,
+		Site:         n.Site,
+		Publisher:    n.Publisher,
+		Published:    t.UTC(),
+		CanonicalURL: CanonicalURL(n.Url),
+	}
+	if n.Symbol != nil {
+		for _, s := range strings.Split(*n.Symbol, ",") {
+			if s = strings.ToUpper(strings.TrimSpace(s)); s != "" {
+				a.Symbols = append(a.Symbols, s)
+			}
+		}
+	}
+	a.Symbols = sortedUnique(a.Symbols)
+	return a, nil
+}
+
+func sortedUnique(values []string) []string {
+	sort.Strings(values)
+	unique := values[:0]
+	for i, v := range values {
+		if i == 0 || v != values[i-1] {
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
+
+// trackingParams are query parameters that do not change the page served.
+var trackingParams = map[string]bool{
+	"fbclid":     true,
+	"gclid":      true,
+	"dclid":      true,
+	"msclkid":    true,
+	"mc_cid":     true,
+	"mc_eid":     true,
+	"cmpid":      true,
+	"ncid":       true,
+	"ref":        true,
+	"ref_src":    true,
+	"src":        true,
+	"source":     true,
+	"guccounter": true,
+	"yptr":       true,
+}
+
+// CanonicalURL normalizes a URL so that syndicated and tracked variants of the same page compare
+// equal: the scheme becomes https, the host is lowercased and loses its www, m, mobile or amp
+// prefix, tracking parameters, fragments, AMP suffixes and trailing slashes are removed, and the
+// remaining query parameters are sorted. Unparsable URLs are returned trimmed.
+func CanonicalURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return raw
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, prefix := range []string{"www.", "m.", "mobile.", "amp."} {
+		if strings.HasPrefix(host, prefix) {
+			host = strings.TrimPrefix(host, prefix)
+			break
+		}
+	}
+	if port := u.Port(); port != "" && port != "80" && port != "443" {
+		host += ":" + port
+	}
+
+	path := strings.TrimRight(u.Path, "/")
+	path = strings.TrimSuffix(path, "/amp")
+	path = strings.TrimSuffix(path, ".amp")
+
+	query := u.Query()
+	for k := range query {
+		if lower := strings.ToLower(k); strings.HasPrefix(lower, "utm_") || trackingParams[lower] {
+			query.Del(k)
+		}
+	}
+
+	c := url.URL{Scheme: "https", Host: host, Path: path, RawQuery: query.Encode()}
+	return c.String()
+}
+
+var titleStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "at": true, "for": true, "in": true, "is": true,
+	"of": true, "on": true, "the": true, "to": true, "with": true,
+}
+
+// titleTokens returns the distinct words of a title, lowercased and without stop words. A short
+// trailing segment after " - " or " | ", usually the name of the publisher, is dropped.
+func titleTokens(title string) map[string]bool {
+	for _, sep := range []string{" - ", " | "} {
+		if i := strings.LastIndex(title, sep); i > 0 && len(strings.Fields(title[i+len(sep):])) <= 3 {
+			title = title[:i]
+		}
+	}
+	tokens := map[string]bool{}
+	for _, t := range textTokens(title) {
+		if !titleStopWords[t.word] {
+			tokens[t.word] = true
+		}
+	}
+	return tokens
+}
+
+func jaccard(a, b map[string]bool) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+	common := 0
+	for t := range a {
+		if b[t] {
+			common++
+		}
+	}
+	return float64(common) / float64(len(a)+len(b)-common)
+}
+
+// TitleSimilarity returns the Jaccard similarity of the words of two titles, from 0 to 1,
+// ignoring case, punctuation, stop words and a trailing publisher name.
+func TitleSimilarity(a, b string) float64 {
+	return jaccard(titleTokens(a), titleTokens(b))
+}
+
+type textToken struct {
+	word string
+
+	// capitalized is set when the word starts with an upper case letter in the text.
+	capitalized bool
+}
+
+// textTokens splits text into lowercased words of letters and digits.
+func textTokens(text string) []textToken {
+	var tokens []textToken
+	for _, f := range strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		tokens = append(tokens, textToken{
+			word:        strings.ToLower(f),
+			capitalized: unicode.IsUpper([]rune(f)[0]),
+		})
+	}
+	return tokens
+}
+
+// companySuffixes are the legal forms and share class words stripped from the end of company
+// names.
+var companySuffixes = map[string]bool{
+	"inc": true, "incorporated": true, "corp": true, "corporation": true, "co": true,
+	"company": true, "ltd": true, "limited": true, "plc": true, "llc": true, "lp": true,
+	"sa": true, "ag": true, "nv": true, "se": true, "holdings": true, "holding": true,
+	"group": true, "class": true, "common": true, "stock": true, "shares": true,
+}
+
+// minCompanyNameLength is the fewest letters and digits of a tagged company name.
+const minCompanyNameLength = 4
+
+type companyName struct {
+	words  []string
+	symbol string
+}
+
+// SymbolDictionary tags texts with the tickers of the companies they mention.
+type SymbolDictionary struct {
+	symbols map[string]bool
+
+	// names are indexed by their first word, longest first.
+	names map[string][]companyName
+}
+
+// NewSymbolDictionary builds a dictionary from the companies of StockListGet. Names are stripped
+// of a leading "The" and of their legal form; when several listings share a name, the first one without an exchange
+// suffix, e.g. "AAPL" over "AAPL.NE", tags it.
+func NewSymbolDictionary(companies []CompanySymbol) *SymbolDictionary {
+	d := &SymbolDictionary{symbols: map[string]bool{}, names: map[string][]companyName{}}
+	byName := map[string]*companyName{}
+	var order []string
+	for _, c := range companies {
+		symbol := strings.ToUpper(strings.TrimSpace(c.Symbol))
+		if symbol == "" {
+			continue
+		}
+		d.symbols[symbol] = true
+
+		var words []string
+		for _, t := range textTokens(c.CompanyName) {
+			words = append(words, t.word)
+		}
+		for len(words) > 0 && companySuffixes[words[len(words)-1]] {
+			words = words[:len(words)-1]
+		}
+		if len(words) > 0 && words[0] == "the" {
+			words = words[1:]
+		}
+		if len(strings.Join(words, "")) < minCompanyNameLength {
+			continue
+		}
+
+		key := strings.Join(words, " ")
+		if existing, ok := byName[key]; ok {
+			if strings.Contains(existing.symbol, ".") && !strings.Contains(symbol, ".") {
+				existing.symbol = symbol
+			}
+			continue
+		}
+		byName[key] = &companyName{words: words, symbol: symbol}
+		order = append(order, key)
+	}
+	for _, key := range order {
+		n := byName[key]
+		d.names[n.words[0]] = append(d.names[n.words[0]], *n)
+	}
+	for _, names := range d.names {
+		sort.SliceStable(names, func(i, j int) bool {
+			return len(names[i].words) > len(names[j].words)
+		})
+	}
+	return d
+}
+
+// Has reports whether the dictionary knows the symbol.
+func (d *SymbolDictionary) Has(symbol string) bool {
+	return d.symbols[strings.ToUpper(symbol)]
+}
+
+// tickerPatterns match explicit references to tickers: cashtags such as "$AAPL" and exchange
+// prefixes such as "(NASDAQ: AAPL)".
+var tickerPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`\$([A-Z][A-Z0-9.\-]{0,9})\b`),
+	regexp.MustCompile(`\b(?:NASDAQ|NYSE|NYSEARCA|NYSEAMERICAN|AMEX|OTC|OTCQX|OTCQB|TSX|TSXV|LSE|ASX)\s*:\s*([A-Z][A-Z0-9.\-]{0,9})\b`),
+}
+
+// Tag returns the known tickers referenced in the text, sorted: explicit tickers and company
+// names matched as whole words. Single word names must be capitalized in the text, so that e.g.
+// "target" does not tag Target.
+func (d *SymbolDictionary) Tag(text string) []string {
+	var symbols []string
+	for _, p := range tickerPatterns {
+		for _, m := range p.FindAllStringSubmatch(text, -1) {
+			if d.symbols[m[1]] {
+				symbols = append(symbols, m[1])
+			}
+		}
+	}
+
+	tokens := textTokens(text)
+	for i := 0; i < len(tokens); i++ {
+		for _, n := range d.names[tokens[i].word] {
+			if i+len(n.words) > len(tokens) || len(n.words) == 1 && !tokens[i].capitalized {
+				continue
+			}
+			match := true
+			for j, w := range n.words[1:] {
+				if tokens[i+1+j].word != w {
+					match = false
+					break
+				}
+			}
+			if match {
+				symbols = append(symbols, n.symbol)
+				i += len(n.words) - 1
+				break
+			}
+		}
+	}
+	return sortedUnique(symbols)
+}
+
+// GetSymbolDictionary builds a SymbolDictionary from StockListGet.
+func GetSymbolDictionary(ctx context.Context, c *ClientWithResponses) (*SymbolDictionary, error) {
+	resp, err := c.StockListGetWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of stock list: %s", resp.Status())
+	}
+	return NewSymbolDictionary(*resp.JSON200), nil
+}
+
+// Defaults applied to the zero values of NewsParams.
+const (
+	DefaultTitleSimilarity = 0.6
+	DefaultDuplicateWindow = 48 * time.Hour
+)
+
+// NewsParams defines parameters for ProcessNews.
+type NewsParams struct {
+	// Similarity is the TitleSimilarity from which articles are duplicates.
+	Similarity float64
+
+	// Window is the longest time between duplicates with similar titles. Articles with the same
+	// canonical URL are duplicates regardless.
+	Window time.Duration
+
+	// Symbols tags the articles with the tickers they mention, if set.
+	Symbols *SymbolDictionary
+}
+
+// Story groups the duplicates of a news story.
+type Story struct {
+	// ID is the canonical URL of the first article.
+	ID string
+
+	// Articles are the duplicates, earliest first.
+	Articles []Article
+
+	// Symbols are the tickers of all the articles, sorted.
+	Symbols []string
+}
+
+// Primary returns the first article published.
+func (s *Story) Primary() *Article {
+	return &s.Articles[0]
+}
+
+// Sites returns the distinct sites that published the story, in order of publication.
+func (s *Story) Sites() []string {
+	var sites []string
+	seen := map[string]bool{}
+	for i := range s.Articles {
+		if site := s.Articles[i].Site; site != "" && !seen[site] {
+			seen[site] = true
+			sites = append(sites, site)
+		}
+	}
+	return sites
+}
+
+// GroupStories groups duplicate articles into stories, latest story first.
+func GroupStories(articles []Article, params *NewsParams) []Story {
+	similarity, window := DefaultTitleSimilarity, DefaultDuplicateWindow
+	if params != nil {
+		if params.Similarity > 0 {
+			similarity = params.Similarity
+		}
+		if params.Window > 0 {
+			window = params.Window
+		}
+	}
+
+	sorted := append([]Article(nil), articles...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Published.Before(sorted[j].Published)
+	})
+
+	var (
+		stories []Story
+		tokens  [][]map[string]bool
+	)
+	byURL := map[string]int{}
+	for _, a := range sorted {
+		titleWords := titleTokens(a.Title)
+		s, ok := byURL[a.CanonicalURL]
+		for i := len(stories) - 1; !ok && i >= 0; i-- {
+			last := stories[i].Articles[len(stories[i].Articles)-1]
+			if a.Published.Sub(last.Published) > window {
+				continue
+			}
+			for _, t := range tokens[i] {
+				if jaccard(titleWords, t) >= similarity {
+					s, ok = i, true
+					break
+				}
+			}
+		}
+		if !ok {
+			s = len(stories)
+			stories = append(stories, Story{ID: a.CanonicalURL})
+			tokens = append(tokens, nil)
+		}
+		stories[s].Articles = append(stories[s].Articles, a)
+		stories[s].Symbols = sortedUnique(append(stories[s].Symbols, a.Symbols...))
+		tokens[s] = append(tokens[s], titleWords)
+		if _, ok := byURL[a.CanonicalURL]; !ok {
+			byURL[a.CanonicalURL] = s
+		}
+	}
+
+	sort.SliceStable(stories, func(i, j int) bool {
+		return stories[i].Primary().Published.After(stories[j].Primary().Published)
+	})
+	return stories
+}
+
+// ProcessNews parses NewsArticle rows, tags them with tickers and groups the duplicates into
+// stories, latest first. The params may be nil.
+func ProcessNews(news []NewsArticle, params *NewsParams) ([]Story, error) {
+	articles := make([]Article, 0, len(news))
+	for i := range news {
+		a, err := NewArticle(&news[i])
+		if err != nil {
+			return nil, err
+		}
+		if params != nil && params.Symbols != nil {
+			a.Symbols = sortedUnique(append(a.Symbols, params.Symbols.Tag(a.Title+"\n"+a.Text)...))
+		}
+		articles = append(articles, *a)
+	}
+	return GroupStories(articles, params), nil
+}
+
+// GetGeneralNews fetches NewsGeneralLatestGet and processes the articles into stories.
+func GetGeneralNews(ctx context.Context, c *ClientWithResponses, params *NewsGeneralLatestGetParams, np *NewsParams) ([]Story, error) {
+	resp, err := c.NewsGeneralLatestGetWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of general news: %s", resp.Status())
+	}
+	return ProcessNews(*resp.JSON200, np)
+}
+
+// GetStockNews fetches NewsStockLatestGet and processes the articles into stories.
+func GetStockNews(ctx context.Context, c *ClientWithResponses, params *NewsStockLatestGetParams, np *NewsParams) ([]Story, error) {
+	resp, err := c.NewsStockLatestGetWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of stock news: %s", resp.Status())
+	}
+	return ProcessNews(*resp.JSON200, np)
+}
+
+func newsFeed(title, link string, stories []Story) *jsonFeed {
+	feed := &jsonFeed{Title: title, HomePageURL: link}
+	for i := range stories {
+		s := &stories[i]
+		a := s.Primary()
+		published := a.Published
+		item := jsonFeedItem{
+			ID:            s.ID,
+			URL:           a.URL,
+			Title:         a.Title,
+			ContentText:   a.Text,
+			Image:         a.Image,
+			DatePublished: &published,
+			Tags:          s.Symbols,
+			Extension:     map[string]any{"_story": s},
+		}
+		if sites := s.Sites(); len(sites) > 1 {
+			item.Summary = "Also reported by " + strings.Join(sites[1:], ", ")
+		}
+		feed.Items = append(feed.Items, item)
+	}
+	return feed
+}
+
+// WriteNewsRSS writes the stories as an RSS 2.0 feed named title, linking to link.
+func WriteNewsRSS(w io.Writer, title, link string, stories []Story) error {
+	return writeRSS(w, newsFeed(title, link, stories))
+}
+
+// WriteNewsAtom writes the stories as an Atom feed named title, linking to link.
+func WriteNewsAtom(w io.Writer, title, link string, stories []Story) error {
+	return writeAtom(w, newsFeed(title, link, stories))
+}
+
+// WriteNewsJSONFeed writes the stories as a JSON Feed named title, linking to link. Each item
+// carries the story under the "_story" extension.
+func WriteNewsJSONFeed(w io.Writer, title, link string, stories []Story) error {
+	return writeJSONFeed(w, newsFeed(title, link, stories))
+}
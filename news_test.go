@@ -0,0 +1,167 @@
+package financialmodelingprep
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type newsSuite struct {
+	suite.Suite
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func (r *newsSuite) dictionary() *SymbolDictionary {
+	return NewSymbolDictionary([]CompanySymbol{
+		{Symbol: "AAPL.NE", CompanyName: "Apple Inc."},
+		{Symbol: "AAPL", CompanyName: "Apple Inc."},
+		{Symbol: "TGT", CompanyName: "Target Corporation"},
+		{Symbol: "BAC", CompanyName: "Bank of America Corporation"},
+		{Symbol: "GS", CompanyName: "The Goldman Sachs Group, Inc."},
+		{Symbol: "HP", CompanyName: "H&P Inc."},
+		{Symbol: "NVDA", CompanyName: "NVIDIA Corporation"},
+	})
+}
+
+func (r *newsSuite) news() []NewsArticle {
+	return []NewsArticle{
+		{
+			Title:         "Apple beats estimates as iPhone sales jump - Reuters",
+			Text:          "Apple reported record revenue.",
+			Url:           "https://www.reuters.com/technology/apple-beats/?utm_source=twitter",
+			Site:          "reuters.com",
+			PublishedDate: "2025-02-03 21:00:00",
+			Symbol:        stringPtr("AAPL"),
+		},
+		{
+			Title:         "Apple Beats Estimates as iPhone Sales Jump",
+			Text:          "Shares of $AAPL rose after hours.",
+			Url:           "https://finance.yahoo.com/news/apple-beats-estimates.html",
+			Site:          "finance.yahoo.com",
+			PublishedDate: "2025-02-03T21:30:00.000Z",
+		},
+		{
+			Title:         "Apple beats estimates as iPhone sales jump",
+			Text:          "Syndicated copy.",
+			Url:           "http://m.reuters.com/technology/apple-beats/amp#top",
+			Site:          "reuters.com",
+			PublishedDate: "2025-02-05 08:00:00",
+		},
+		{
+			Title:         "Goldman Sachs and Bank of America lead bank rally",
+			Text:          "Banks rallied (NYSE: GS) while a target was missed.",
+			Url:           "https://www.cnbc.com/banks.html",
+			Site:          "cnbc.com",
+			PublishedDate: "2025-02-04 15:00:00",
+		},
+		{
+			Title:         "Apple beats estimates as iPhone sales jump",
+			Url:           "https://www.marketwatch.com/story/apple",
+			Site:          "marketwatch.com",
+			PublishedDate: "2025-02-08 00:00:00",
+		},
+	}
+}
+
+func (r *newsSuite) TestCanonicalURL() {
+	r.Equal("https://reuters.com/technology/apple-beats", CanonicalURL("http://www.Reuters.com/technology/apple-beats/?utm_source=x&UTM_Medium=y#top"))
+	r.Equal("https://reuters.com/technology/apple-beats", CanonicalURL("https://amp.reuters.com/technology/apple-beats/amp"))
+	r.Equal("https://example.com/a?id=1&page=2", CanonicalURL("https://example.com/a?page=2&fbclid=z&id=1"))
+	r.Equal("https://example.com:8080/a", CanonicalURL("https://example.com:8080/a"))
+	r.Equal("not a url", CanonicalURL(" not a url "))
+}
+
+func (r *newsSuite) TestTitleSimilarity() {
+	r.InDelta(1, TitleSimilarity("Apple beats estimates - Reuters", "APPLE BEATS ESTIMATES!"), 1e-9)
+	r.InDelta(0.5, TitleSimilarity("Apple beats estimates", "Apple misses estimates"), 1e-9)
+	r.Zero(TitleSimilarity("", ""))
+}
+
+func (r *newsSuite) TestTag() {
+	d := r.dictionary()
+	r.True(d.Has("aapl"))
+	r.False(d.Has("MSFT"))
+
+	r.Equal([]string{"AAPL"}, d.Tag("Apple unveils a new iPhone"))
+	// Lower case single words are not names.
+	r.Empty(d.Tag("an apple a day hits the target"))
+	r.Equal([]string{"TGT"}, d.Tag("Target cuts guidance"))
+	r.Equal([]string{"BAC", "GS"}, d.Tag("Goldman Sachs upgrades Bank of America"))
+	r.Equal([]string{"NVDA"}, d.Tag("Shares of $NVDA and $XYZ rose"))
+	r.Equal([]string{"GS"}, d.Tag("The bank (NYSE: GS) reported"))
+	// Short single word names are skipped.
+	r.Empty(d.Tag("H&P was mentioned"))
+}
+
+func (r *newsSuite) TestProcessNews() {
+	stories, err := ProcessNews(r.news(), &NewsParams{Symbols: r.dictionary()})
+	r.NoError(err)
+	r.Len(stories, 3)
+
+	r.Equal("https://cnbc.com/banks.html", stories[1].ID)
+	r.Equal([]string{"BAC", "GS"}, stories[1].Symbols)
+
+	// The syndicated copy shares the canonical URL, the second article a similar title.
+	apple := stories[2]
+	r.Equal("https://reuters.com/technology/apple-beats", apple.ID)
+	r.Len(apple.Articles, 3)
+	r.Equal([]string{"reuters.com", "finance.yahoo.com"}, apple.Sites())
+	r.Equal([]string{"AAPL"}, apple.Symbols)
+	r.Equal(time.Date(2025, time.February, 3, 21, 0, 0, 0, time.UTC), apple.Primary().Published)
+
+	// The similar title published long after is another story, unless the window covers it.
+	r.Equal("https://marketwatch.com/story/apple", stories[0].ID)
+	stories, err = ProcessNews(r.news(), &NewsParams{Window: 72 * time.Hour})
+	r.NoError(err)
+	r.Len(stories, 2)
+	r.Len(stories[1].Articles, 4)
+
+	_, err = ProcessNews([]NewsArticle{{Url: "https://example.com", PublishedDate: "yesterday"}}, nil)
+	r.Error(err)
+}
+
+func (r *newsSuite) TestFeeds() {
+	stories, err := ProcessNews(r.news(), &NewsParams{Symbols: r.dictionary(), Window: 72 * time.Hour})
+	r.Require().NoError(err)
+
+	var buf bytes.Buffer
+	r.NoError(WriteNewsRSS(&buf, "Market news", "https://example.com/news", stories))
+	var rss rssFeed
+	r.NoError(xml.Unmarshal(buf.Bytes(), &rss))
+	r.Equal("2.0", rss.Version)
+	r.Len(rss.Channel.Items, 2)
+	item := rss.Channel.Items[1]
+	r.Equal("https://reuters.com/technology/apple-beats", item.GUID.Value)
+	r.Equal("Mon, 03 Feb 2025 21:00:00 +0000", item.PubDate)
+	r.Equal([]string{"AAPL"}, item.Categories)
+
+	buf.Reset()
+	r.NoError(WriteNewsAtom(&buf, "Market news", "https://example.com/news", stories))
+	var atom atomFeed
+	r.NoError(xml.Unmarshal(buf.Bytes(), &atom))
+	r.Equal("urn:Market%20news", atom.ID)
+	r.Equal("2025-02-04T15:00:00Z", atom.Updated)
+	r.Len(atom.Entries, 2)
+	r.Equal("2025-02-03T21:00:00Z", atom.Entries[1].Published)
+	r.Equal("Also reported by finance.yahoo.com, marketwatch.com", atom.Entries[1].Summary)
+	r.Equal("https://www.reuters.com/technology/apple-beats/?utm_source=twitter", atom.Entries[1].Links[0].Href)
+
+	buf.Reset()
+	r.NoError(WriteNewsJSONFeed(&buf, "Market news", "https://example.com/news", stories))
+	var feed map[string]any
+	r.NoError(json.Unmarshal(buf.Bytes(), &feed))
+	items := feed["items"].([]any)
+	r.Len(items, 2)
+	r.Contains(items[1].(map[string]any), "_story")
+}
+
+func TestNewsSuite(t *testing.T) {
+	suite.Run(t, new(newsSuite))
+}
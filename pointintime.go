@@ -0,0 +1,458 @@
+package financialmodelingprep
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	keyMetricsName      = "key-metrics"
+	financialRatiosName = "ratios"
+
+	// acceptedDateLayout is the layout of the "acceptedDate" field of the statements.
+	acceptedDateLayout = time.DateTime
+
+	// defaultReportingLag is used to estimate when a period became public when nothing is known
+	// about its filing.
+	defaultReportingLag = 90 * 24 * time.Hour
+)
+
+// edgarLocation is the time zone of the accepted dates, which are EDGAR acceptance times.
+var edgarLocation = func() *time.Location {
+	if loc, err := time.LoadLocation("America/New_York"); err == nil {
+		return loc
+	}
+	return time.FixedZone("EST", -5*60*60)
+}()
+
+// PointInTimeVersion is a single version of a record, together with the time it became public.
+type PointInTimeVersion struct {
+	KnownAt time.Time
+
+	// Value is one of *IncomeStatement, *BalanceSheetStatement, *CashFlowStatement, *KeyMetrics or
+	// *FinancialRatios.
+	Value interface{}
+}
+
+type pointInTimeEntry struct {
+	kind    string
+	period  FinancialPeriod
+	date    time.Time
+	knownAt time.Time // Zero when the time must be derived from the statements of the period.
+	value   interface{}
+}
+
+// PointInTime keeps every version of the statements, key metrics and ratios of a universe of
+// companies keyed by the time they became public, so backtests can ask what was known on a given
+// date without look-ahead bias.
+//
+// Statements become known at their accepted date, or their filing date when the former is missing.
+// Key metrics and ratios carry no filing information, so they become known at the time given when
+// adding them, usually when they were fetched, as they reflect the figures restated until then.
+// Without a time they become known with the latest statement of the same fiscal period, or
+// ReportingLag after the period end when no such statement was added.
+type PointInTime struct {
+	// ReportingLag is the delay after the period end at which a period is assumed to be public when
+	// nothing is known about its filing.
+	ReportingLag time.Duration
+
+	mu      sync.RWMutex
+	symbols map[string][]pointInTimeEntry
+}
+
+// NewPointInTime returns an empty store.
+func NewPointInTime() *PointInTime {
+	return &PointInTime{
+		ReportingLag: defaultReportingLag,
+		symbols:      map[string][]pointInTimeEntry{},
+	}
+}
+
+func statementKnownAt(acceptedDate string, filingDate time.Time) time.Time {
+	if t, err := time.ParseInLocation(acceptedDateLayout, acceptedDate, edgarLocation); err == nil {
+		return t
+	}
+	return filingDate
+}
+
+func (p *PointInTime) add(symbol string, e pointInTimeEntry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Adding the same version twice replaces it.
+	entries := p.symbols[symbol]
+	for i := range entries {
+		if entries[i].kind == e.kind && entries[i].period == e.period && entries[i].knownAt.Equal(e.knownAt) {
+			entries[i] = e
+			return
+		}
+	}
+	p.symbols[symbol] = append(entries, e)
+}
+
+// AddIncomeStatements adds income statements to the store. Every distinct accepted date of a period
+// is kept as a separate version.
+func (p *PointInTime) AddIncomeStatements(statements ...IncomeStatement) {
+	for i := range statements {
+		s := statements[i]
+		p.add(s.Symbol, pointInTimeEntry{
+			kind:    incomeStatementName,
+			period:  FinancialPeriod{FiscalYear: s.FiscalYear, Period: s.Period},
+			date:    s.Date.Time,
+			knownAt: statementKnownAt(s.AcceptedDate, s.FilingDate.Time),
+			value:   &s,
+		})
+	}
+}
+
+// AddBalanceSheetStatements adds balance sheet statements to the store, see AddIncomeStatements.
+func (p *PointInTime) AddBalanceSheetStatements(statements ...BalanceSheetStatement) {
+	for i := range statements {
+		s := statements[i]
+		p.add(s.Symbol, pointInTimeEntry{
+			kind:    balanceSheetStatementName,
+			period:  FinancialPeriod{FiscalYear: s.FiscalYear, Period: s.Period},
+			date:    s.Date.Time,
+			knownAt: statementKnownAt(s.AcceptedDate, s.FilingDate.Time),
+			value:   &s,
+		})
+	}
+}
+
+// AddCashFlowStatements adds cash flow statements to the store, see AddIncomeStatements.
+func (p *PointInTime) AddCashFlowStatements(statements ...CashFlowStatement) {
+	for i := range statements {
+		s := statements[i]
+		p.add(s.Symbol, pointInTimeEntry{
+			kind:    cashFlowStatementName,
+			period:  FinancialPeriod{FiscalYear: s.FiscalYear, Period: s.Period},
+			date:    s.Date.Time,
+			knownAt: statementKnownAt(s.AcceptedDate, s.FilingDate.Time),
+			value:   &s,
+		})
+	}
+}
+
+// AddKeyMetrics adds key metrics known at the given time, e.g. when they were fetched, to the store.
+// Every distinct time of a period is kept as a separate version. A zero time derives it from the
+// statements, see PointInTime.
+func (p *PointInTime) AddKeyMetrics(knownAt time.Time, metrics ...KeyMetrics) {
+	for i := range metrics {
+		m := metrics[i]
+		p.add(m.Symbol, pointInTimeEntry{
+			kind:    keyMetricsName,
+			period:  FinancialPeriod{FiscalYear: m.FiscalYear, Period: m.Period},
+			date:    m.Date.Time,
+			knownAt: knownAt,
+			value:   &m,
+		})
+	}
+}
+
+// AddFinancialRatios adds ratios known at the given time to the store, see AddKeyMetrics.
+func (p *PointInTime) AddFinancialRatios(knownAt time.Time, ratios ...FinancialRatios) {
+	for i := range ratios {
+		r := ratios[i]
+		p.add(r.Symbol, pointInTimeEntry{
+			kind:    financialRatiosName,
+			period:  FinancialPeriod{FiscalYear: r.FiscalYear, Period: r.Period},
+			date:    r.Date.Time,
+			knownAt: knownAt,
+			value:   &r,
+		})
+	}
+}
+
+// Symbols returns the symbols in the store, sorted.
+func (p *PointInTime) Symbols() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.sortedSymbols()
+}
+
+// sortedSymbols returns the sorted symbols. Must be called with the lock held.
+func (p *PointInTime) sortedSymbols() []string {
+	symbols := make([]string, 0, len(p.symbols))
+	for symbol := range p.symbols {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// knownAt returns when the entry became public. Entries without a time become public with the
+// latest statement of their period, as they may reflect its restated figures. Must be called with
+// the lock held.
+func (p *PointInTime) knownAt(symbol string, e *pointInTimeEntry) time.Time {
+	if !e.knownAt.IsZero() {
+		return e.knownAt
+	}
+	var last time.Time
+	for _, other := range p.symbols[symbol] {
+		if other.knownAt.IsZero() || other.period != e.period || !isStatementKind(other.kind) {
+			continue
+		}
+		if other.knownAt.After(last) {
+			last = other.knownAt
+		}
+	}
+	if last.IsZero() {
+		return e.date.Add(p.ReportingLag)
+	}
+	return last
+}
+
+func isStatementKind(kind string) bool {
+	return kind == incomeStatementName || kind == balanceSheetStatementName || kind == cashFlowStatementName
+}
+
+// asOf returns the latest version of every period of the given kind known at the given time,
+// newest period first.
+func (p *PointInTime) asOf(symbol, kind string, t time.Time) []interface{} {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	type latest struct {
+		knownAt time.Time
+		entry   *pointInTimeEntry
+	}
+	periods := map[FinancialPeriod]latest{}
+	entries := p.symbols[symbol]
+	for i := range entries {
+		e := &entries[i]
+		if e.kind != kind {
+			continue
+		}
+		knownAt := p.knownAt(symbol, e)
+		if knownAt.After(t) {
+			continue
+		}
+		if l, ok := periods[e.period]; !ok || knownAt.After(l.knownAt) {
+			periods[e.period] = latest{knownAt: knownAt, entry: e}
+		}
+	}
+
+	found := make([]*pointInTimeEntry, 0, len(periods))
+	for _, l := range periods {
+		found = append(found, l.entry)
+	}
+	sort.Slice(found, func(i, j int) bool {
+		if !found[i].date.Equal(found[j].date) {
+			return found[i].date.After(found[j].date)
+		}
+		return found[j].period.Before(found[i].period)
+	})
+	values := make([]interface{}, len(found))
+	for i, e := range found {
+		values[i] = e.value
+	}
+	return values
+}
+
+// IncomeStatements returns the income statements of a company as known at the given time, newest
+// period first.
+func (p *PointInTime) IncomeStatements(symbol string, t time.Time) []IncomeStatement {
+	var statements []IncomeStatement
+	for _, v := range p.asOf(symbol, incomeStatementName, t) {
+		statements = append(statements, *v.(*IncomeStatement))
+	}
+	return statements
+}
+
+// BalanceSheetStatements returns the balance sheet statements of a company as known at the given
+// time, newest period first.
+func (p *PointInTime) BalanceSheetStatements(symbol string, t time.Time) []BalanceSheetStatement {
+	var statements []BalanceSheetStatement
+	for _, v := range p.asOf(symbol, balanceSheetStatementName, t) {
+		statements = append(statements, *v.(*BalanceSheetStatement))
+	}
+	return statements
+}
+
+// CashFlowStatements returns the cash flow statements of a company as known at the given time,
+// newest period first.
+func (p *PointInTime) CashFlowStatements(symbol string, t time.Time) []CashFlowStatement {
+	var statements []CashFlowStatement
+	for _, v := range p.asOf(symbol, cashFlowStatementName, t) {
+		statements = append(statements, *v.(*CashFlowStatement))
+	}
+	return statements
+}
+
+// KeyMetrics returns the key metrics of a company as known at the given time, newest period first.
+func (p *PointInTime) KeyMetrics(symbol string, t time.Time) []KeyMetrics {
+	var metrics []KeyMetrics
+	for _, v := range p.asOf(symbol, keyMetricsName, t) {
+		metrics = append(metrics, *v.(*KeyMetrics))
+	}
+	return metrics
+}
+
+// FinancialRatios returns the ratios of a company as known at the given time, newest period first.
+func (p *PointInTime) FinancialRatios(symbol string, t time.Time) []FinancialRatios {
+	var ratios []FinancialRatios
+	for _, v := range p.asOf(symbol, financialRatiosName, t) {
+		ratios = append(ratios, *v.(*FinancialRatios))
+	}
+	return ratios
+}
+
+// Financials returns the statements of a company as known at the given time, aligned by fiscal
+// period.
+func (p *PointInTime) Financials(symbol string, t time.Time) *Financials {
+	return NewFinancials(symbol,
+		p.IncomeStatements(symbol, t),
+		p.BalanceSheetStatements(symbol, t),
+		p.CashFlowStatements(symbol, t))
+}
+
+// Versions returns every version of a record of the given kind and period, oldest first. The kind is
+// one of "income-statement", "balance-sheet-statement", "cash-flow-statement", "key-metrics" or
+// "ratios".
+func (p *PointInTime) Versions(symbol, kind string, fp FinancialPeriod) []PointInTimeVersion {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	var versions []PointInTimeVersion
+	entries := p.symbols[symbol]
+	for i := range entries {
+		e := &entries[i]
+		if e.kind == kind && e.period == fp {
+			versions = append(versions, PointInTimeVersion{
+				KnownAt: p.knownAt(symbol, e),
+				Value:   e.value,
+			})
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].KnownAt.Before(versions[j].KnownAt)
+	})
+	return versions
+}
+
+// CrossSection returns the latest value of the named field known at the given time for every
+// symbol in the store. The field is looked up by its JSON or Go name in the statements, the key
+// metrics and the ratios, in that order. Only the given period kind is considered: quarterly when
+// quarter is true, fiscal years otherwise.
+func (p *PointInTime) CrossSection(field string, t time.Time, quarter bool) (map[string]float64, error) {
+	var (
+		kind  string
+		index []int
+	)
+	for _, source := range []struct {
+		kind string
+		typ  reflect.Type
+	}{
+		{incomeStatementName, reflect.TypeOf(IncomeStatement{})},
+		{balanceSheetStatementName, reflect.TypeOf(BalanceSheetStatement{})},
+		{cashFlowStatementName, reflect.TypeOf(CashFlowStatement{})},
+		{keyMetricsName, reflect.TypeOf(KeyMetrics{})},
+		{financialRatiosName, reflect.TypeOf(FinancialRatios{})},
+	} {
+		if index = numericFieldIndex(source.typ, field); index != nil {
+			kind = source.kind
+			break
+		}
+	}
+	if index == nil {
+		return nil, fmt.Errorf("not supported financial field: %s", field)
+	}
+
+	values := map[string]float64{}
+	for _, symbol := range p.Symbols() {
+		for _, v := range p.asOf(symbol, kind, t) {
+			rv := reflect.ValueOf(v).Elem()
+			if (FinancialPeriod{Period: rv.FieldByName("Period").String()}).IsQuarter() != quarter {
+				continue
+			}
+			values[symbol] = rv.FieldByIndex(index).Float()
+			break
+		}
+	}
+	return values, nil
+}
+
+// pointInTimeRecord is the serialized form of an entry. The time a statement became public is
+// derived from the value again when loading, while key metrics and ratios keep theirs.
+type pointInTimeRecord struct {
+	Kind    string          `json:"kind"`
+	KnownAt time.Time       `json:"knownAt,omitzero"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// Save writes the store as JSON lines, one version per line.
+func (p *PointInTime) Save(w io.Writer) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	enc := json.NewEncoder(w)
+	for _, symbol := range p.sortedSymbols() {
+		for _, e := range p.symbols[symbol] {
+			value, err := json.Marshal(e.value)
+			if err != nil {
+				return err
+			}
+			record := pointInTimeRecord{Kind: e.kind, Value: value}
+			if !isStatementKind(e.kind) {
+				record.KnownAt = e.knownAt
+			}
+			if err := enc.Encode(&record); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Load reads versions written by Save and adds them to the store.
+func (p *PointInTime) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var record pointInTimeRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return err
+		}
+		var err error
+		switch record.Kind {
+		case incomeStatementName:
+			var s IncomeStatement
+			if err = json.Unmarshal(record.Value, &s); err == nil {
+				p.AddIncomeStatements(s)
+			}
+		case balanceSheetStatementName:
+			var s BalanceSheetStatement
+			if err = json.Unmarshal(record.Value, &s); err == nil {
+				p.AddBalanceSheetStatements(s)
+			}
+		case cashFlowStatementName:
+			var s CashFlowStatement
+			if err = json.Unmarshal(record.Value, &s); err == nil {
+				p.AddCashFlowStatements(s)
+			}
+		case keyMetricsName:
+			var m KeyMetrics
+			if err = json.Unmarshal(record.Value, &m); err == nil {
+				p.AddKeyMetrics(record.KnownAt, m)
+			}
+		case financialRatiosName:
+			var r FinancialRatios
+			if err = json.Unmarshal(record.Value, &r); err == nil {
+				p.AddFinancialRatios(record.KnownAt, r)
+			}
+		default:
+			err = fmt.Errorf("not supported record kind: %s", record.Kind)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
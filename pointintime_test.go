@@ -0,0 +1,135 @@
+package financialmodelingprep
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type pointInTimeSuite struct {
+	suite.Suite
+}
+
+func (r *pointInTimeSuite) newPointInTime() *PointInTime {
+	p := NewPointInTime()
+	p.AddIncomeStatements(
+		IncomeStatement{Symbol: "AAPL", FiscalYear: "2024", Period: "FY", Date: fixtureDate(2024, time.September, 28), AcceptedDate: "2024-11-01 06:01:36", FilingDate: fixtureDate(2024, time.November, 1), NetIncome: 93736},
+		IncomeStatement{Symbol: "AAPL", FiscalYear: "2023", Period: "FY", Date: fixtureDate(2023, time.September, 30), AcceptedDate: "2023-11-03 06:01:36", FilingDate: fixtureDate(2023, time.November, 3), NetIncome: 96995},
+		// Restated a year later.
+		IncomeStatement{Symbol: "AAPL", FiscalYear: "2023", Period: "FY", Date: fixtureDate(2023, time.September, 30), AcceptedDate: "2024-11-01 06:01:36", FilingDate: fixtureDate(2024, time.November, 1), NetIncome: 97000},
+		IncomeStatement{Symbol: "MSFT", FiscalYear: "2024", Period: "FY", Date: fixtureDate(2024, time.June, 30), AcceptedDate: "2024-07-30 16:06:22", FilingDate: fixtureDate(2024, time.July, 30), NetIncome: 88136},
+	)
+	p.AddKeyMetrics(time.Time{},
+		KeyMetrics{Symbol: "AAPL", FiscalYear: "2024", Period: "FY", Date: fixtureDate(2024, time.September, 28), ReturnOnEquity: 1.6459},
+		KeyMetrics{Symbol: "AAPL", FiscalYear: "2022", Period: "FY", Date: fixtureDate(2022, time.September, 24), ReturnOnEquity: 1.9696},
+	)
+	// Fetched when FY 2023 was filed, and again after its restatement.
+	p.AddKeyMetrics(time.Date(2023, time.November, 3, 12, 0, 0, 0, time.UTC),
+		KeyMetrics{Symbol: "AAPL", FiscalYear: "2023", Period: "FY", Date: fixtureDate(2023, time.September, 30), ReturnOnEquity: 1.5600},
+	)
+	p.AddKeyMetrics(time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC),
+		KeyMetrics{Symbol: "AAPL", FiscalYear: "2023", Period: "FY", Date: fixtureDate(2023, time.September, 30), ReturnOnEquity: 1.5700},
+	)
+	p.AddFinancialRatios(time.Time{},
+		FinancialRatios{Symbol: "AAPL", FiscalYear: "2023", Period: "FY", Date: fixtureDate(2023, time.September, 30), NetProfitMargin: 0.2531},
+	)
+	return p
+}
+
+func (r *pointInTimeSuite) TestIncomeStatements() {
+	p := r.newPointInTime()
+
+	// Before the FY 2024 filing only FY 2023 was known, in its original version.
+	statements := p.IncomeStatements("AAPL", time.Date(2024, time.October, 31, 0, 0, 0, 0, time.UTC))
+	r.Len(statements, 1)
+	r.Equal("2023", statements[0].FiscalYear)
+	r.InDelta(96995, statements[0].NetIncome, 1e-9)
+
+	// Afterwards both are known, with FY 2023 restated.
+	statements = p.IncomeStatements("AAPL", time.Date(2024, time.November, 2, 0, 0, 0, 0, time.UTC))
+	r.Len(statements, 2)
+	r.Equal("2024", statements[0].FiscalYear)
+	r.InDelta(97000, statements[1].NetIncome, 1e-9)
+
+	versions := p.Versions("AAPL", incomeStatementName, FinancialPeriod{FiscalYear: "2023", Period: "FY"})
+	r.Len(versions, 2)
+	r.InDelta(96995, versions[0].Value.(*IncomeStatement).NetIncome, 1e-9)
+
+	// Accepted dates are US Eastern time.
+	r.Equal(time.Date(2023, time.November, 3, 10, 1, 36, 0, time.UTC), versions[0].KnownAt.UTC())
+	r.Empty(p.IncomeStatements("MSFT", time.Date(2024, time.July, 30, 17, 0, 0, 0, time.UTC)))
+	r.Len(p.IncomeStatements("MSFT", time.Date(2024, time.July, 30, 21, 0, 0, 0, time.UTC)), 1)
+
+	f := p.Financials("AAPL", time.Date(2024, time.November, 2, 0, 0, 0, 0, time.UTC))
+	r.Len(f.Periods, 2)
+}
+
+func (r *pointInTimeSuite) TestKeyMetrics() {
+	p := r.newPointInTime()
+
+	// Key metrics without a time become known with the statements of the same period.
+	metrics := p.KeyMetrics("AAPL", time.Date(2024, time.October, 31, 0, 0, 0, 0, time.UTC))
+	r.Len(metrics, 2)
+	r.Equal("2023", metrics[0].FiscalYear)
+	r.Equal("2022", metrics[1].FiscalYear)
+
+	metrics = p.KeyMetrics("AAPL", time.Date(2024, time.November, 1, 12, 0, 0, 0, time.UTC))
+	r.Len(metrics, 3)
+	r.Equal("2024", metrics[0].FiscalYear)
+}
+
+func (r *pointInTimeSuite) TestRestatedKeyMetrics() {
+	p := r.newPointInTime()
+	fy2023 := FinancialPeriod{FiscalYear: "2023", Period: "FY"}
+
+	// At the original filing only the metrics fetched then are known, not the restated ones.
+	metrics := p.KeyMetrics("AAPL", time.Date(2023, time.November, 3, 12, 0, 0, 0, time.UTC))
+	r.Len(metrics, 2)
+	r.Equal("2023", metrics[0].FiscalYear)
+	r.InDelta(1.56, metrics[0].ReturnOnEquity, 1e-9)
+
+	metrics = p.KeyMetrics("AAPL", time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC))
+	r.InDelta(1.57, metrics[1].ReturnOnEquity, 1e-9)
+	r.Len(p.Versions("AAPL", keyMetricsName, fy2023), 2)
+
+	// Ratios without a time become known with the restated statement, not the original one.
+	r.Empty(p.FinancialRatios("AAPL", time.Date(2023, time.November, 4, 0, 0, 0, 0, time.UTC)))
+	ratios := p.FinancialRatios("AAPL", time.Date(2024, time.November, 2, 0, 0, 0, 0, time.UTC))
+	r.Len(ratios, 1)
+	r.InDelta(0.2531, ratios[0].NetProfitMargin, 1e-9)
+}
+
+func (r *pointInTimeSuite) TestCrossSection() {
+	p := r.newPointInTime()
+
+	values, err := p.CrossSection("netIncome", time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC), false)
+	r.NoError(err)
+	r.Equal(map[string]float64{"AAPL": 96995, "MSFT": 88136}, values)
+
+	_, err = p.CrossSection("unknown", time.Now(), false)
+	r.Error(err)
+}
+
+func (r *pointInTimeSuite) TestSaveLoad() {
+	p := r.newPointInTime()
+
+	var buf bytes.Buffer
+	r.NoError(p.Save(&buf))
+
+	loaded := NewPointInTime()
+	r.NoError(loaded.Load(&buf))
+	r.Equal(p.Symbols(), loaded.Symbols())
+
+	t := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	r.Equal(p.IncomeStatements("AAPL", t), loaded.IncomeStatements("AAPL", t))
+	r.Equal(p.KeyMetrics("AAPL", t), loaded.KeyMetrics("AAPL", t))
+	r.Len(loaded.Versions("AAPL", keyMetricsName, FinancialPeriod{FiscalYear: "2023", Period: "FY"}), 2)
+	r.Empty(loaded.FinancialRatios("AAPL", time.Date(2023, time.November, 4, 0, 0, 0, 0, time.UTC)))
+	r.Len(loaded.Versions("AAPL", incomeStatementName, FinancialPeriod{FiscalYear: "2023", Period: "FY"}), 2)
+}
+
+func TestPointInTimeSuite(t *testing.T) {
+	suite.Run(t, new(pointInTimeSuite))
+}
@@ -0,0 +1,240 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultQuoteInterval is the default interval between the polls of a QuoteStream.
+	DefaultQuoteInterval = 5 * time.Second
+
+	// DefaultQuoteBatchSize is the default number of symbols per BatchQuoteGet request.
+	DefaultQuoteBatchSize = 100
+
+	// DefaultQuoteBuffer is the default capacity of the updates of a QuoteStream.
+	DefaultQuoteBuffer = 256
+)
+
+// QuoteDelta is the change of a quote since the previous update of its symbol.
+type QuoteDelta struct {
+	Price float64
+
+	// PricePercentage is the change of the price in percent, zero when the previous price was.
+	PricePercentage float64
+	Volume          float64
+
+	// Elapsed is the time between the timestamps of the quotes.
+	Elapsed time.Duration
+}
+
+// QuoteUpdate is a quote that changed since the previous poll.
+type QuoteUpdate struct {
+	Quote FullQuote
+
+	// Previous is the last quote emitted for the symbol, nil for the first one. Delta is zero then.
+	Previous *FullQuote
+	Delta    QuoteDelta
+}
+
+// NewQuoteUpdate computes the update from the previous quote, which may be nil.
+func NewQuoteUpdate(q *FullQuote, previous *FullQuote) QuoteUpdate {
+	u := QuoteUpdate{Quote: *q}
+	if previous != nil {
+		p := *previous
+		u.Previous = &p
+		u.Delta = QuoteDelta{
+			Price:   q.Price - p.Price,
+			Volume:  q.Volume - p.Volume,
+			Elapsed: time.Duration(q.Timestamp-p.Timestamp) * time.Second,
+		}
+		if p.Price != 0 {
+			u.Delta.PricePercentage = u.Delta.Price / p.Price * 100
+		}
+	}
+	return u
+}
+
+// QuoteStreamConfig defines the configuration of a QuoteStream.
+type QuoteStreamConfig struct {
+	Symbols []string
+
+	// Interval is the time between polls, DefaultQuoteInterval when zero.
+	Interval time.Duration
+
+	// RequestsPerMinute is the budget of requests, which are spaced out to fit. Zero is unlimited.
+	RequestsPerMinute int
+
+	// BatchSize is the number of symbols per request, DefaultQuoteBatchSize when zero.
+	BatchSize int
+
+	// Calendar restricts polling to the sessions of an exchange when set. The stream polls once
+	// more after a close to pick up closing prices, then sleeps until the next open.
+	Calendar *ExchangeCalendar
+
+	// Buffer is the capacity of the updates, DefaultQuoteBuffer when zero.
+	Buffer int
+
+	// DropOldest discards the oldest buffered update when the buffer is full. By default polling
+	// blocks until the consumer catches up.
+	DropOldest bool
+}
+
+// QuoteStream polls BatchQuoteGet for a watchlist and emits the quotes that changed since the
+// previous poll.
+type QuoteStream struct {
+	c       *ClientWithResponses
+	symbols []string
+	conf    QuoteStreamConfig
+	limiter *RateLimiter
+
+	updates chan QuoteUpdate
+	dropped atomic.Int64
+	last    map[string]FullQuote
+}
+
+// NewQuoteStream returns a stream of the quotes of the configured symbols. The config may be nil,
+// leaving the watchlist empty.
+func NewQuoteStream(c *ClientWithResponses, cfg *QuoteStreamConfig) *QuoteStream {
+	var conf QuoteStreamConfig
+	if cfg != nil {
+		conf = *cfg
+	}
+	if conf.Interval <= 0 {
+		conf.Interval = DefaultQuoteInterval
+	}
+	if conf.BatchSize <= 0 {
+		conf.BatchSize = DefaultQuoteBatchSize
+	}
+	if conf.Buffer <= 0 {
+		conf.Buffer = DefaultQuoteBuffer
+	}
+	s := &QuoteStream{
+		c:       c,
+		conf:    conf,
+		updates: make(chan QuoteUpdate, conf.Buffer),
+		last:    map[string]FullQuote{},
+		limiter: &RateLimiter{},
+	}
+	for _, symbol := range conf.Symbols {
+		if symbol = strings.ToUpper(strings.TrimSpace(symbol)); symbol != "" {
+			s.symbols = append(s.symbols, symbol)
+		}
+	}
+	s.symbols = sortedUnique(s.symbols)
+	if conf.RequestsPerMinute > 0 {
+		s.limiter = NewRateLimiter(time.Minute / time.Duration(conf.RequestsPerMinute))
+	}
+	return s
+}
+
+// Updates returns the changed quotes. It is closed when Run returns.
+func (s *QuoteStream) Updates() <-chan QuoteUpdate {
+	return s.updates
+}
+
+// Dropped returns the number of updates discarded with DropOldest.
+func (s *QuoteStream) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// delay returns how long to wait before polling at now, given whether the previous poll was
+// within a session. It reports false when the calendar has no next session.
+func (s *QuoteStream) delay(now time.Time, wasOpen bool) (time.Duration, bool) {
+	cal := s.conf.Calendar
+	if cal == nil || wasOpen || cal.IsOpen(now) {
+		return 0, true
+	}
+	next, ok := cal.NextOpen(now)
+	if !ok {
+		return 0, false
+	}
+	return next.Sub(now), true
+}
+
+// Run polls until the context is done or a request fails, and closes the updates.
+func (s *QuoteStream) Run(ctx context.Context) error {
+	defer close(s.updates)
+
+	wasOpen := false
+	for {
+		now := time.Now()
+		d, ok := s.delay(now, wasOpen)
+		if !ok {
+			return fmt.Errorf("no session of exchange %s within a year", s.conf.Calendar.Exchange)
+		}
+		if d > 0 {
+			if err := sleep(ctx, d); err != nil {
+				return err
+			}
+			continue
+		}
+		wasOpen = s.conf.Calendar != nil && s.conf.Calendar.IsOpen(now)
+
+		if err := s.poll(ctx); err != nil {
+			return err
+		}
+		if err := sleep(ctx, s.conf.Interval); err != nil {
+			return err
+		}
+	}
+}
+
+// poll fetches the quotes once and emits those that changed.
+func (s *QuoteStream) poll(ctx context.Context) error {
+	for i := 0; i < len(s.symbols); i += s.conf.BatchSize {
+		batch := s.symbols[i:min(i+s.conf.BatchSize, len(s.symbols))]
+		if err := s.limiter.Wait(ctx); err != nil {
+			return err
+		}
+		resp, err := s.c.BatchQuoteGetWithResponse(ctx, &BatchQuoteGetParams{Symbols: strings.Join(batch, ",")})
+		if err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return fmt.Errorf("unexpected status of batch quote: %s", resp.Status())
+		}
+		for j := range *resp.JSON200 {
+			q := &(*resp.JSON200)[j]
+			var previous *FullQuote
+			if last, ok := s.last[q.Symbol]; ok {
+				if last == *q {
+					continue
+				}
+				previous = &last
+			}
+			s.last[q.Symbol] = *q
+			if err := s.emit(ctx, NewQuoteUpdate(q, previous)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// emit sends an update, blocking or discarding the oldest one when the buffer is full.
+func (s *QuoteStream) emit(ctx context.Context, u QuoteUpdate) error {
+	if !s.conf.DropOldest {
+		select {
+		case s.updates <- u:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	for {
+		select {
+		case s.updates <- u:
+			return nil
+		default:
+		}
+		select {
+		case <-s.updates:
+			s.dropped.Add(1)
+		default:
+		}
+	}
+}
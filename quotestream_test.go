@@ -0,0 +1,195 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type quoteStreamSuite struct {
+	suite.Suite
+
+	mu       sync.Mutex
+	quotes   map[string]FullQuote
+	requests []string
+	server   *httptest.Server
+	c        *ClientWithResponses
+}
+
+func (r *quoteStreamSuite) SetupTest() {
+	r.quotes = map[string]FullQuote{
+		"AAPL": {Symbol: "AAPL", Price: 200, Volume: 1000, Timestamp: 1766415600},
+		"MSFT": {Symbol: "MSFT", Price: 400, Volume: 500, Timestamp: 1766415600},
+		"NVDA": {Symbol: "NVDA", Price: 180, Volume: 2000, Timestamp: 1766415600},
+	}
+	r.requests = nil
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.requests = append(r.requests, req.URL.Query().Get("symbols"))
+
+		var quotes []FullQuote
+		for _, symbol := range strings.Split(req.URL.Query().Get("symbols"), ",") {
+			if q, ok := r.quotes[symbol]; ok {
+				quotes = append(quotes, q)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(quotes)
+	}))
+
+	c, err := NewClientWithResponses(r.server.URL)
+	r.Require().NoError(err)
+	r.c = c
+}
+
+func (r *quoteStreamSuite) TearDownTest() {
+	r.server.Close()
+}
+
+func (r *quoteStreamSuite) tick(symbol string, price, volume float64, elapsed int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	q := r.quotes[symbol]
+	q.Price, q.Volume, q.Timestamp = price, volume, q.Timestamp+elapsed
+	r.quotes[symbol] = q
+}
+
+func drain(updates <-chan QuoteUpdate) []QuoteUpdate {
+	var drained []QuoteUpdate
+	for {
+		select {
+		case u := <-updates:
+			drained = append(drained, u)
+		default:
+			return drained
+		}
+	}
+}
+
+func (r *quoteStreamSuite) TestNewQuoteUpdate() {
+	u := NewQuoteUpdate(&FullQuote{Symbol: "AAPL", Price: 202, Volume: 1500, Timestamp: 1060}, &FullQuote{Symbol: "AAPL", Price: 200, Volume: 1000, Timestamp: 1000})
+	r.InDelta(2, u.Delta.Price, 1e-9)
+	r.InDelta(1, u.Delta.PricePercentage, 1e-9)
+	r.InDelta(500, u.Delta.Volume, 1e-9)
+	r.Equal(time.Minute, u.Delta.Elapsed)
+	r.Equal(200.0, u.Previous.Price)
+
+	u = NewQuoteUpdate(&FullQuote{Symbol: "AAPL", Price: 202}, nil)
+	r.Nil(u.Previous)
+	r.Zero(u.Delta)
+}
+
+func (r *quoteStreamSuite) TestPollChanges() {
+	s := NewQuoteStream(r.c, &QuoteStreamConfig{Symbols: []string{"nvda", "AAPL", "MSFT", "AAPL"}, BatchSize: 2})
+	ctx := context.Background()
+
+	r.Require().NoError(s.poll(ctx))
+	r.Equal([]string{"AAPL,MSFT", "NVDA"}, r.requests)
+	updates := drain(s.Updates())
+	r.Len(updates, 3)
+	for _, u := range updates {
+		r.Nil(u.Previous)
+	}
+
+	r.Require().NoError(s.poll(ctx))
+	r.Empty(drain(s.Updates()))
+
+	r.tick("MSFT", 396, 800, 5)
+	r.Require().NoError(s.poll(ctx))
+	updates = drain(s.Updates())
+	r.Require().Len(updates, 1)
+	r.Equal("MSFT", updates[0].Quote.Symbol)
+	r.InDelta(-4, updates[0].Delta.Price, 1e-9)
+	r.InDelta(-1, updates[0].Delta.PricePercentage, 1e-9)
+	r.InDelta(300, updates[0].Delta.Volume, 1e-9)
+	r.Equal(5*time.Second, updates[0].Delta.Elapsed)
+}
+
+func (r *quoteStreamSuite) TestNilConfig() {
+	s := NewQuoteStream(r.c, nil)
+	r.Require().NoError(s.poll(context.Background()))
+	r.Empty(r.requests)
+	r.Empty(drain(s.Updates()))
+}
+
+func (r *quoteStreamSuite) TestDropOldest() {
+	s := NewQuoteStream(r.c, &QuoteStreamConfig{Symbols: []string{"AAPL"}, Buffer: 2, DropOldest: true})
+	ctx := context.Background()
+	for i := 1; i <= 4; i++ {
+		r.tick("AAPL", 200+float64(i), 1000, 1)
+		r.Require().NoError(s.poll(ctx))
+	}
+	updates := drain(s.Updates())
+	r.Require().Len(updates, 2)
+	r.Equal(203.0, updates[0].Quote.Price)
+	r.Equal(204.0, updates[1].Quote.Price)
+	r.EqualValues(2, s.Dropped())
+}
+
+func (r *quoteStreamSuite) TestBackPressure() {
+	s := NewQuoteStream(r.c, &QuoteStreamConfig{Symbols: []string{"AAPL", "MSFT", "NVDA"}, Buffer: 1})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Nobody consumes, so polling blocks on the second update until the context is done.
+	r.ErrorIs(s.poll(ctx), context.DeadlineExceeded)
+	r.Len(drain(s.Updates()), 1)
+	r.Zero(s.Dropped())
+}
+
+func (r *quoteStreamSuite) TestRun() {
+	s := NewQuoteStream(r.c, &QuoteStreamConfig{Symbols: []string{"AAPL"}, Interval: 10 * time.Millisecond, RequestsPerMinute: 6000})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- s.Run(ctx)
+	}()
+
+	u := <-s.Updates()
+	r.Equal(200.0, u.Quote.Price)
+	r.tick("AAPL", 201, 1100, 1)
+	u = <-s.Updates()
+	r.Equal(201.0, u.Quote.Price)
+	r.InDelta(1, u.Delta.Price, 1e-9)
+
+	cancel()
+	r.ErrorIs(<-done, context.Canceled)
+	for range s.Updates() {
+	}
+}
+
+func (r *quoteStreamSuite) TestDelay() {
+	hours := nasdaqHours()
+	cal, err := NewExchangeCalendar(&hours, nasdaqHolidays())
+	r.Require().NoError(err)
+	s := NewQuoteStream(r.c, &QuoteStreamConfig{Symbols: []string{"AAPL"}, Calendar: cal})
+
+	d, ok := s.delay(utc(2025, 12, 22, 15, 0), false)
+	r.True(ok)
+	r.Zero(d)
+
+	// Once more after the close, then until the next open.
+	d, ok = s.delay(utc(2025, 12, 24, 21, 0), true)
+	r.True(ok)
+	r.Zero(d)
+	d, ok = s.delay(utc(2025, 12, 24, 21, 0), false)
+	r.True(ok)
+	r.Equal(41*time.Hour+30*time.Minute, d)
+
+	s = NewQuoteStream(r.c, &QuoteStreamConfig{Symbols: []string{"AAPL"}})
+	d, ok = s.delay(utc(2025, 12, 25, 15, 0), false)
+	r.True(ok)
+	r.Zero(d)
+}
+
+func TestQuoteStreamSuite(t *testing.T) {
+	suite.Run(t, new(quoteStreamSuite))
+}
@@ -0,0 +1,40 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type rateLimiterSuite struct {
+	suite.Suite
+}
+
+func (r *rateLimiterSuite) TestWait() {
+	l := NewRateLimiter(20 * time.Millisecond)
+	ctx := context.Background()
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		r.Require().NoError(l.Wait(ctx))
+	}
+	r.GreaterOrEqual(time.Since(start), 40*time.Millisecond)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	r.ErrorIs(l.Wait(canceled), context.Canceled)
+}
+
+func (r *rateLimiterSuite) TestZero() {
+	var l RateLimiter
+	start := time.Now()
+	for i := 0; i < 100; i++ {
+		r.Require().NoError(l.Wait(context.Background()))
+	}
+	r.Less(time.Since(start), 20*time.Millisecond)
+}
+
+func TestRateLimiterSuite(t *testing.T) {
+	suite.Run(t, new(rateLimiterSuite))
+}
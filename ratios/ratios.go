@@ -0,0 +1,189 @@
+// Package ratios recomputes the main financial ratios from the statements and reconciles them
+// against the vendor figures of RatiosGet and KeyMetricsGet.
+package ratios
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+// Inputs holds the figures of a single fiscal period.
+type Inputs struct {
+	IncomeStatement       *fmp.IncomeStatement
+	BalanceSheetStatement *fmp.BalanceSheetStatement
+	CashFlowStatement     *fmp.CashFlowStatement
+
+	// Price is the share price at the period end. Valuation multiples are skipped when it is zero.
+	Price float64
+}
+
+// Ratios maps the JSON field names of FinancialRatios and KeyMetrics to recomputed values. Ratios
+// whose denominator is zero are left out.
+type Ratios map[string]float64
+
+func (r Ratios) set(name string, numerator, denominator float64) {
+	if denominator == 0 || math.IsNaN(numerator) || math.IsNaN(denominator) {
+		return
+	}
+	r[name] = numerator / denominator
+}
+
+// Compute recomputes the ratios of a fiscal period. Missing statements are skipped together with
+// the ratios that need them.
+func Compute(in *Inputs) Ratios {
+	r := Ratios{}
+	is, bs, cf := in.IncomeStatement, in.BalanceSheetStatement, in.CashFlowStatement
+
+	// Margins.
+	if is != nil {
+		r.set("grossProfitMargin", is.GrossProfit, is.Revenue)
+		r.set("operatingProfitMargin", is.OperatingIncome, is.Revenue)
+		r.set("ebitMargin", is.Ebit, is.Revenue)
+		r.set("ebitdaMargin", is.Ebitda, is.Revenue)
+		r.set("pretaxProfitMargin", is.IncomeBeforeTax, is.Revenue)
+		r.set("netProfitMargin", is.NetIncome, is.Revenue)
+		r.set("continuousOperationsProfitMargin", is.NetIncomeFromContinuingOperations, is.Revenue)
+		r.set("bottomLineProfitMargin", is.BottomLineNetIncome, is.Revenue)
+		r.set("effectiveTaxRate", is.IncomeTaxExpense, is.IncomeBeforeTax)
+		r.set("netIncomePerEBT", is.NetIncome, is.IncomeBeforeTax)
+		r.set("ebtPerEbit", is.IncomeBeforeTax, is.OperatingIncome)
+		r.set("interestCoverageRatio", is.OperatingIncome, is.InterestExpense)
+		r.set("researchAndDevelopementToRevenue", is.ResearchAndDevelopmentExpenses, is.Revenue)
+		r.set("salesGeneralAndAdministrativeToRevenue", is.SellingGeneralAndAdministrativeExpenses, is.Revenue)
+
+		// Per share.
+		r.set("revenuePerShare", is.Revenue, is.WeightedAverageShsOut)
+		r.set("netIncomePerShare", is.NetIncome, is.WeightedAverageShsOut)
+	}
+
+	// Liquidity and leverage.
+	if bs != nil {
+		r.set("currentRatio", bs.TotalCurrentAssets, bs.TotalCurrentLiabilities)
+		r.set("quickRatio", bs.CashAndShortTermInvestments+bs.AccountsReceivables, bs.TotalCurrentLiabilities)
+		r.set("cashRatio", bs.CashAndCashEquivalents, bs.TotalCurrentLiabilities)
+		r.set("debtToAssetsRatio", bs.TotalDebt, bs.TotalAssets)
+		r.set("debtToEquityRatio", bs.TotalDebt, bs.TotalStockholdersEquity)
+		r.set("debtToCapitalRatio", bs.TotalDebt, bs.TotalDebt+bs.TotalStockholdersEquity)
+		r.set("longTermDebtToCapitalRatio", bs.LongTermDebt, bs.LongTermDebt+bs.TotalStockholdersEquity)
+		r.set("financialLeverageRatio", bs.TotalAssets, bs.TotalStockholdersEquity)
+		r.set("intangiblesToTotalAssets", bs.GoodwillAndIntangibleAssets, bs.TotalAssets)
+		r["workingCapital"] = bs.TotalCurrentAssets - bs.TotalCurrentLiabilities
+		r["investedCapital"] = bs.TotalDebt + bs.TotalEquity - bs.CashAndCashEquivalents
+		r["tangibleAssetValue"] = bs.TotalAssets - bs.TotalLiabilities - bs.GoodwillAndIntangibleAssets
+	}
+
+	// Returns and turnover.
+	if is != nil && bs != nil {
+		r.set("returnOnEquity", is.NetIncome, bs.TotalStockholdersEquity)
+		r.set("returnOnAssets", is.NetIncome, bs.TotalAssets)
+		r.set("returnOnTangibleAssets", is.NetIncome, bs.TotalAssets-bs.GoodwillAndIntangibleAssets)
+		r.set("operatingReturnOnAssets", is.OperatingIncome, bs.TotalAssets)
+		r.set("returnOnCapitalEmployed", is.OperatingIncome, bs.TotalAssets-bs.TotalCurrentLiabilities)
+		if taxRate, ok := r["effectiveTaxRate"]; ok {
+			r.set("returnOnInvestedCapital", is.OperatingIncome*(1-taxRate), r["investedCapital"])
+		}
+
+		r.set("assetTurnover", is.Revenue, bs.TotalAssets)
+		r.set("fixedAssetTurnover", is.Revenue, bs.PropertyPlantEquipmentNet)
+		r.set("inventoryTurnover", is.CostOfRevenue, bs.Inventory)
+		r.set("receivablesTurnover", is.Revenue, bs.AccountsReceivables)
+		r.set("payablesTurnover", is.CostOfRevenue, bs.AccountPayables)
+		r.set("workingCapitalTurnoverRatio", is.Revenue, r["workingCapital"])
+		r.set("solvencyRatio", is.NetIncome+is.DepreciationAndAmortization, bs.TotalLiabilities)
+		r.set("netDebtToEBITDA", bs.NetDebt, is.Ebitda)
+
+		r.set("bookValuePerShare", bs.TotalStockholdersEquity, is.WeightedAverageShsOut)
+		r.set("shareholdersEquityPerShare", bs.TotalStockholdersEquity, is.WeightedAverageShsOut)
+		r.set("tangibleBookValuePerShare", bs.TotalStockholdersEquity-bs.GoodwillAndIntangibleAssets, is.WeightedAverageShsOut)
+		r.set("cashPerShare", bs.CashAndShortTermInvestments, is.WeightedAverageShsOut)
+		r.set("interestDebtPerShare", bs.TotalDebt+is.InterestExpense, is.WeightedAverageShsOut)
+	}
+
+	// Cash flow.
+	if cf != nil {
+		r.set("freeCashFlowOperatingCashFlowRatio", cf.FreeCashFlow, cf.OperatingCashFlow)
+		r.set("capexToOperatingCashFlow", -cf.CapitalExpenditure, cf.OperatingCashFlow)
+		r.set("capitalExpenditureCoverageRatio", cf.OperatingCashFlow, -cf.CapitalExpenditure)
+		r.set("dividendPaidAndCapexCoverageRatio", cf.OperatingCashFlow, -cf.CapitalExpenditure-cf.CommonDividendsPaid)
+	}
+	if is != nil && cf != nil {
+		r.set("operatingCashFlowSalesRatio", cf.OperatingCashFlow, is.Revenue)
+		r.set("capexToRevenue", -cf.CapitalExpenditure, is.Revenue)
+		r.set("capexToDepreciation", -cf.CapitalExpenditure, is.DepreciationAndAmortization)
+		r.set("stockBasedCompensationToRevenue", cf.StockBasedCompensation, is.Revenue)
+		r.set("incomeQuality", cf.OperatingCashFlow, is.NetIncome)
+		r.set("dividendPayoutRatio", -cf.CommonDividendsPaid, is.NetIncome)
+		r.set("freeCashFlowPerShare", cf.FreeCashFlow, is.WeightedAverageShsOut)
+		r.set("operatingCashFlowPerShare", cf.OperatingCashFlow, is.WeightedAverageShsOut)
+		r.set("capexPerShare", -cf.CapitalExpenditure, is.WeightedAverageShsOut)
+	}
+	if bs != nil && cf != nil {
+		r.set("operatingCashFlowRatio", cf.OperatingCashFlow, bs.TotalCurrentLiabilities)
+		r.set("operatingCashFlowCoverageRatio", cf.OperatingCashFlow, bs.TotalDebt)
+		r.set("shortTermOperatingCashFlowCoverageRatio", cf.OperatingCashFlow, bs.ShortTermDebt)
+	}
+
+	// Valuation.
+	if in.Price > 0 && is != nil {
+		marketCap := in.Price * is.WeightedAverageShsOut
+		r["marketCap"] = marketCap
+		r.set("priceToEarningsRatio", marketCap, is.NetIncome)
+		r.set("priceToSalesRatio", marketCap, is.Revenue)
+		r.set("earningsYield", is.NetIncome, marketCap)
+		if bs != nil {
+			enterpriseValue := marketCap + bs.TotalDebt - bs.CashAndCashEquivalents
+			r["enterpriseValue"] = enterpriseValue
+			r.set("priceToBookRatio", marketCap, bs.TotalStockholdersEquity)
+			r.set("debtToMarketCap", bs.TotalDebt, marketCap)
+			r.set("evToSales", enterpriseValue, is.Revenue)
+			r.set("evToEBITDA", enterpriseValue, is.Ebitda)
+			r.set("enterpriseValueMultiple", enterpriseValue, is.Ebitda)
+			if cf != nil {
+				r.set("evToFreeCashFlow", enterpriseValue, cf.FreeCashFlow)
+				r.set("evToOperatingCashFlow", enterpriseValue, cf.OperatingCashFlow)
+			}
+		}
+		if cf != nil {
+			r.set("priceToFreeCashFlowRatio", marketCap, cf.FreeCashFlow)
+			r.set("priceToOperatingCashFlowRatio", marketCap, cf.OperatingCashFlow)
+			r.set("freeCashFlowYield", cf.FreeCashFlow, marketCap)
+			r.set("dividendYield", -cf.CommonDividendsPaid, marketCap)
+			if v, ok := r["dividendYield"]; ok {
+				r["dividendYieldPercentage"] = v * 100
+			}
+		}
+	}
+
+	return r
+}
+
+// PriceAt returns the closing price of the latest candle on or before the given date.
+func PriceAt(candles []fmp.LightCandle, date time.Time) (float64, bool) {
+	var (
+		found  bool
+		latest time.Time
+		price  float64
+	)
+	for _, c := range candles {
+		if c.Date.After(date) {
+			continue
+		}
+		if !found || c.Date.After(latest) {
+			found, latest, price = true, c.Date.Time, float64(c.Price)
+		}
+	}
+	return price, found
+}
+
+// Names returns the ratio names in r, sorted.
+func (r Ratios) Names() []string {
+	names := make([]string, 0, len(r))
+	for name := range r {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
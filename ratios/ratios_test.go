@@ -0,0 +1,101 @@
+package ratios
+
+import (
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/suite"
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+type ratiosSuite struct {
+	suite.Suite
+	in *Inputs
+}
+
+// SetupTest takes the fiscal 2024 figures of Apple, in millions.
+func (r *ratiosSuite) SetupTest() {
+	r.in = &Inputs{
+		IncomeStatement: &fmp.IncomeStatement{
+			Symbol:                "AAPL",
+			FiscalYear:            "2024",
+			Period:                "FY",
+			Revenue:               391035,
+			CostOfRevenue:         210352,
+			GrossProfit:           180683,
+			OperatingIncome:       123216,
+			IncomeBeforeTax:       123485,
+			IncomeTaxExpense:      29749,
+			NetIncome:             93736,
+			Ebitda:                134661,
+			WeightedAverageShsOut: 15343.783,
+		},
+		BalanceSheetStatement: &fmp.BalanceSheetStatement{
+			TotalAssets:             364980,
+			TotalCurrentAssets:      152987,
+			TotalCurrentLiabilities: 176392,
+			TotalDebt:               106629,
+			TotalStockholdersEquity: 56950,
+			TotalEquity:             56950,
+			CashAndCashEquivalents:  29943,
+		},
+		CashFlowStatement: &fmp.CashFlowStatement{
+			OperatingCashFlow:   118254,
+			CapitalExpenditure:  -9447,
+			FreeCashFlow:        108807,
+			CommonDividendsPaid: -15234,
+		},
+		Price: 227.79,
+	}
+}
+
+func (r *ratiosSuite) TestCompute() {
+	computed := Compute(r.in)
+
+	r.InDelta(0.46206, computed["grossProfitMargin"], 1e-5)
+	r.InDelta(0.23971, computed["netProfitMargin"], 1e-5)
+	r.InDelta(1.64593, computed["returnOnEquity"], 1e-5)
+	r.InDelta(0.86732, computed["currentRatio"], 1e-5)
+	r.InDelta(1.87233, computed["debtToEquityRatio"], 1e-5)
+	r.InDelta(227.79*15343.783, computed["marketCap"], 1e-3)
+	r.InDelta(37.2871, computed["priceToEarningsRatio"], 1e-3)
+	r.InDelta(0.16252, computed["dividendPayoutRatio"], 1e-5)
+	r.InDelta(-23405, computed["workingCapital"], 1e-9)
+
+	// Ratios with a zero denominator are left out.
+	_, ok := computed["inventoryTurnover"]
+	r.False(ok)
+}
+
+func (r *ratiosSuite) TestComputeWithoutPrice() {
+	r.in.Price = 0
+	r.in.CashFlowStatement = nil
+	computed := Compute(r.in)
+
+	_, ok := computed["priceToEarningsRatio"]
+	r.False(ok)
+	_, ok = computed["freeCashFlowPerShare"]
+	r.False(ok)
+	r.Contains(computed.Names(), "grossProfitMargin")
+}
+
+func (r *ratiosSuite) TestPriceAt() {
+	candles := []fmp.LightCandle{
+		{Date: openapi_types.Date{Time: time.Date(2024, time.September, 30, 0, 0, 0, 0, time.UTC)}, Price: 233.0},
+		{Date: openapi_types.Date{Time: time.Date(2024, time.September, 27, 0, 0, 0, 0, time.UTC)}, Price: 227.79},
+		{Date: openapi_types.Date{Time: time.Date(2024, time.September, 26, 0, 0, 0, 0, time.UTC)}, Price: 227.52},
+	}
+
+	// Saturday takes the close of Friday.
+	price, ok := PriceAt(candles, time.Date(2024, time.September, 28, 0, 0, 0, 0, time.UTC))
+	r.True(ok)
+	r.InDelta(227.79, price, 1e-4)
+
+	_, ok = PriceAt(candles, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	r.False(ok)
+}
+
+func TestRatiosSuite(t *testing.T) {
+	suite.Run(t, new(ratiosSuite))
+}
@@ -0,0 +1,221 @@
+package ratios
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+// DefaultTolerance is the relative difference above which a ratio is reported as a mismatch.
+const DefaultTolerance = 0.01
+
+// Mismatch describes a ratio whose recomputed value differs from the vendor value.
+type Mismatch struct {
+	Field    string
+	Computed float64
+	Reported float64
+
+	// Difference is the relative difference, |computed - reported| / max(|computed|, |reported|).
+	Difference float64
+}
+
+// Report is the outcome of reconciling the ratios of a fiscal period.
+type Report struct {
+	Symbol     string
+	FiscalYear string
+	Period     string
+	Date       time.Time
+
+	// Source is "ratios" for FinancialRatios and "key-metrics" for KeyMetrics.
+	Source string
+
+	// Compared lists the fields that were compared, sorted.
+	Compared []string
+
+	// Mismatches lists the fields whose difference exceeds the tolerance, largest difference first.
+	Mismatches []Mismatch
+}
+
+// OK reports whether no mismatch was found.
+func (r *Report) OK() bool {
+	return len(r.Mismatches) == 0
+}
+
+// String formats the report with one line per mismatch.
+func (r *Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s %s %s: %d compared, %d mismatched\n", r.Symbol, r.Period, r.FiscalYear, r.Source, len(r.Compared), len(r.Mismatches))
+	for _, m := range r.Mismatches {
+		fmt.Fprintf(&b, "  %s: computed %g, reported %g (%.2f%%)\n", m.Field, m.Computed, m.Reported, m.Difference*100)
+	}
+	return b.String()
+}
+
+// ReconcileFinancialRatios compares recomputed ratios against the vendor ratios.
+func ReconcileFinancialRatios(computed Ratios, reported *fmp.FinancialRatios, tolerance float64) *Report {
+	r := &Report{
+		Symbol:     reported.Symbol,
+		FiscalYear: reported.FiscalYear,
+		Period:     reported.Period,
+		Date:       reported.Date.Time,
+		Source:     "ratios",
+	}
+	reconcile(r, computed, reflect.ValueOf(reported).Elem(), tolerance)
+	return r
+}
+
+// ReconcileKeyMetrics compares recomputed ratios against the vendor key metrics.
+func ReconcileKeyMetrics(computed Ratios, reported *fmp.KeyMetrics, tolerance float64) *Report {
+	r := &Report{
+		Symbol:     reported.Symbol,
+		FiscalYear: reported.FiscalYear,
+		Period:     reported.Period,
+		Date:       reported.Date.Time,
+		Source:     "key-metrics",
+	}
+	reconcile(r, computed, reflect.ValueOf(reported).Elem(), tolerance)
+	return r
+}
+
+func reconcile(r *Report, computed Ratios, reported reflect.Value, tolerance float64) {
+	t := reported.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.Type.Kind() != reflect.Float64 {
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		c, ok := computed[name]
+		if !ok {
+			continue
+		}
+		v := reported.Field(i).Float()
+		r.Compared = append(r.Compared, name)
+
+		var diff float64
+		if scale := math.Max(math.Abs(c), math.Abs(v)); scale > 0 {
+			diff = math.Abs(c-v) / scale
+		}
+		if diff > tolerance {
+			r.Mismatches = append(r.Mismatches, Mismatch{
+				Field:      name,
+				Computed:   c,
+				Reported:   v,
+				Difference: diff,
+			})
+		}
+	}
+	sort.Strings(r.Compared)
+	sort.SliceStable(r.Mismatches, func(i, j int) bool {
+		return r.Mismatches[i].Difference > r.Mismatches[j].Difference
+	})
+}
+
+// ReconcileParams defines parameters for Reconcile.
+type ReconcileParams struct {
+	Symbol string
+	Limit  *int
+	Period *fmp.Period
+
+	// Tolerance defaults to DefaultTolerance.
+	Tolerance float64
+}
+
+// Reconcile fetches the statements, ratios, key metrics and prices of a company, recomputes the
+// ratios of every fiscal period and reconciles them against the vendor figures. Two reports are
+// returned per period, newest period first: one for the ratios and one for the key metrics.
+func Reconcile(ctx context.Context, c *fmp.ClientWithResponses, params *ReconcileParams) ([]*Report, error) {
+	tolerance := params.Tolerance
+	if tolerance == 0 {
+		tolerance = DefaultTolerance
+	}
+
+	financials, err := fmp.GetFinancials(ctx, c, &fmp.FinancialsGetParams{
+		Symbol:     params.Symbol,
+		Limit:      params.Limit,
+		Period:     params.Period,
+		Concurrent: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(financials.Periods) == 0 {
+		return nil, nil
+	}
+
+	ratiosResp, err := c.RatiosGetWithResponse(ctx, &fmp.RatiosGetParams{
+		Symbol: params.Symbol,
+		Limit:  params.Limit,
+		Period: params.Period,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if ratiosResp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of ratios: %s", ratiosResp.Status())
+	}
+	metricsResp, err := c.KeyMetricsGetWithResponse(ctx, &fmp.KeyMetricsGetParams{
+		Symbol: params.Symbol,
+		Limit:  params.Limit,
+		Period: params.Period,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if metricsResp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of key metrics: %s", metricsResp.Status())
+	}
+
+	// Fetch prices covering every period end, with a margin for periods ending on a holiday.
+	from := openapi_types.Date{Time: financials.Periods[0].Date().AddDate(0, 0, -7)}
+	to := openapi_types.Date{Time: financials.Periods[len(financials.Periods)-1].Date()}
+	pricesResp, err := c.HistoricalPriceEodLightGetWithResponse(ctx, &fmp.HistoricalPriceEodLightGetParams{
+		Symbol: params.Symbol,
+		From:   &from,
+		To:     &to,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if pricesResp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of historical prices: %s", pricesResp.Status())
+	}
+	candles := *pricesResp.JSON200
+
+	computed := map[fmp.FinancialPeriod]Ratios{}
+	for i := range financials.Periods {
+		s := &financials.Periods[i]
+		price, _ := PriceAt(candles, s.Date())
+		computed[s.FinancialPeriod] = Compute(&Inputs{
+			IncomeStatement:       s.IncomeStatement,
+			BalanceSheetStatement: s.BalanceSheetStatement,
+			CashFlowStatement:     s.CashFlowStatement,
+			Price:                 price,
+		})
+	}
+
+	var reports []*Report
+	for i := range *ratiosResp.JSON200 {
+		reported := &(*ratiosResp.JSON200)[i]
+		if r, ok := computed[fmp.FinancialPeriod{FiscalYear: reported.FiscalYear, Period: reported.Period}]; ok {
+			reports = append(reports, ReconcileFinancialRatios(r, reported, tolerance))
+		}
+	}
+	for i := range *metricsResp.JSON200 {
+		reported := &(*metricsResp.JSON200)[i]
+		if r, ok := computed[fmp.FinancialPeriod{FiscalYear: reported.FiscalYear, Period: reported.Period}]; ok {
+			reports = append(reports, ReconcileKeyMetrics(r, reported, tolerance))
+		}
+	}
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].Date.After(reports[j].Date)
+	})
+	return reports, nil
+}
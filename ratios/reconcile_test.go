@@ -0,0 +1,69 @@
+package ratios
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	fmp "github.com/zhoub/go-financialmodelingprep"
+)
+
+type reconcileSuite struct {
+	suite.Suite
+}
+
+func (r *reconcileSuite) TestReconcileFinancialRatios() {
+	computed := Ratios{
+		"assetTurnover":     1.07139,
+		"currentRatio":      0.86732,
+		"debtToEquityRatio": 1.87233,
+		"grossProfitMargin": 0.46206,
+		"netProfitMargin":   0.23971,
+	}
+	reported := &fmp.FinancialRatios{
+		Symbol:            "AAPL",
+		FiscalYear:        "2024",
+		Period:            "FY",
+		GrossProfitMargin: 0.46206,
+		NetProfitMargin:   0.23971,
+		CurrentRatio:      0.86732,
+		// Reported with a different definition.
+		DebtToEquityRatio: 2.09,
+	}
+
+	report := ReconcileFinancialRatios(computed, reported, DefaultTolerance)
+	r.False(report.OK())
+	r.Contains(report.Compared, "grossProfitMargin")
+	r.Equal("ratios", report.Source)
+
+	// Fields absent from the vendor figures are reported as mismatches too.
+	r.Equal("assetTurnover", report.Mismatches[0].Field)
+
+	var fields []string
+	for _, m := range report.Mismatches {
+		fields = append(fields, m.Field)
+	}
+	r.Contains(fields, "debtToEquityRatio")
+	r.NotContains(fields, "grossProfitMargin")
+	r.NotContains(fields, "currentRatio")
+	r.Contains(report.String(), "debtToEquityRatio")
+}
+
+func (r *reconcileSuite) TestReconcileKeyMetrics() {
+	computed := Ratios{
+		"returnOnEquity": 1.6459,
+		"currentRatio":   0.8673,
+	}
+	reported := &fmp.KeyMetrics{
+		Symbol:         "AAPL",
+		ReturnOnEquity: 1.6459,
+		CurrentRatio:   0.8673,
+	}
+
+	report := ReconcileKeyMetrics(computed, reported, DefaultTolerance)
+	r.True(report.OK())
+	r.Equal([]string{"currentRatio", "returnOnEquity"}, report.Compared)
+}
+
+func TestReconcileSuite(t *testing.T) {
+	suite.Run(t, new(reconcileSuite))
+}
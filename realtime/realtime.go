@@ -0,0 +1,428 @@
+// Package realtime streams ticks from the WebSocket feeds of FMP for US stocks, forex and crypto,
+// logging in, resubscribing after reconnects and watching the connection with heartbeats. Server
+// stands in for FMP to test offline.
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Feed is a WebSocket feed of FMP.
+type Feed string
+
+const (
+	Stocks Feed = "stocks"
+	Forex  Feed = "forex"
+	Crypto Feed = "crypto"
+)
+
+// Endpoints are the URLs of the feeds.
+var Endpoints = map[Feed]string{
+	Stocks: "wss://websockets.financialmodelingprep.com",
+	Forex:  "wss://forex.financialmodelingprep.com",
+	Crypto: "wss://crypto.financialmodelingprep.com",
+}
+
+const (
+	// DefaultHeartbeatInterval is the default interval between pings.
+	DefaultHeartbeatInterval = 15 * time.Second
+
+	// DefaultReconnectDelay is the default delay before the first reconnect, doubled after each
+	// failed attempt up to DefaultMaxReconnectDelay.
+	DefaultReconnectDelay    = time.Second
+	DefaultMaxReconnectDelay = time.Minute
+
+	// DefaultBuffer is the default capacity of the ticks.
+	DefaultBuffer = 1024
+)
+
+// ErrUnauthorized is returned by Run when FMP rejects the login, which is not retried.
+var ErrUnauthorized = errors.New("realtime: login rejected")
+
+// TickType is the kind of a tick.
+type TickType string
+
+const (
+	TickTrade TickType = "T"
+	TickQuote TickType = "Q"
+
+	// TickBreak marks a break of the stream of a symbol, e.g. a halt.
+	TickBreak TickType = "B"
+)
+
+// Tick is a trade or quote of a feed. Prices and sizes absent for the type are zero.
+type Tick struct {
+	// Symbol is uppercased, e.g. "AAPL" or "EURUSD".
+	Symbol string   `json:"s"`
+	Type   TickType `json:"type"`
+
+	// Time is the time as sent by FMP, see Timestamp.
+	Time int64 `json:"t"`
+
+	// Exchange is set by the crypto feed.
+	Exchange string `json:"e,omitempty"`
+
+	AskPrice  float64 `json:"ap,omitempty"`
+	AskSize   float64 `json:"as,omitempty"`
+	BidPrice  float64 `json:"bp,omitempty"`
+	BidSize   float64 `json:"bs,omitempty"`
+	LastPrice float64 `json:"lp,omitempty"`
+	LastSize  float64 `json:"ls,omitempty"`
+}
+
+// Timestamp converts Time, which is in nanoseconds, milliseconds or seconds depending on the feed.
+func (t *Tick) Timestamp() time.Time {
+	switch {
+	case t.Time > 1e15:
+		return time.Unix(0, t.Time)
+	case t.Time > 1e11:
+		return time.UnixMilli(t.Time)
+	default:
+		return time.Unix(t.Time, 0)
+	}
+}
+
+// Event is a control message of a feed, e.g. the reply to a login or subscription, or a heartbeat.
+type Event struct {
+	Event   string `json:"event"`
+	Status  int    `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// request is a control message sent to a feed.
+type request struct {
+	Event string `json:"event"`
+	Data  any    `json:"data"`
+}
+
+type loginData struct {
+	APIKey string `json:"apiKey"`
+}
+
+type tickerData struct {
+	Ticker []string `json:"ticker"`
+}
+
+// Config defines the configuration of a Client.
+type Config struct {
+	APIKey string
+
+	// Feed is Stocks when empty.
+	Feed Feed
+
+	// URL overrides the endpoint of the feed, e.g. with the URL of a Server.
+	URL string
+
+	// HeartbeatInterval is the interval between pings, DefaultHeartbeatInterval when zero. The
+	// connection is dropped when nothing is received for two intervals.
+	HeartbeatInterval time.Duration
+
+	// ReconnectDelay and MaxReconnectDelay bound the backoff between reconnects,
+	// DefaultReconnectDelay and DefaultMaxReconnectDelay when zero.
+	ReconnectDelay    time.Duration
+	MaxReconnectDelay time.Duration
+
+	// Buffer is the capacity of the ticks, DefaultBuffer when zero.
+	Buffer int
+
+	// OnEvent is called with the control messages received, if set.
+	OnEvent func(Event)
+}
+
+// Client streams the ticks of the subscribed symbols of a feed.
+type Client struct {
+	conf  Config
+	ticks chan Tick
+
+	mu   sync.Mutex
+	subs map[string]bool
+	conn *websocket.Conn
+
+	// writeMu serializes the writes to conn.
+	writeMu sync.Mutex
+}
+
+// NewClient returns a client of a feed. It connects when Run is called. The config may be nil.
+func NewClient(cfg *Config) *Client {
+	var conf Config
+	if cfg != nil {
+		conf = *cfg
+	}
+	if conf.Feed == "" {
+		conf.Feed = Stocks
+	}
+	if conf.URL == "" {
+		conf.URL = Endpoints[conf.Feed]
+	}
+	if conf.HeartbeatInterval <= 0 {
+		conf.HeartbeatInterval = DefaultHeartbeatInterval
+	}
+	if conf.ReconnectDelay <= 0 {
+		conf.ReconnectDelay = DefaultReconnectDelay
+	}
+	if conf.MaxReconnectDelay <= 0 {
+		conf.MaxReconnectDelay = DefaultMaxReconnectDelay
+	}
+	if conf.Buffer <= 0 {
+		conf.Buffer = DefaultBuffer
+	}
+	return &Client{conf: conf, ticks: make(chan Tick, conf.Buffer), subs: map[string]bool{}}
+}
+
+// Ticks returns the ticks received. It is closed when Run returns.
+func (c *Client) Ticks() <-chan Tick {
+	return c.ticks
+}
+
+// Subscriptions returns the subscribed symbols, sorted.
+func (c *Client) Subscriptions() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	symbols := make([]string, 0, len(c.subs))
+	for symbol := range c.subs {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// Subscribe adds symbols, which are subscribed again after every reconnect. It may be called
+// before Run, and sends the subscription right away when connected. The symbols stay subscribed
+// when sending fails, as the connection is replaced.
+func (c *Client) Subscribe(symbols ...string) error {
+	return c.update("subscribe", true, symbols)
+}
+
+// Unsubscribe removes symbols.
+func (c *Client) Unsubscribe(symbols ...string) error {
+	return c.update("unsubscribe", false, symbols)
+}
+
+func (c *Client) update(event string, subscribed bool, symbols []string) error {
+	tickers := make([]string, 0, len(symbols))
+	c.mu.Lock()
+	for _, symbol := range symbols {
+		symbol = strings.ToUpper(strings.TrimSpace(symbol))
+		if symbol == "" {
+			continue
+		}
+		if subscribed {
+			c.subs[symbol] = true
+		} else {
+			delete(c.subs, symbol)
+		}
+		tickers = append(tickers, strings.ToLower(symbol))
+	}
+	conn := c.conn
+	c.mu.Unlock()
+
+	if conn == nil || len(tickers) == 0 {
+		return nil
+	}
+	return c.send(conn, &request{Event: event, Data: tickerData{Ticker: tickers}})
+}
+
+func (c *Client) send(conn *websocket.Conn, req *request) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := conn.SetWriteDeadline(time.Now().Add(c.conf.HeartbeatInterval)); err != nil {
+		return err
+	}
+	return conn.WriteJSON(req)
+}
+
+// Run connects, logs in and streams ticks until the context is done or the login is rejected,
+// reconnecting and resubscribing whenever the connection drops. It closes the ticks when it
+// returns.
+func (c *Client) Run(ctx context.Context) error {
+	defer close(c.ticks)
+
+	delay := c.conf.ReconnectDelay
+	for {
+		streamed, err := c.connect(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if errors.Is(err, ErrUnauthorized) {
+			return err
+		}
+		if streamed {
+			// Connections that got through the login retry from the shortest delay.
+			delay = c.conf.ReconnectDelay
+		}
+
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+		delay = min(2*delay, c.conf.MaxReconnectDelay)
+	}
+}
+
+// connect runs a connection until it fails. It reports whether the login succeeded.
+func (c *Client) connect(ctx context.Context) (bool, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, c.conf.URL, nil)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	// Drop the connection when the context is done, which unblocks the reads.
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close()
+	})
+	defer stop()
+
+	timeout := 2 * c.conf.HeartbeatInterval
+	alive := func() error {
+		return conn.SetReadDeadline(time.Now().Add(timeout))
+	}
+	conn.SetPongHandler(func(string) error {
+		return alive()
+	})
+	if err := alive(); err != nil {
+		return false, err
+	}
+
+	if err := c.login(conn); err != nil {
+		return false, err
+	}
+
+	// Publish the connection and resubscribe under the same lock, so concurrent Subscribe calls
+	// are neither lost nor sent twice.
+	c.mu.Lock()
+	c.conn = conn
+	tickers := make([]string, 0, len(c.subs))
+	for symbol := range c.subs {
+		tickers = append(tickers, strings.ToLower(symbol))
+	}
+	sort.Strings(tickers)
+	var subscribeErr error
+	if len(tickers) > 0 {
+		subscribeErr = c.send(conn, &request{Event: "subscribe", Data: tickerData{Ticker: tickers}})
+	}
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.conn = nil
+		c.mu.Unlock()
+	}()
+	if subscribeErr != nil {
+		return true, subscribeErr
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go c.heartbeat(conn, done)
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return true, err
+		}
+		if err := alive(); err != nil {
+			return true, err
+		}
+		if err := c.dispatch(ctx, data); err != nil {
+			return true, err
+		}
+	}
+}
+
+// login authenticates and waits for the reply.
+func (c *Client) login(conn *websocket.Conn) error {
+	if err := c.send(conn, &request{Event: "login", Data: loginData{APIKey: c.conf.APIKey}}); err != nil {
+		return err
+	}
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+		var e Event
+		if err := json.Unmarshal(data, &e); err != nil {
+			return fmt.Errorf("realtime: invalid message %q: %w", data, err)
+		}
+		if e.Event != "login" {
+			c.event(e)
+			continue
+		}
+		c.event(e)
+		if e.Status != 200 {
+			return fmt.Errorf("%w: %d %s", ErrUnauthorized, e.Status, e.Message)
+		}
+		return nil
+	}
+}
+
+// heartbeat pings the server until done is closed.
+func (c *Client) heartbeat(conn *websocket.Conn, done <-chan struct{}) {
+	t := time.NewTicker(c.conf.HeartbeatInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-t.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(c.conf.HeartbeatInterval)); err != nil {
+				conn.Close()
+				return
+			}
+		}
+	}
+}
+
+func (c *Client) event(e Event) {
+	if c.conf.OnEvent != nil {
+		c.conf.OnEvent(e)
+	}
+}
+
+// dispatch decodes a message, which is a tick, an event or an array of either.
+func (c *Client) dispatch(ctx context.Context, data []byte) error {
+	var messages []json.RawMessage
+	if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal(data, &messages); err != nil {
+			return fmt.Errorf("realtime: invalid message %q: %w", data, err)
+		}
+	} else {
+		messages = []json.RawMessage{data}
+	}
+
+	for _, m := range messages {
+		var fields struct {
+			Event
+			Tick
+		}
+		if err := json.Unmarshal(m, &fields); err != nil {
+			return fmt.Errorf("realtime: invalid message %q: %w", m, err)
+		}
+		if fields.Event.Event != "" {
+			c.event(fields.Event)
+			continue
+		}
+		if fields.Tick.Symbol == "" {
+			continue
+		}
+		tick := fields.Tick
+		tick.Symbol = strings.ToUpper(tick.Symbol)
+		select {
+		case c.ticks <- tick:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
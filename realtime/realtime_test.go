@@ -0,0 +1,195 @@
+package realtime
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const testAPIKey = "test-key"
+
+type realtimeSuite struct {
+	suite.Suite
+	server *Server
+}
+
+func (r *realtimeSuite) SetupTest() {
+	r.server = NewServer(testAPIKey)
+}
+
+func (r *realtimeSuite) TearDownTest() {
+	r.server.Close()
+}
+
+// start runs a client against the server until the test cleans up.
+func (r *realtimeSuite) start(cfg *Config) (*Client, <-chan error) {
+	conf := *cfg
+	conf.URL = r.server.URL()
+	if conf.APIKey == "" {
+		conf.APIKey = testAPIKey
+	}
+	if conf.ReconnectDelay == 0 {
+		conf.ReconnectDelay = 10 * time.Millisecond
+	}
+	c := NewClient(&conf)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- c.Run(ctx)
+	}()
+	r.T().Cleanup(func() {
+		cancel()
+		for range c.Ticks() {
+		}
+	})
+	return c, done
+}
+
+func (r *realtimeSuite) subscribed(symbols ...string) {
+	r.Eventually(func() bool {
+		subs := r.server.Subscriptions()
+		if len(subs) != len(symbols) {
+			return false
+		}
+		for i := range subs {
+			if subs[i] != symbols[i] {
+				return false
+			}
+		}
+		return true
+	}, 2*time.Second, 5*time.Millisecond)
+}
+
+func (r *realtimeSuite) next(c *Client) Tick {
+	select {
+	case t := <-c.Ticks():
+		return t
+	case <-time.After(2 * time.Second):
+		r.FailNow("no tick")
+		return Tick{}
+	}
+}
+
+func (r *realtimeSuite) TestSubscribe() {
+	var mu sync.Mutex
+	var events []Event
+	c, _ := r.start(&Config{OnEvent: func(e Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, e)
+	}})
+	r.Require().NoError(c.Subscribe("aapl", " MSFT ", ""))
+	r.Equal([]string{"AAPL", "MSFT"}, c.Subscriptions())
+	r.subscribed("AAPL", "MSFT")
+
+	r.Equal(1, r.server.Publish(Tick{Symbol: "AAPL", Type: TickQuote, Time: 1766415600000, AskPrice: 200.1, AskSize: 3, BidPrice: 200, BidSize: 5}))
+	r.Zero(r.server.Publish(Tick{Symbol: "NVDA", Type: TickTrade, LastPrice: 180}))
+	r.Equal(1, r.server.Publish(Tick{Symbol: "msft", Type: TickTrade, Time: 1766415600, LastPrice: 400, LastSize: 10}))
+
+	t := r.next(c)
+	r.Equal("AAPL", t.Symbol)
+	r.Equal(TickQuote, t.Type)
+	r.Equal(200.1, t.AskPrice)
+	r.Equal(5.0, t.BidSize)
+	r.Equal(time.UnixMilli(1766415600000), t.Timestamp())
+
+	t = r.next(c)
+	r.Equal("MSFT", t.Symbol)
+	r.Equal(TickTrade, t.Type)
+	r.Equal(400.0, t.LastPrice)
+	r.Equal(time.Unix(1766415600, 0), t.Timestamp())
+
+	r.Require().NoError(c.Unsubscribe("MSFT"))
+	r.subscribed("AAPL")
+	r.Zero(r.server.Publish(Tick{Symbol: "MSFT", Type: TickTrade, LastPrice: 401}))
+
+	r.Equal(1, r.server.Heartbeat())
+	r.Eventually(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(events) > 0 && events[len(events)-1].Event == "heartbeat"
+	}, 2*time.Second, 5*time.Millisecond)
+
+	mu.Lock()
+	r.Equal(Event{Event: "login", Status: 200, Message: "Authenticated"}, events[0])
+	mu.Unlock()
+}
+
+func (r *realtimeSuite) TestReconnect() {
+	c, _ := r.start(&Config{Feed: Forex})
+	r.Require().NoError(c.Subscribe("EURUSD", "GBPUSD"))
+	r.subscribed("EURUSD", "GBPUSD")
+	r.Equal(1, r.server.Logins())
+
+	r.server.Disconnect()
+	r.Eventually(func() bool {
+		return r.server.Logins() == 2 && r.server.Connections() == 1
+	}, 2*time.Second, 5*time.Millisecond)
+	r.subscribed("EURUSD", "GBPUSD")
+
+	r.Equal(1, r.server.Publish(Tick{Symbol: "eurusd", Type: TickQuote, Time: 1766415600000000000, AskPrice: 1.1712, BidPrice: 1.171}))
+	t := r.next(c)
+	r.Equal("EURUSD", t.Symbol)
+	r.Equal(time.Unix(0, 1766415600000000000), t.Timestamp())
+}
+
+func (r *realtimeSuite) TestSubscribeBeforeRun() {
+	c := NewClient(&Config{URL: r.server.URL(), APIKey: testAPIKey})
+	r.Require().NoError(c.Subscribe("BTCUSD"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- c.Run(ctx)
+	}()
+	r.subscribed("BTCUSD")
+	r.Equal(1, r.server.Publish(Tick{Symbol: "btcusd", Type: TickTrade, Exchange: "binance", LastPrice: 100000}))
+	r.Equal("binance", r.next(c).Exchange)
+
+	cancel()
+	r.ErrorIs(<-done, context.Canceled)
+	_, open := <-c.Ticks()
+	r.False(open)
+}
+
+func (r *realtimeSuite) TestNilConfig() {
+	c := NewClient(nil)
+	r.Require().NoError(c.Subscribe("AAPL"))
+	r.Equal([]string{"AAPL"}, c.Subscriptions())
+}
+
+func (r *realtimeSuite) TestHeartbeatTimeout() {
+	c, _ := r.start(&Config{HeartbeatInterval: 20 * time.Millisecond})
+	r.Require().NoError(c.Subscribe("AAPL"))
+	r.subscribed("AAPL")
+
+	// Pongs keep the idle connection alive.
+	time.Sleep(100 * time.Millisecond)
+	r.Equal(1, r.server.Logins())
+
+	r.server.SetSilent(true)
+	r.Eventually(func() bool {
+		return r.server.Logins() > 1 && r.server.Connections() == 1
+	}, 2*time.Second, 5*time.Millisecond)
+	r.server.SetSilent(false)
+	r.subscribed("AAPL")
+}
+
+func (r *realtimeSuite) TestUnauthorized() {
+	_, done := r.start(&Config{APIKey: "wrong"})
+	select {
+	case err := <-done:
+		r.ErrorIs(err, ErrUnauthorized)
+	case <-time.After(2 * time.Second):
+		r.FailNow("login not rejected")
+	}
+	r.Zero(r.server.Logins())
+}
+
+func TestRealtimeSuite(t *testing.T) {
+	suite.Run(t, new(realtimeSuite))
+}
@@ -0,0 +1,237 @@
+package realtime
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// Server stands in for a WebSocket feed of FMP, to test clients offline. It accepts the login with
+// its API key, tracks subscriptions per connection and sends the ticks published to subscribers.
+type Server struct {
+	apiKey   string
+	upgrader websocket.Upgrader
+	listener net.Listener
+	srv      *http.Server
+
+	mu     sync.Mutex
+	conns  map[*serverConn]struct{}
+	logins int
+
+	// silent stops answering pings, to test heartbeat timeouts.
+	silent bool
+}
+
+// serverConn is a connection of a client to a Server.
+type serverConn struct {
+	conn *websocket.Conn
+
+	// writeMu serializes the writes to conn.
+	writeMu sync.Mutex
+
+	// authenticated and subs are guarded by the mutex of the Server.
+	authenticated bool
+	subs          map[string]bool
+}
+
+func (c *serverConn) write(v any) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.conn.WriteJSON(v)
+}
+
+// NewServer starts a server on a free port of the loopback interface, accepting the API key. It
+// panics when no port is free. Close it when done.
+func NewServer(apiKey string) *Server {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic("realtime: failed to listen on a port: " + err.Error())
+	}
+	s := &Server{apiKey: apiKey, listener: l, conns: map[*serverConn]struct{}{}}
+	s.srv = &http.Server{Handler: http.HandlerFunc(s.serve)}
+	go s.srv.Serve(l)
+	return s
+}
+
+// URL returns the WebSocket URL of the server, for Config.URL.
+func (s *Server) URL() string {
+	return "ws://" + s.listener.Addr().String()
+}
+
+// Close disconnects the clients and shuts the server down.
+func (s *Server) Close() {
+	s.Disconnect()
+	s.srv.Close()
+}
+
+// Disconnect drops the connections of all clients, which may reconnect.
+func (s *Server) Disconnect() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for c := range s.conns {
+		c.conn.Close()
+	}
+}
+
+// SetSilent stops or resumes answering pings.
+func (s *Server) SetSilent(silent bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.silent = silent
+}
+
+// Connections returns the number of connected clients.
+func (s *Server) Connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.conns)
+}
+
+// Logins returns the number of successful logins since the server started.
+func (s *Server) Logins() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.logins
+}
+
+// Subscriptions returns the symbols subscribed by any client, uppercased and sorted.
+func (s *Server) Subscriptions() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	seen := map[string]bool{}
+	for c := range s.conns {
+		for symbol := range c.subs {
+			seen[symbol] = true
+		}
+	}
+	symbols := make([]string, 0, len(seen))
+	for symbol := range seen {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// Publish sends a tick to the clients subscribed to its symbol, with the symbol lowercased as FMP
+// does. It returns the number of clients sent to.
+func (s *Server) Publish(t Tick) int {
+	t.Symbol = strings.ToLower(t.Symbol)
+	return s.broadcast(t, func(c *serverConn) bool {
+		return c.subs[strings.ToUpper(t.Symbol)]
+	})
+}
+
+// Heartbeat sends a heartbeat event to the authenticated clients.
+func (s *Server) Heartbeat() int {
+	return s.broadcast(Event{Event: "heartbeat", Status: 200}, func(*serverConn) bool {
+		return true
+	})
+}
+
+func (s *Server) broadcast(v any, match func(*serverConn) bool) int {
+	s.mu.Lock()
+	var targets []*serverConn
+	for c := range s.conns {
+		if c.authenticated && match(c) {
+			targets = append(targets, c)
+		}
+	}
+	s.mu.Unlock()
+
+	sent := 0
+	for _, c := range targets {
+		if c.write(v) == nil {
+			sent++
+		}
+	}
+	return sent
+}
+
+func (s *Server) serve(w http.ResponseWriter, req *http.Request) {
+	conn, err := s.upgrader.Upgrade(w, req, nil)
+	if err != nil {
+		return
+	}
+	c := &serverConn{conn: conn, subs: map[string]bool{}}
+	conn.SetPingHandler(func(data string) error {
+		s.mu.Lock()
+		silent := s.silent
+		s.mu.Unlock()
+		if silent {
+			return nil
+		}
+		c.writeMu.Lock()
+		defer c.writeMu.Unlock()
+		return conn.WriteMessage(websocket.PongMessage, []byte(data))
+	})
+
+	s.mu.Lock()
+	s.conns[c] = struct{}{}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		s.mu.Unlock()
+		conn.Close()
+	}()
+
+	for {
+		var r struct {
+			Event string          `json:"event"`
+			Data  json.RawMessage `json:"data"`
+		}
+		if err := conn.ReadJSON(&r); err != nil {
+			return
+		}
+		if err := s.handle(c, r.Event, r.Data); err != nil {
+			return
+		}
+	}
+}
+
+// handle answers a request of a client like FMP.
+func (s *Server) handle(c *serverConn, event string, data json.RawMessage) error {
+	switch event {
+	case "login":
+		var login loginData
+		if json.Unmarshal(data, &login) != nil || login.APIKey != s.apiKey {
+			c.write(Event{Event: "login", Status: 401, Message: "Unauthorized"})
+			return ErrUnauthorized
+		}
+		s.mu.Lock()
+		c.authenticated = true
+		s.logins++
+		s.mu.Unlock()
+		return c.write(Event{Event: "login", Status: 200, Message: "Authenticated"})
+
+	case "subscribe", "unsubscribe":
+		s.mu.Lock()
+		authenticated := c.authenticated
+		s.mu.Unlock()
+		if !authenticated {
+			return c.write(Event{Event: event, Status: 401, Message: "Not authenticated"})
+		}
+		var tickers tickerData
+		if err := json.Unmarshal(data, &tickers); err != nil {
+			return c.write(Event{Event: event, Status: 400, Message: "Invalid tickers"})
+		}
+		s.mu.Lock()
+		for _, ticker := range tickers.Ticker {
+			if event == "subscribe" {
+				c.subs[strings.ToUpper(ticker)] = true
+			} else {
+				delete(c.subs, strings.ToUpper(ticker))
+			}
+		}
+		s.mu.Unlock()
+		return c.write(Event{Event: event, Status: 200, Message: strings.Join(tickers.Ticker, ",")})
+
+	default:
+		return c.write(Event{Event: event, Status: 400, Message: "Unknown event"})
+	}
+}
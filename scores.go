@@ -0,0 +1,306 @@
+package financialmodelingprep
+
+import (
+	"fmt"
+	"sort"
+)
+
+// PiotroskiScore is the Piotroski F-score with its nine criteria broken out. Ratios use the
+// total assets at the end of each fiscal year.
+type PiotroskiScore struct {
+	// Profitability.
+	PositiveReturnOnAssets    bool
+	PositiveOperatingCashFlow bool
+	ImprovingReturnOnAssets   bool
+	CashFlowExceedsNetIncome  bool
+
+	// Leverage, liquidity and source of funds.
+	DecreasingLeverage    bool
+	ImprovingCurrentRatio bool
+	NoNewShares           bool
+
+	// Operating efficiency.
+	ImprovingGrossMargin   bool
+	ImprovingAssetTurnover bool
+
+	Score int
+}
+
+// AltmanZone classifies an Altman Z-score.
+type AltmanZone string
+
+// Defines values for AltmanZone.
+const (
+	AltmanSafe     AltmanZone = "safe"
+	AltmanGrey     AltmanZone = "grey"
+	AltmanDistress AltmanZone = "distress"
+)
+
+// AltmanZScore is the original Altman Z-score with its components.
+type AltmanZScore struct {
+	WorkingCapitalToAssets   float64
+	RetainedEarningsToAssets float64
+	EbitToAssets             float64
+	MarketCapToLiabilities   float64
+	RevenueToAssets          float64
+
+	Score float64
+	Zone  AltmanZone
+}
+
+// BeneishMScore is the eight-variable Beneish M-score with its indexes.
+type BeneishMScore struct {
+	DaysSalesInReceivablesIndex float64
+	GrossMarginIndex            float64
+	AssetQualityIndex           float64
+	SalesGrowthIndex            float64
+	DepreciationIndex           float64
+	SGAExpensesIndex            float64
+	TotalAccrualsToAssets       float64
+	LeverageIndex               float64
+
+	Score float64
+
+	// LikelyManipulator is set when the score exceeds -1.78.
+	LikelyManipulator bool
+}
+
+// Scores holds the scores of a company for a fiscal period.
+type Scores struct {
+	Symbol string
+	FinancialPeriod
+
+	Piotroski *PiotroskiScore
+	AltmanZ   *AltmanZScore
+	BeneishM  *BeneishMScore
+}
+
+func ratio(numerator, denominator float64) float64 {
+	if denominator == 0 {
+		return 0
+	}
+	return numerator / denominator
+}
+
+func checkComplete(statements ...*FinancialStatements) error {
+	for _, s := range statements {
+		if s == nil || !s.Complete() {
+			return fmt.Errorf("incomplete statements")
+		}
+	}
+	return nil
+}
+
+// NewPiotroskiScore computes the Piotroski F-score of the current fiscal period against the
+// previous one.
+func NewPiotroskiScore(current, previous *FinancialStatements) (*PiotroskiScore, error) {
+	if err := checkComplete(current, previous); err != nil {
+		return nil, err
+	}
+	cis, cbs, ccf := current.IncomeStatement, current.BalanceSheetStatement, current.CashFlowStatement
+	pis, pbs := previous.IncomeStatement, previous.BalanceSheetStatement
+
+	roa := ratio(cis.NetIncome, cbs.TotalAssets)
+	s := &PiotroskiScore{
+		PositiveReturnOnAssets:    roa > 0,
+		PositiveOperatingCashFlow: ccf.OperatingCashFlow > 0,
+		ImprovingReturnOnAssets:   roa > ratio(pis.NetIncome, pbs.TotalAssets),
+		CashFlowExceedsNetIncome:  ccf.OperatingCashFlow > cis.NetIncome,
+		DecreasingLeverage:        ratio(cbs.LongTermDebt, cbs.TotalAssets) < ratio(pbs.LongTermDebt, pbs.TotalAssets),
+		ImprovingCurrentRatio:     ratio(cbs.TotalCurrentAssets, cbs.TotalCurrentLiabilities) > ratio(pbs.TotalCurrentAssets, pbs.TotalCurrentLiabilities),
+		NoNewShares:               cis.WeightedAverageShsOut <= pis.WeightedAverageShsOut,
+		ImprovingGrossMargin:      ratio(cis.GrossProfit, cis.Revenue) > ratio(pis.GrossProfit, pis.Revenue),
+		ImprovingAssetTurnover:    ratio(cis.Revenue, cbs.TotalAssets) > ratio(pis.Revenue, pbs.TotalAssets),
+	}
+	for _, ok := range []bool{
+		s.PositiveReturnOnAssets,
+		s.PositiveOperatingCashFlow,
+		s.ImprovingReturnOnAssets,
+		s.CashFlowExceedsNetIncome,
+		s.DecreasingLeverage,
+		s.ImprovingCurrentRatio,
+		s.NoNewShares,
+		s.ImprovingGrossMargin,
+		s.ImprovingAssetTurnover,
+	} {
+		if ok {
+			s.Score++
+		}
+	}
+	return s, nil
+}
+
+// NewAltmanZScore computes the Altman Z-score of a fiscal period given the market capitalization of
+// the company.
+func NewAltmanZScore(current *FinancialStatements, marketCap float64) (*AltmanZScore, error) {
+	if current == nil || current.IncomeStatement == nil || current.BalanceSheetStatement == nil {
+		return nil, fmt.Errorf("incomplete statements")
+	}
+	is, bs := current.IncomeStatement, current.BalanceSheetStatement
+	z := &AltmanZScore{
+		WorkingCapitalToAssets:   ratio(bs.TotalCurrentAssets-bs.TotalCurrentLiabilities, bs.TotalAssets),
+		RetainedEarningsToAssets: ratio(bs.RetainedEarnings, bs.TotalAssets),
+		EbitToAssets:             ratio(is.Ebit, bs.TotalAssets),
+		MarketCapToLiabilities:   ratio(marketCap, bs.TotalLiabilities),
+		RevenueToAssets:          ratio(is.Revenue, bs.TotalAssets),
+	}
+	z.Score = 1.2*z.WorkingCapitalToAssets +
+		1.4*z.RetainedEarningsToAssets +
+		3.3*z.EbitToAssets +
+		0.6*z.MarketCapToLiabilities +
+		1.0*z.RevenueToAssets
+	switch {
+	case z.Score > 2.99:
+		z.Zone = AltmanSafe
+	case z.Score >= 1.81:
+		z.Zone = AltmanGrey
+	default:
+		z.Zone = AltmanDistress
+	}
+	return z, nil
+}
+
+// NewBeneishMScore computes the Beneish M-score of the current fiscal period against the previous
+// one.
+func NewBeneishMScore(current, previous *FinancialStatements) (*BeneishMScore, error) {
+	if err := checkComplete(current, previous); err != nil {
+		return nil, err
+	}
+	cis, cbs, ccf := current.IncomeStatement, current.BalanceSheetStatement, current.CashFlowStatement
+	pis, pbs := previous.IncomeStatement, previous.BalanceSheetStatement
+
+	assetQuality := func(bs *BalanceSheetStatement) float64 {
+		return 1 - ratio(bs.TotalCurrentAssets+bs.PropertyPlantEquipmentNet, bs.TotalAssets)
+	}
+	depreciationRate := func(is *IncomeStatement, bs *BalanceSheetStatement) float64 {
+		return ratio(is.DepreciationAndAmortization, is.DepreciationAndAmortization+bs.PropertyPlantEquipmentNet)
+	}
+	leverage := func(bs *BalanceSheetStatement) float64 {
+		return ratio(bs.TotalCurrentLiabilities+bs.LongTermDebt, bs.TotalAssets)
+	}
+
+	m := &BeneishMScore{
+		DaysSalesInReceivablesIndex: ratio(ratio(cbs.NetReceivables, cis.Revenue), ratio(pbs.NetReceivables, pis.Revenue)),
+		GrossMarginIndex:            ratio(ratio(pis.GrossProfit, pis.Revenue), ratio(cis.GrossProfit, cis.Revenue)),
+		AssetQualityIndex:           ratio(assetQuality(cbs), assetQuality(pbs)),
+		SalesGrowthIndex:            ratio(cis.Revenue, pis.Revenue),
+		DepreciationIndex:           ratio(depreciationRate(pis, pbs), depreciationRate(cis, cbs)),
+		SGAExpensesIndex:            ratio(ratio(cis.SellingGeneralAndAdministrativeExpenses, cis.Revenue), ratio(pis.SellingGeneralAndAdministrativeExpenses, pis.Revenue)),
+		TotalAccrualsToAssets:       ratio(cis.NetIncomeFromContinuingOperations-ccf.OperatingCashFlow, cbs.TotalAssets),
+		LeverageIndex:               ratio(leverage(cbs), leverage(pbs)),
+	}
+	m.Score = -4.84 +
+		0.920*m.DaysSalesInReceivablesIndex +
+		0.528*m.GrossMarginIndex +
+		0.404*m.AssetQualityIndex +
+		0.892*m.SalesGrowthIndex +
+		0.115*m.DepreciationIndex -
+		0.172*m.SGAExpensesIndex +
+		4.679*m.TotalAccrualsToAssets -
+		0.327*m.LeverageIndex
+	m.LikelyManipulator = m.Score > -1.78
+	return m, nil
+}
+
+// NewFinancialScores computes the figures of FinancialScoresGet locally.
+func NewFinancialScores(current, previous *FinancialStatements, marketCap float64) (*FinancialScores, error) {
+	z, err := NewAltmanZScore(current, marketCap)
+	if err != nil {
+		return nil, err
+	}
+	f, err := NewPiotroskiScore(current, previous)
+	if err != nil {
+		return nil, err
+	}
+	is, bs := current.IncomeStatement, current.BalanceSheetStatement
+	return &FinancialScores{
+		Symbol:           is.Symbol,
+		ReportedCurrency: is.ReportedCurrency,
+		AltmanZScore:     z.Score,
+		PiotroskiScore:   f.Score,
+		WorkingCapital:   bs.TotalCurrentAssets - bs.TotalCurrentLiabilities,
+		TotalAssets:      bs.TotalAssets,
+		RetainedEarnings: bs.RetainedEarnings,
+		Ebit:             is.Ebit,
+		MarketCap:        marketCap,
+		TotalLiabilities: bs.TotalLiabilities,
+		Revenue:          is.Revenue,
+	}, nil
+}
+
+// ScoreFinancials scores the latest fiscal period of f that has a complete previous period of the
+// same kind. Scores that cannot be computed are left nil.
+func ScoreFinancials(f *Financials, marketCap float64) (*Scores, error) {
+	for i := len(f.Periods) - 1; i >= 0; i-- {
+		current := &f.Periods[i]
+		if !current.Complete() {
+			continue
+		}
+		previous, ok := f.previous(current.FinancialPeriod)
+		if !ok || !previous.Complete() {
+			continue
+		}
+		s := &Scores{
+			Symbol:          f.Symbol,
+			FinancialPeriod: current.FinancialPeriod,
+		}
+		s.Piotroski, _ = NewPiotroskiScore(current, previous)
+		s.BeneishM, _ = NewBeneishMScore(current, previous)
+		if marketCap > 0 {
+			s.AltmanZ, _ = NewAltmanZScore(current, marketCap)
+		}
+		return s, nil
+	}
+	return nil, fmt.Errorf("no two consecutive complete periods for %s", f.Symbol)
+}
+
+// previous returns the period a year before fp, i.e. the same quarter or the previous fiscal year.
+func (f *Financials) previous(fp FinancialPeriod) (*FinancialStatements, bool) {
+	return f.Lookup(FinancialPeriod{FiscalYear: fmt.Sprint(fp.year() - 1), Period: fp.Period})
+}
+
+// ScoreUniverse scores every company found in bulk statements, e.g. decoded with ReadCSV from
+// IncomeStatementBulkGet, BalanceSheetStatementBulkGet and CashFlowStatementBulkGet for two
+// consecutive years. Market capitalizations are keyed by symbol; the Altman Z-score is skipped for
+// symbols without one. Companies that cannot be scored are left out. The result is sorted by symbol.
+func ScoreUniverse(incomes []IncomeStatement, balances []BalanceSheetStatement, cashFlows []CashFlowStatement, marketCaps map[string]float64) []*Scores {
+	type statements struct {
+		incomes   []IncomeStatement
+		balances  []BalanceSheetStatement
+		cashFlows []CashFlowStatement
+	}
+	bySymbol := map[string]*statements{}
+	group := func(symbol string) *statements {
+		g, ok := bySymbol[symbol]
+		if !ok {
+			g = &statements{}
+			bySymbol[symbol] = g
+		}
+		return g
+	}
+	for _, s := range incomes {
+		g := group(s.Symbol)
+		g.incomes = append(g.incomes, s)
+	}
+	for _, s := range balances {
+		g := group(s.Symbol)
+		g.balances = append(g.balances, s)
+	}
+	for _, s := range cashFlows {
+		g := group(s.Symbol)
+		g.cashFlows = append(g.cashFlows, s)
+	}
+
+	var scores []*Scores
+	for symbol, g := range bySymbol {
+		s, err := ScoreFinancials(NewFinancials(symbol, g.incomes, g.balances, g.cashFlows), marketCaps[symbol])
+		if err != nil {
+			continue
+		}
+		scores = append(scores, s)
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		return scores[i].Symbol < scores[j].Symbol
+	})
+	return scores
+}
@@ -0,0 +1,101 @@
+package financialmodelingprep
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type scoresSuite struct {
+	suite.Suite
+}
+
+func (r *scoresSuite) statements() ([]IncomeStatement, []BalanceSheetStatement, []CashFlowStatement) {
+	incomes := []IncomeStatement{
+		{Symbol: "ACME", FiscalYear: "2023", Period: "FY", Revenue: 1000, GrossProfit: 400, Ebit: 150, NetIncome: 100, NetIncomeFromContinuingOperations: 100, DepreciationAndAmortization: 50, SellingGeneralAndAdministrativeExpenses: 200, WeightedAverageShsOut: 100},
+		{Symbol: "ACME", FiscalYear: "2024", Period: "FY", Revenue: 1200, GrossProfit: 500, Ebit: 200, NetIncome: 130, NetIncomeFromContinuingOperations: 130, DepreciationAndAmortization: 55, SellingGeneralAndAdministrativeExpenses: 230, WeightedAverageShsOut: 98},
+	}
+	balances := []BalanceSheetStatement{
+		{Symbol: "ACME", FiscalYear: "2023", Period: "FY", TotalAssets: 2000, TotalCurrentAssets: 800, TotalCurrentLiabilities: 500, LongTermDebt: 400, TotalLiabilities: 1000, RetainedEarnings: 600, NetReceivables: 150, PropertyPlantEquipmentNet: 700},
+		{Symbol: "ACME", FiscalYear: "2024", Period: "FY", TotalAssets: 2100, TotalCurrentAssets: 900, TotalCurrentLiabilities: 520, LongTermDebt: 380, TotalLiabilities: 1000, RetainedEarnings: 700, NetReceivables: 170, PropertyPlantEquipmentNet: 720},
+	}
+	cashFlows := []CashFlowStatement{
+		{Symbol: "ACME", FiscalYear: "2023", Period: "FY", OperatingCashFlow: 140},
+		{Symbol: "ACME", FiscalYear: "2024", Period: "FY", OperatingCashFlow: 180},
+	}
+	return incomes, balances, cashFlows
+}
+
+func (r *scoresSuite) financials() *Financials {
+	incomes, balances, cashFlows := r.statements()
+	return NewFinancials("ACME", incomes, balances, cashFlows)
+}
+
+func (r *scoresSuite) TestPiotroskiScore() {
+	f := r.financials()
+	current, _ := f.Lookup(FinancialPeriod{FiscalYear: "2024", Period: "FY"})
+	previous, _ := f.Lookup(FinancialPeriod{FiscalYear: "2023", Period: "FY"})
+
+	s, err := NewPiotroskiScore(current, previous)
+	r.NoError(err)
+	r.True(s.PositiveReturnOnAssets)
+	r.True(s.CashFlowExceedsNetIncome)
+	r.True(s.DecreasingLeverage)
+	r.True(s.NoNewShares)
+	r.Equal(9, s.Score)
+
+	_, err = NewPiotroskiScore(current, nil)
+	r.Error(err)
+}
+
+func (r *scoresSuite) TestAltmanZScore() {
+	f := r.financials()
+	current, _ := f.Lookup(FinancialPeriod{FiscalYear: "2024", Period: "FY"})
+
+	z, err := NewAltmanZScore(current, 3000)
+	r.NoError(err)
+	// 1.2*380/2100 + 1.4*700/2100 + 3.3*200/2100 + 0.6*3000/1000 + 1200/2100
+	r.InDelta(3.3695, z.Score, 1e-4)
+	r.Equal(AltmanSafe, z.Zone)
+}
+
+func (r *scoresSuite) TestBeneishMScore() {
+	f := r.financials()
+	current, _ := f.Lookup(FinancialPeriod{FiscalYear: "2024", Period: "FY"})
+	previous, _ := f.Lookup(FinancialPeriod{FiscalYear: "2023", Period: "FY"})
+
+	m, err := NewBeneishMScore(current, previous)
+	r.NoError(err)
+	r.InDelta(1.2, m.SalesGrowthIndex, 1e-9)
+	r.InDelta(0.96, m.GrossMarginIndex, 1e-9)
+	r.False(m.LikelyManipulator)
+}
+
+func (r *scoresSuite) TestFinancialScores() {
+	f := r.financials()
+	current, _ := f.Lookup(FinancialPeriod{FiscalYear: "2024", Period: "FY"})
+	previous, _ := f.Lookup(FinancialPeriod{FiscalYear: "2023", Period: "FY"})
+
+	fs, err := NewFinancialScores(current, previous, 3000)
+	r.NoError(err)
+	r.Equal("ACME", fs.Symbol)
+	r.Equal(9, fs.PiotroskiScore)
+	r.InDelta(380, fs.WorkingCapital, 1e-9)
+}
+
+func (r *scoresSuite) TestScoreUniverse() {
+	incomes, balances, cashFlows := r.statements()
+	incomes = append(incomes, IncomeStatement{Symbol: "LONE", FiscalYear: "2024", Period: "FY"})
+
+	scores := ScoreUniverse(incomes, balances, cashFlows, map[string]float64{"ACME": 3000})
+	r.Len(scores, 1)
+	r.Equal("ACME", scores[0].Symbol)
+	r.Equal("2024", scores[0].FiscalYear)
+	r.NotNil(scores[0].Piotroski)
+	r.NotNil(scores[0].AltmanZ)
+	r.NotNil(scores[0].BeneishM)
+}
+
+func TestScoresSuite(t *testing.T) {
+	suite.Run(t, new(scoresSuite))
+}
@@ -0,0 +1,512 @@
+package financialmodelingprep
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ScreenRecord joins the bulk data of a company screened by ScreenExpr. Parts missing from the
+// bulk data are nil.
+type ScreenRecord struct {
+	Symbol     string
+	Profile    *CompanyProfile
+	KeyMetrics *KeyMetricsTTM
+	Ratios     *RatiosTTM
+}
+
+// JoinScreenRecords joins profiles, key metrics and ratios by symbol, sorted by symbol.
+func JoinScreenRecords(profiles []CompanyProfile, metrics []KeyMetricsTTM, ratios []RatiosTTM) []ScreenRecord {
+	index := map[string]*ScreenRecord{}
+	record := func(symbol string) *ScreenRecord {
+		r, ok := index[symbol]
+		if !ok {
+			r = &ScreenRecord{Symbol: symbol}
+			index[symbol] = r
+		}
+		return r
+	}
+	for i := range profiles {
+		record(profiles[i].Symbol).Profile = &profiles[i]
+	}
+	for i := range metrics {
+		record(metrics[i].Symbol).KeyMetrics = &metrics[i]
+	}
+	for i := range ratios {
+		record(ratios[i].Symbol).Ratios = &ratios[i]
+	}
+
+	records := make([]ScreenRecord, 0, len(index))
+	for _, r := range index {
+		records = append(records, *r)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Symbol < records[j].Symbol
+	})
+	return records
+}
+
+// screenSource is a part of a ScreenRecord.
+type screenSource int
+
+const (
+	screenProfile screenSource = iota
+	screenKeyMetrics
+	screenRatios
+)
+
+// screenSources names the parts of a ScreenRecord, which qualify ambiguous fields, e.g.
+// ratios.currentRatioTTM.
+var screenSources = map[string]screenSource{
+	"profile":    screenProfile,
+	"keyMetrics": screenKeyMetrics,
+	"ratios":     screenRatios,
+}
+
+var screenSourceTypes = []reflect.Type{
+	reflect.TypeOf(CompanyProfile{}),
+	reflect.TypeOf(KeyMetricsTTM{}),
+	reflect.TypeOf(RatiosTTM{}),
+}
+
+type screenField struct {
+	source screenSource
+	index  []int
+}
+
+func screenableKind(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64, reflect.Int, reflect.Int32, reflect.Int64, reflect.String, reflect.Bool:
+		return true
+	}
+	return false
+}
+
+// lookupScreenField finds a field of a part by its JSON or Go name.
+func lookupScreenField(source screenSource, name string) (screenField, bool) {
+	t := screenSourceTypes[source]
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		jsonName, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if (sf.Name == name || jsonName == name) && screenableKind(sf.Type) {
+			return screenField{source: source, index: sf.Index}, true
+		}
+	}
+	return screenField{}, false
+}
+
+// resolveScreenField finds an unqualified field, searching the profile, then the key metrics, then
+// the ratios.
+func resolveScreenField(name string) (screenField, bool) {
+	for source := range screenSourceTypes {
+		if f, ok := lookupScreenField(screenSource(source), name); ok {
+			return f, true
+		}
+	}
+	return screenField{}, false
+}
+
+// value returns the field of the record as a float64, string or bool, or nil when missing.
+func (f screenField) value(r *ScreenRecord) any {
+	var v reflect.Value
+	switch f.source {
+	case screenProfile:
+		if r.Profile == nil {
+			return nil
+		}
+		v = reflect.ValueOf(r.Profile).Elem()
+	case screenKeyMetrics:
+		if r.KeyMetrics == nil {
+			return nil
+		}
+		v = reflect.ValueOf(r.KeyMetrics).Elem()
+	case screenRatios:
+		if r.Ratios == nil {
+			return nil
+		}
+		v = reflect.ValueOf(r.Ratios).Elem()
+	}
+	v = v.FieldByIndex(f.index)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	}
+	return nil
+}
+
+// ScreenExpr is a filter over ScreenRecord written as a Go expression, e.g.
+//
+//	marketCap > 10e9 && sector == "Technology" && priceToEarningsRatioTTM < 20
+//
+// Identifiers are the fields of CompanyProfile, KeyMetricsTTM and RatiosTTM by JSON or Go name,
+// searched in that order, or qualified by profile, keyMetrics or ratios, e.g.
+// ratios.currentRatioTTM. Supported are number, string and boolean literals, arithmetic, the
+// comparisons, which compare strings case-insensitively, and the logical operators. Comparisons
+// with a missing value, such as a field of a part without bulk data or a division by zero, are
+// false, and records whose filter evaluates to a missing value do not pass.
+type ScreenExpr struct {
+	src      string
+	expr     ast.Expr
+	fields   map[ast.Expr]screenField
+	literals map[ast.Expr]any
+}
+
+// ParseScreenExpr parses a filter, checking its operators and fields.
+func ParseScreenExpr(src string) (*ScreenExpr, error) {
+	expr, err := parser.ParseExpr(src)
+	if err != nil {
+		return nil, fmt.Errorf("invalid screen expression %q: %w", src, err)
+	}
+	e := &ScreenExpr{src: src, expr: expr, fields: map[ast.Expr]screenField{}, literals: map[ast.Expr]any{}}
+	if err := e.check(expr); err != nil {
+		return nil, fmt.Errorf("invalid screen expression %q: %w", src, err)
+	}
+	return e, nil
+}
+
+// MustParseScreenExpr is like ParseScreenExpr but panics on invalid filters.
+func MustParseScreenExpr(src string) *ScreenExpr {
+	e, err := ParseScreenExpr(src)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+func (e *ScreenExpr) String() string {
+	return e.src
+}
+
+// check validates the nodes of the expression and resolves its fields and literals.
+func (e *ScreenExpr) check(n ast.Expr) error {
+	switch n := n.(type) {
+	case *ast.ParenExpr:
+		return e.check(n.X)
+	case *ast.BasicLit:
+		var (
+			v   any
+			err error
+		)
+		switch n.Kind {
+		case token.INT, token.FLOAT:
+			v, err = strconv.ParseFloat(n.Value, 64)
+		case token.STRING:
+			v, err = strconv.Unquote(n.Value)
+		default:
+			return fmt.Errorf("unsupported literal %s", n.Value)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid literal %s: %w", n.Value, err)
+		}
+		e.literals[n] = v
+		return nil
+	case *ast.Ident:
+		if n.Name == "true" || n.Name == "false" {
+			return nil
+		}
+		f, ok := resolveScreenField(n.Name)
+		if !ok {
+			return fmt.Errorf("unknown field %s", n.Name)
+		}
+		e.fields[n] = f
+		return nil
+	case *ast.SelectorExpr:
+		x, ok := n.X.(*ast.Ident)
+		if !ok {
+			return fmt.Errorf("unsupported selector")
+		}
+		source, ok := screenSources[x.Name]
+		if !ok {
+			return fmt.Errorf("unknown part %s", x.Name)
+		}
+		f, ok := lookupScreenField(source, n.Sel.Name)
+		if !ok {
+			return fmt.Errorf("unknown field %s.%s", x.Name, n.Sel.Name)
+		}
+		e.fields[n] = f
+		return nil
+	case *ast.UnaryExpr:
+		if n.Op != token.NOT && n.Op != token.SUB && n.Op != token.ADD {
+			return fmt.Errorf("unsupported operator %s", n.Op)
+		}
+		return e.check(n.X)
+	case *ast.BinaryExpr:
+		switch n.Op {
+		case token.LAND, token.LOR, token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ,
+			token.ADD, token.SUB, token.MUL, token.QUO:
+		default:
+			return fmt.Errorf("unsupported operator %s", n.Op)
+		}
+		if err := e.check(n.X); err != nil {
+			return err
+		}
+		return e.check(n.Y)
+	default:
+		return fmt.Errorf("unsupported expression %T", n)
+	}
+}
+
+// Match reports whether a record passes the filter. It fails on mismatched types, e.g. a string
+// compared to a number.
+func (e *ScreenExpr) Match(r *ScreenRecord) (bool, error) {
+	v, err := e.eval(e.expr, r)
+	if err != nil {
+		return false, fmt.Errorf("screen expression %q: %w", e.src, err)
+	}
+	switch v := v.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	default:
+		return false, fmt.Errorf("screen expression %q: not a condition", e.src)
+	}
+}
+
+func (e *ScreenExpr) eval(n ast.Expr, r *ScreenRecord) (any, error) {
+	switch n := n.(type) {
+	case *ast.ParenExpr:
+		return e.eval(n.X, r)
+	case *ast.BasicLit:
+		return e.literals[n], nil
+	case *ast.Ident:
+		switch n.Name {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		return e.fields[n].value(r), nil
+	case *ast.SelectorExpr:
+		return e.fields[n].value(r), nil
+	case *ast.UnaryExpr:
+		x, err := e.eval(n.X, r)
+		if err != nil || x == nil {
+			return nil, err
+		}
+		switch x := x.(type) {
+		case bool:
+			if n.Op == token.NOT {
+				return !x, nil
+			}
+		case float64:
+			switch n.Op {
+			case token.SUB:
+				return -x, nil
+			case token.ADD:
+				return x, nil
+			}
+		}
+		return nil, fmt.Errorf("invalid operation %s on %T", n.Op, x)
+	case *ast.BinaryExpr:
+		return e.evalBinary(n, r)
+	}
+	return nil, fmt.Errorf("unsupported expression %T", n)
+}
+
+func (e *ScreenExpr) evalBinary(n *ast.BinaryExpr, r *ScreenRecord) (any, error) {
+	x, err := e.eval(n.X, r)
+	if err != nil {
+		return nil, err
+	}
+
+	// The logical operators short-circuit, and count missing values as false.
+	if n.Op == token.LAND || n.Op == token.LOR {
+		xb, ok := x.(bool)
+		if x != nil && !ok {
+			return nil, fmt.Errorf("invalid operation %s on %T", n.Op, x)
+		}
+		if n.Op == token.LAND && !xb {
+			return false, nil
+		}
+		if n.Op == token.LOR && xb {
+			return true, nil
+		}
+		y, err := e.eval(n.Y, r)
+		if err != nil {
+			return nil, err
+		}
+		yb, ok := y.(bool)
+		if y != nil && !ok {
+			return nil, fmt.Errorf("invalid operation %s on %T", n.Op, y)
+		}
+		return yb, nil
+	}
+
+	y, err := e.eval(n.Y, r)
+	if err != nil {
+		return nil, err
+	}
+	if x == nil || y == nil {
+		if isComparison(n.Op) {
+			return false, nil
+		}
+		return nil, nil
+	}
+	switch x := x.(type) {
+	case float64:
+		y, ok := y.(float64)
+		if !ok {
+			break
+		}
+		switch n.Op {
+		case token.ADD:
+			return x + y, nil
+		case token.SUB:
+			return x - y, nil
+		case token.MUL:
+			return x * y, nil
+		case token.QUO:
+			if y == 0 {
+				return nil, nil
+			}
+			return x / y, nil
+		}
+		return compare(n.Op, x < y, x == y), nil
+	case string:
+		y, ok := y.(string)
+		if !ok || !isComparison(n.Op) {
+			break
+		}
+		c := strings.Compare(strings.ToLower(x), strings.ToLower(y))
+		return compare(n.Op, c < 0, c == 0), nil
+	case bool:
+		y, ok := y.(bool)
+		if !ok || (n.Op != token.EQL && n.Op != token.NEQ) {
+			break
+		}
+		return compare(n.Op, false, x == y), nil
+	}
+	return nil, fmt.Errorf("invalid operation %T %s %T", x, n.Op, y)
+}
+
+func isComparison(op token.Token) bool {
+	switch op {
+	case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+		return true
+	}
+	return false
+}
+
+// compare evaluates a comparison operator given whether x < y and x == y.
+func compare(op token.Token, less, equal bool) bool {
+	switch op {
+	case token.EQL:
+		return equal
+	case token.NEQ:
+		return !equal
+	case token.LSS:
+		return less
+	case token.LEQ:
+		return less || equal
+	case token.GTR:
+		return !less && !equal
+	default:
+		return !less
+	}
+}
+
+// ScreenRecords returns the records passing every filter, in order.
+func ScreenRecords(records []ScreenRecord, filters ...*ScreenExpr) ([]ScreenRecord, error) {
+	var matched []ScreenRecord
+	for i := range records {
+		ok := true
+		for _, f := range filters {
+			m, err := f.Match(&records[i])
+			if err != nil {
+				return nil, err
+			}
+			if !m {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			matched = append(matched, records[i])
+		}
+	}
+	return matched, nil
+}
+
+// bulkRows decodes the rows of a bulk endpoint, which may be served as JSON or as CSV.
+func bulkRows[T any](name string, json200 *[]T, statusCode int, status string, body []byte) ([]T, error) {
+	if json200 != nil {
+		return *json200, nil
+	}
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status of %s: %s", name, status)
+	}
+	return ReadCSV[T](bytes.NewReader(body))
+}
+
+// GetBulkProfiles fetches the parts of ProfileBulkGet, starting at part 0, until an empty part or
+// maxParts parts.
+func GetBulkProfiles(ctx context.Context, c *ClientWithResponses, maxParts int) ([]CompanyProfile, error) {
+	var profiles []CompanyProfile
+	for part := 0; part < maxParts; part++ {
+		resp, err := c.ProfileBulkGetWithResponse(ctx, &ProfileBulkGetParams{Part: strconv.Itoa(part)})
+		if err != nil {
+			return nil, err
+		}
+		rows, err := bulkRows("profile bulk", resp.JSON200, resp.StatusCode(), resp.Status(), resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		profiles = append(profiles, rows...)
+	}
+	return profiles, nil
+}
+
+// GetScreenRecords fetches the bulk profiles, up to maxParts parts, key metrics and ratios, and
+// joins them for ScreenRecords.
+func GetScreenRecords(ctx context.Context, c *ClientWithResponses, maxParts int) ([]ScreenRecord, error) {
+	profiles, err := GetBulkProfiles(ctx, c, maxParts)
+	if err != nil {
+		return nil, err
+	}
+
+	metricsResp, err := c.KeyMetricsTTMBulkGetWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := bulkRows("key metrics ttm bulk", metricsResp.JSON200, metricsResp.StatusCode(), metricsResp.Status(), metricsResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	ratiosResp, err := c.RatiosTTMBulkGetWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ratios, err := bulkRows("ratios ttm bulk", ratiosResp.JSON200, ratiosResp.StatusCode(), ratiosResp.Status(), ratiosResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return JoinScreenRecords(profiles, metrics, ratios), nil
+}
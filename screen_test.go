@@ -0,0 +1,104 @@
+package financialmodelingprep
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type screenSuite struct {
+	suite.Suite
+}
+
+func (r *screenSuite) records() []ScreenRecord {
+	return JoinScreenRecords(
+		[]CompanyProfile{
+			{Symbol: "MSFT", Sector: "Technology", MarketCap: 3e12, Beta: 0.9, Volume: 20000000, IsActivelyTrading: true},
+			{Symbol: "AAPL", Sector: "Technology", MarketCap: 3.4e12, Beta: 1.2, Volume: 40000000, IsActivelyTrading: true},
+			{Symbol: "XOM", Sector: "Energy", MarketCap: 5e11, Beta: 0.8, Volume: 15000000, IsActivelyTrading: true},
+		},
+		[]KeyMetricsTTM{
+			{Symbol: "AAPL", ReturnOnEquityTTM: 1.5, CurrentRatioTTM: 0.9},
+			{Symbol: "MSFT", ReturnOnEquityTTM: 0.35, CurrentRatioTTM: 1.3},
+			{Symbol: "XOM", ReturnOnEquityTTM: 0.15, CurrentRatioTTM: 1.4},
+		},
+		[]RatiosTTM{
+			{Symbol: "AAPL", PriceToEarningsRatioTTM: 35, CurrentRatioTTM: 0.95},
+			{Symbol: "MSFT", PriceToEarningsRatioTTM: 33, CurrentRatioTTM: 1.35},
+			// A company without a profile.
+			{Symbol: "TINY", PriceToEarningsRatioTTM: 8},
+		},
+	)
+}
+
+func (r *screenSuite) symbols(filters ...string) []string {
+	exprs := make([]*ScreenExpr, len(filters))
+	for i, f := range filters {
+		exprs[i] = MustParseScreenExpr(f)
+	}
+	matched, err := ScreenRecords(r.records(), exprs...)
+	r.Require().NoError(err)
+	symbols := []string{}
+	for _, m := range matched {
+		symbols = append(symbols, m.Symbol)
+	}
+	return symbols
+}
+
+func (r *screenSuite) TestJoin() {
+	records := r.records()
+	r.Len(records, 4)
+	r.Equal("AAPL", records[0].Symbol)
+	r.Equal("TINY", records[2].Symbol)
+	r.Nil(records[2].Profile)
+	r.Nil(records[3].Ratios)
+}
+
+func (r *screenSuite) TestScreen() {
+	r.Equal([]string{"AAPL", "MSFT"}, r.symbols(`sector == "technology"`))
+	r.Equal([]string{"AAPL", "MSFT", "XOM"}, r.symbols(`marketCap > 100e9 && isActivelyTrading`))
+	r.Equal([]string{"MSFT"}, r.symbols(`sector == "Technology"`, `priceToEarningsRatioTTM < 34`))
+	r.Equal([]string{"MSFT", "TINY"}, r.symbols(`priceToEarningsRatioTTM <= 33`))
+	r.Equal([]string{"AAPL"}, r.symbols(`returnOnEquityTTM > 1 || beta > 1.5`))
+	r.Equal([]string{"XOM"}, r.symbols(`!(sector == "Technology") && volume / 1e6 >= 15`))
+	r.Equal([]string{"AAPL", "MSFT"}, r.symbols(`marketCap / (beta - 0.8) > 1e12`))
+
+	// Unqualified fields resolve to the key metrics before the ratios.
+	r.Equal([]string{"MSFT", "XOM"}, r.symbols(`currentRatioTTM > 1`))
+	r.Equal([]string{"AAPL", "MSFT"}, r.symbols(`ratios.currentRatioTTM > keyMetrics.currentRatioTTM`))
+	r.Equal([]string{"MSFT"}, r.symbols(`ratios.CurrentRatioTTM > 1`))
+
+	// Comparisons with missing values are false, but may be negated.
+	r.Equal([]string{"AAPL", "MSFT", "XOM"}, r.symbols(`!(priceToEarningsRatioTTM < 10)`))
+	r.Equal([]string{"TINY"}, r.symbols(`priceToEarningsRatioTTM < 10`))
+	r.Empty(r.symbols(`marketCap / 0 > 0`))
+}
+
+func (r *screenSuite) TestInvalid() {
+	for _, src := range []string{
+		`marketCap >`,
+		`unknownField > 1`,
+		`ratios.unknownField > 1`,
+		`other.marketCap > 1`,
+		`len(symbol) > 1`,
+		`marketCap % 2 == 0`,
+		`'a' == symbol`,
+		`marketCap > 0x10`,
+		`marketCap > 0b101`,
+	} {
+		_, err := ParseScreenExpr(src)
+		r.Error(err, src)
+	}
+
+	records := r.records()
+	for _, src := range []string{`sector > 1`, `marketCap`, `sector && true`, `-sector == "x"`} {
+		e, err := ParseScreenExpr(src)
+		r.Require().NoError(err, src)
+		_, err = e.Match(&records[0])
+		r.Error(err, src)
+	}
+}
+
+func TestScreenSuite(t *testing.T) {
+	suite.Run(t, new(screenSuite))
+}
@@ -0,0 +1,121 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Range bounds a value inclusively. Nil bounds are open.
+type Range struct {
+	Min, Max *float64
+}
+
+// Contains reports whether the value lies within the range.
+func (r *Range) Contains(v float64) bool {
+	return (r.Min == nil || v >= *r.Min) && (r.Max == nil || v <= *r.Max)
+}
+
+// ScreenerCriteria selects companies with CompanyScreenerGet. Empty fields match everything.
+type ScreenerCriteria struct {
+	MarketCap Range
+	Beta      Range
+	Price     Range
+
+	// Dividend bounds the last annual dividend per share.
+	Dividend Range
+	Volume   Range
+
+	// Sector, Industry, Country and Exchange are matched as named by FMP, e.g. "Technology",
+	// "Consumer Electronics", "US" and "NASDAQ".
+	Sector   string
+	Industry string
+	Country  string
+	Exchange string
+
+	IsEtf             *bool
+	IsFund            *bool
+	IsActivelyTrading *bool
+
+	// IncludeAllShareClasses includes the share classes other than the primary listing.
+	IncludeAllShareClasses bool
+
+	// Limit is the maximum number of companies returned, the default of FMP when zero.
+	Limit int
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// Params returns the parameters of CompanyScreenerGet selecting the criteria.
+func (c *ScreenerCriteria) Params() *CompanyScreenerGetParams {
+	p := &CompanyScreenerGetParams{
+		MarketCapMoreThan:  c.MarketCap.Min,
+		MarketCapLowerThan: c.MarketCap.Max,
+		BetaMoreThan:       c.Beta.Min,
+		BetaLowerThan:      c.Beta.Max,
+		PriceMoreThan:      c.Price.Min,
+		PriceLowerThan:     c.Price.Max,
+		DividendMoreThan:   c.Dividend.Min,
+		DividendLowerThan:  c.Dividend.Max,
+		VolumeMoreThan:     c.Volume.Min,
+		VolumeLowerThan:    c.Volume.Max,
+		Sector:             optionalString(c.Sector),
+		Industry:           optionalString(c.Industry),
+		Country:            optionalString(c.Country),
+		Exchange:           optionalString(c.Exchange),
+		IsEtf:              c.IsEtf,
+		IsFund:             c.IsFund,
+		IsActivelyTrading:  c.IsActivelyTrading,
+	}
+	if c.IncludeAllShareClasses {
+		p.IncludeAllShareClasses = &c.IncludeAllShareClasses
+	}
+	if c.Limit > 0 {
+		limit := c.Limit
+		p.Limit = &limit
+	}
+	return p
+}
+
+func matchBool(want *bool, v bool) bool {
+	return want == nil || *want == v
+}
+
+// Match reports whether a result passes the criteria, e.g. to narrow down cached results locally.
+// Exchanges match either the short or the full name. The limit is ignored.
+func (c *ScreenerCriteria) Match(r *CompanyScreenerResult) bool {
+	if !c.MarketCap.Contains(r.MarketCap) || !c.Beta.Contains(r.Beta) || !c.Price.Contains(r.Price) ||
+		!c.Dividend.Contains(r.LastAnnualDividend) || !c.Volume.Contains(r.Volume) {
+		return false
+	}
+	if c.Sector != "" && !strings.EqualFold(c.Sector, r.Sector) {
+		return false
+	}
+	if c.Industry != "" && !strings.EqualFold(c.Industry, r.Industry) {
+		return false
+	}
+	if c.Country != "" && !strings.EqualFold(c.Country, r.Country) {
+		return false
+	}
+	if c.Exchange != "" && !strings.EqualFold(c.Exchange, r.ExchangeShortName) && !strings.EqualFold(c.Exchange, r.Exchange) {
+		return false
+	}
+	return matchBool(c.IsEtf, r.IsEtf) && matchBool(c.IsFund, r.IsFund) && matchBool(c.IsActivelyTrading, r.IsActivelyTrading)
+}
+
+// ScreenCompanies fetches the companies passing the criteria with CompanyScreenerGet.
+func ScreenCompanies(ctx context.Context, c *ClientWithResponses, criteria *ScreenerCriteria) ([]CompanyScreenerResult, error) {
+	resp, err := c.CompanyScreenerGetWithResponse(ctx, criteria.Params())
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of company screener: %s", resp.Status())
+	}
+	return *resp.JSON200, nil
+}
@@ -0,0 +1,63 @@
+package financialmodelingprep
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type screenerSuite struct {
+	suite.Suite
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
+
+func (r *screenerSuite) TestParams() {
+	criteria := ScreenerCriteria{
+		MarketCap: Range{Min: float64Ptr(1e9), Max: float64Ptr(1e11)},
+		Beta:      Range{Max: float64Ptr(1.5)},
+		Sector:    "Technology",
+		IsEtf:     boolPtr(false),
+		Limit:     50,
+	}
+	p := criteria.Params()
+	r.Equal(1e9, *p.MarketCapMoreThan)
+	r.Equal(1e11, *p.MarketCapLowerThan)
+	r.Nil(p.BetaMoreThan)
+	r.Equal(1.5, *p.BetaLowerThan)
+	r.Equal("Technology", *p.Sector)
+	r.Nil(p.Industry)
+	r.False(*p.IsEtf)
+	r.Nil(p.IsFund)
+	r.Nil(p.IncludeAllShareClasses)
+	r.Equal(50, *p.Limit)
+
+	r.Equal(&CompanyScreenerGetParams{}, (&ScreenerCriteria{}).Params())
+}
+
+func (r *screenerSuite) TestMatch() {
+	result := CompanyScreenerResult{
+		Symbol:            "AAPL",
+		MarketCap:         3.4e12,
+		Beta:              1.24,
+		Price:             229.87,
+		Sector:            "Technology",
+		Industry:          "Consumer Electronics",
+		Country:           "US",
+		Exchange:          "NASDAQ Global Select",
+		ExchangeShortName: "NASDAQ",
+		IsActivelyTrading: true,
+	}
+	r.True((&ScreenerCriteria{}).Match(&result))
+	r.True((&ScreenerCriteria{MarketCap: Range{Min: float64Ptr(1e12)}, Sector: "technology", Exchange: "nasdaq"}).Match(&result))
+	r.True((&ScreenerCriteria{Exchange: "NASDAQ Global Select", IsEtf: boolPtr(false)}).Match(&result))
+	r.False((&ScreenerCriteria{Beta: Range{Max: float64Ptr(1)}}).Match(&result))
+	r.False((&ScreenerCriteria{Country: "CA"}).Match(&result))
+	r.False((&ScreenerCriteria{IsActivelyTrading: boolPtr(false)}).Match(&result))
+}
+
+func TestScreenerSuite(t *testing.T) {
+	suite.Run(t, new(screenerSuite))
+}
@@ -0,0 +1,243 @@
+package financialmodelingprep
+
+import (
+	"sort"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// SentimentScorer scores the sentiment of a text, from -1 for negative to 1 for positive.
+type SentimentScorer interface {
+	Score(text string) float64
+}
+
+// NegationWindow is the number of words before a positive word searched for a negation.
+const NegationWindow = 3
+
+// LexiconScorer scores texts by counting the words of finance word lists, in the style of the
+// Loughran-McDonald dictionary. A positive word preceded by a negation within NegationWindow
+// words counts as negative, while negated negative words are left alone, as in Loughran and
+// McDonald (2011).
+type LexiconScorer struct {
+	Positive  map[string]bool
+	Negative  map[string]bool
+	Negations map[string]bool
+}
+
+func wordSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[w] = true
+	}
+	return set
+}
+
+// NewLexiconScorer returns a scorer with the built-in word lists, which may be extended.
+func NewLexiconScorer() *LexiconScorer {
+	return &LexiconScorer{
+		Positive: wordSet(
+			"achieve", "achieved", "achievement", "advance", "advanced", "beat", "beats", "best",
+			"better", "boost", "boosted", "breakthrough", "bullish", "exceed", "exceeded", "exceeds",
+			"expansion", "favorable", "gain", "gained", "gains", "grew", "growth", "improve",
+			"improved", "improvement", "improving", "innovative", "jump", "jumped", "jumps",
+			"optimistic", "outperform", "outperformed", "positive", "profitable", "profitability",
+			"rallied", "rally", "record", "rebound", "rebounded", "rise", "rises", "rising", "rose",
+			"soar", "soared", "soars", "strength", "strong", "stronger", "success", "successful",
+			"surge", "surged", "surges", "tailwind", "tailwinds", "upgrade", "upgraded", "upgrades",
+		),
+		Negative: wordSet(
+			"adverse", "bankrupt", "bankruptcy", "bearish", "challenging", "closure", "closures",
+			"concern", "concerns", "crash", "crashed", "cut", "cuts", "cutting", "decline",
+			"declined", "declines", "declining", "default", "defaults", "deficit", "delay",
+			"delayed", "delays", "difficult", "disappointed", "disappointing", "downgrade",
+			"downgraded", "downgrades", "drop", "dropped", "drops", "fall", "falling", "falls",
+			"fell", "fraud", "headwind", "headwinds", "impairment", "impairments", "investigation",
+			"lawsuit", "lawsuits", "layoff", "layoffs", "litigation", "lose", "losing", "loss",
+			"losses", "lost", "miss", "missed", "misses", "negative", "penalties", "penalty",
+			"plunge", "plunged", "plunges", "probe", "recall", "recalls", "restated",
+			"restatement", "shortfall", "slump", "slumped", "suspended", "suspension",
+			"terminated", "termination", "tumble", "tumbled", "turmoil", "underperform",
+			"underperformed", "violation", "violations", "warned", "warning", "warns", "weak",
+			"weaker", "weakness", "worse", "worst",
+		),
+		Negations: wordSet(
+			"aren", "cannot", "didn", "doesn", "isn", "neither", "never", "no", "none", "nor",
+			"not", "wasn", "weren", "without",
+		),
+	}
+}
+
+// Count returns the number of positive and negative words of the text.
+func (s *LexiconScorer) Count(text string) (positive, negative int) {
+	tokens := textTokens(text)
+	for i, t := range tokens {
+		switch {
+		case s.Positive[t.word]:
+			negated := false
+			for j := max(0, i-NegationWindow); j < i; j++ {
+				if s.Negations[tokens[j].word] {
+					negated = true
+					break
+				}
+			}
+			if negated {
+				negative++
+			} else {
+				positive++
+			}
+		case s.Negative[t.word]:
+			negative++
+		}
+	}
+	return positive, negative
+}
+
+// Score returns the net tone of the text, the positive minus the negative words over their sum,
+// or zero without any.
+func (s *LexiconScorer) Score(text string) float64 {
+	positive, negative := s.Count(text)
+	if positive+negative == 0 {
+		return 0
+	}
+	return float64(positive-negative) / float64(positive+negative)
+}
+
+// ScoreArticle scores the title and text of an article together.
+func ScoreArticle(scorer SentimentScorer, a *Article) float64 {
+	return scorer.Score(a.Title + "\n" + a.Text)
+}
+
+// SentimentBar is the sentiment of the news of a company over a day.
+type SentimentBar struct {
+	Symbol string
+	Date   openapi_types.Date
+
+	Articles int
+
+	// Score is the mean score of the articles, and Positive and Negative the number of articles
+	// scoring above and below zero.
+	Score    float64
+	Positive int
+	Negative int
+}
+
+func (b *SentimentBar) add(o *SentimentBar) {
+	if n := b.Articles + o.Articles; n > 0 {
+		b.Score = (b.Score*float64(b.Articles) + o.Score*float64(o.Articles)) / float64(n)
+	}
+	b.Articles += o.Articles
+	b.Positive += o.Positive
+	b.Negative += o.Negative
+}
+
+// SentimentParams defines parameters for DailySentiment.
+type SentimentParams struct {
+	// Scorer is the built-in LexiconScorer when nil.
+	Scorer SentimentScorer
+
+	// Location is the time zone of the exchange, UTC when nil.
+	Location *time.Location
+
+	// Close is the time of day of the close in Location. Articles published at or after it count
+	// towards the next day, the first that can trade on them. Zero disables the shift.
+	Close time.Duration
+}
+
+// DailySentiment scores the articles and aggregates the scores per symbol and day, sorted by
+// symbol then date. Articles count towards each of their symbols and articles without symbols are
+// skipped. Pass the primary articles of stories, so duplicates are not counted twice. The params
+// may be nil.
+func DailySentiment(articles []Article, params *SentimentParams) []SentimentBar {
+	var p SentimentParams
+	if params != nil {
+		p = *params
+	}
+	if p.Scorer == nil {
+		p.Scorer = NewLexiconScorer()
+	}
+	if p.Location == nil {
+		p.Location = time.UTC
+	}
+
+	type key struct {
+		symbol string
+		date   time.Time
+	}
+	bars := map[key]*SentimentBar{}
+	for i := range articles {
+		a := &articles[i]
+		if len(a.Symbols) == 0 {
+			continue
+		}
+		t := a.Published.In(p.Location)
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		if p.Close > 0 && t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, p.Location)) >= p.Close {
+			day = day.AddDate(0, 0, 1)
+		}
+
+		score := ScoreArticle(p.Scorer, a)
+		o := SentimentBar{Articles: 1, Score: score}
+		if score > 0 {
+			o.Positive = 1
+		} else if score < 0 {
+			o.Negative = 1
+		}
+		for _, symbol := range a.Symbols {
+			k := key{symbol: symbol, date: day}
+			b, ok := bars[k]
+			if !ok {
+				b = &SentimentBar{Symbol: symbol, Date: openapi_types.Date{Time: day}}
+				bars[k] = b
+			}
+			b.add(&o)
+		}
+	}
+
+	series := make([]SentimentBar, 0, len(bars))
+	for _, b := range bars {
+		series = append(series, *b)
+	}
+	sort.Slice(series, func(i, j int) bool {
+		if series[i].Symbol != series[j].Symbol {
+			return series[i].Symbol < series[j].Symbol
+		}
+		return series[i].Date.Before(series[j].Date.Time)
+	})
+	return series
+}
+
+// AlignSentiment lines up the sentiment of the symbol of the candles with them: the news of days
+// without a bar, such as weekends and holidays, counts towards the next bar, and bars without news
+// get an empty SentimentBar. The result has one bar per candle, sorted by date; news after the
+// last candle is dropped.
+func AlignSentiment(bars []SentimentBar, candles []LightCandle) []SentimentBar {
+	dates := make([]openapi_types.Date, len(candles))
+	for i := range candles {
+		dates[i] = candles[i].Date
+	}
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j].Time)
+	})
+
+	aligned := make([]SentimentBar, len(dates))
+	for i, d := range dates {
+		aligned[i] = SentimentBar{Date: d}
+		if len(candles) > 0 {
+			aligned[i].Symbol = candles[0].Symbol
+		}
+	}
+	for i := range bars {
+		b := &bars[i]
+		if len(candles) == 0 || b.Symbol != candles[0].Symbol {
+			continue
+		}
+		j := sort.Search(len(dates), func(j int) bool {
+			return !dates[j].Before(b.Date.Time)
+		})
+		if j < len(dates) {
+			aligned[j].add(b)
+		}
+	}
+	return aligned
+}
@@ -0,0 +1,112 @@
+package financialmodelingprep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type sentimentSuite struct {
+	suite.Suite
+}
+
+// constantScorer scores every text alike, to test the aggregation.
+type constantScorer float64
+
+func (s constantScorer) Score(string) float64 {
+	return float64(s)
+}
+
+func (r *sentimentSuite) TestLexiconScorer() {
+	s := NewLexiconScorer()
+
+	positive, negative := s.Count("Apple beats estimates as iPhone sales surge")
+	r.Equal(2, positive)
+	r.Zero(negative)
+	r.InDelta(1, s.Score("Apple beats estimates as iPhone sales surge"), 1e-9)
+
+	r.InDelta(-1, s.Score("Shares fell after the company missed guidance and warned of layoffs"), 1e-9)
+	r.InDelta(0, s.Score("Revenue grew but margins declined"), 1e-9)
+	r.Zero(s.Score("The company will hold its annual meeting"))
+
+	// Negation flips positive words only.
+	positive, negative = s.Count("Results did not beat expectations, and the stock didn't drop")
+	r.Zero(positive)
+	r.Equal(2, negative)
+
+	// The negation must precede the word closely.
+	positive, negative = s.Count("No one expected the shares would rise")
+	r.Equal(1, positive)
+	r.Zero(negative)
+
+	s.Negative["meh"] = true
+	r.InDelta(-1, s.Score("Meh quarter"), 1e-9)
+}
+
+func (r *sentimentSuite) articles() []Article {
+	at := func(day, hour int) time.Time {
+		return time.Date(2025, time.February, day, hour, 0, 0, 0, time.UTC)
+	}
+	return []Article{
+		{Title: "Apple beats estimates", Published: at(3, 14), Symbols: []string{"AAPL"}},
+		{Title: "Apple shares fall on China weakness", Published: at(3, 15), Symbols: []string{"AAPL"}},
+		// After the 16:00 New York close, 21:30 UTC.
+		{Title: "Apple and Microsoft rally", Published: at(3, 22), Symbols: []string{"AAPL", "MSFT"}},
+		// Saturday.
+		{Title: "Apple faces lawsuit", Published: at(8, 12), Symbols: []string{"AAPL"}},
+		{Title: "Markets are mixed", Published: at(8, 12)},
+	}
+}
+
+func (r *sentimentSuite) TestDailySentiment() {
+	bars := DailySentiment(r.articles(), nil)
+	r.Len(bars, 3)
+	r.Equal("AAPL", bars[0].Symbol)
+	r.Equal(fixtureDate(2025, time.February, 3), bars[0].Date)
+	r.Equal(3, bars[0].Articles)
+	r.Equal(2, bars[0].Positive)
+	r.Equal(1, bars[0].Negative)
+	r.InDelta(1.0/3, bars[0].Score, 1e-9)
+	r.Equal("MSFT", bars[2].Symbol)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	r.Require().NoError(err)
+	bars = DailySentiment(r.articles(), &SentimentParams{Location: newYork, Close: 16 * time.Hour})
+	r.Len(bars, 4)
+	r.Equal(2, bars[0].Articles)
+	r.InDelta(0, bars[0].Score, 1e-9)
+	r.Equal(fixtureDate(2025, time.February, 4), bars[1].Date)
+	r.Equal(1, bars[1].Articles)
+
+	bars = DailySentiment(r.articles(), &SentimentParams{Scorer: constantScorer(0.5)})
+	r.InDelta(0.5, bars[0].Score, 1e-9)
+}
+
+func (r *sentimentSuite) TestAlignSentiment() {
+	bars := DailySentiment(r.articles(), nil)
+	candles := []LightCandle{
+		{Symbol: "AAPL", Date: fixtureDate(2025, time.February, 10), Price: 227},
+		{Symbol: "AAPL", Date: fixtureDate(2025, time.February, 7), Price: 227},
+		{Symbol: "AAPL", Date: fixtureDate(2025, time.February, 4), Price: 232},
+		{Symbol: "AAPL", Date: fixtureDate(2025, time.February, 3), Price: 228},
+	}
+
+	aligned := AlignSentiment(bars, candles)
+	r.Len(aligned, 4)
+	r.Equal(fixtureDate(2025, time.February, 3), aligned[0].Date)
+	r.Equal(3, aligned[0].Articles)
+	r.Zero(aligned[1].Articles)
+	r.Equal("AAPL", aligned[1].Symbol)
+	r.Zero(aligned[2].Articles)
+	// The weekend lawsuit counts towards Monday.
+	r.Equal(fixtureDate(2025, time.February, 10), aligned[3].Date)
+	r.Equal(1, aligned[3].Articles)
+	r.InDelta(-1, aligned[3].Score, 1e-9)
+
+	r.Empty(AlignSentiment(bars, nil))
+}
+
+func TestSentimentSuite(t *testing.T) {
+	suite.Run(t, new(sentimentSuite))
+}
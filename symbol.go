@@ -0,0 +1,347 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// exchangeSuffix holds the codes of an exchange suffix of FMP in the conventions of other vendors.
+// Empty codes have no conversion.
+type exchangeSuffix struct {
+	bloomberg string
+	ric       string
+}
+
+// exchangeSuffixes are the exchange suffixes of FMP symbols, e.g. "L" of "VOD.L".
+var exchangeSuffixes = map[string]exchangeSuffix{
+	"L":   {bloomberg: "LN", ric: "L"},
+	"HK":  {bloomberg: "HK", ric: "HK"},
+	"T":   {bloomberg: "JT", ric: "T"},
+	"TO":  {bloomberg: "CT", ric: "TO"},
+	"V":   {bloomberg: "CV", ric: "V"},
+	"NE":  {ric: "NEO"},
+	"AX":  {bloomberg: "AU", ric: "AX"},
+	"DE":  {bloomberg: "GY", ric: "DE"},
+	"F":   {bloomberg: "GF", ric: "F"},
+	"PA":  {bloomberg: "FP", ric: "PA"},
+	"AS":  {bloomberg: "NA", ric: "AS"},
+	"MI":  {bloomberg: "IM", ric: "MI"},
+	"MC":  {bloomberg: "SM", ric: "MC"},
+	"SW":  {bloomberg: "SW", ric: "S"},
+	"ST":  {bloomberg: "SS", ric: "ST"},
+	"OL":  {bloomberg: "NO", ric: "OL"},
+	"CO":  {bloomberg: "DC", ric: "CO"},
+	"HE":  {bloomberg: "FH", ric: "HE"},
+	"BR":  {bloomberg: "BB", ric: "BR"},
+	"LS":  {bloomberg: "PL", ric: "LS"},
+	"VI":  {bloomberg: "AV", ric: "VI"},
+	"IR":  {bloomberg: "ID", ric: "I"},
+	"NS":  {bloomberg: "IS", ric: "NS"},
+	"BO":  {bloomberg: "IB", ric: "BO"},
+	"KS":  {bloomberg: "KS", ric: "KS"},
+	"KQ":  {bloomberg: "KQ", ric: "KQ"},
+	"TW":  {bloomberg: "TT", ric: "TW"},
+	"TWO": {ric: "TWO"},
+	"SI":  {bloomberg: "SP", ric: "SI"},
+	"SS":  {bloomberg: "CH", ric: "SS"},
+	"SZ":  {bloomberg: "CH", ric: "SZ"},
+	"JO":  {bloomberg: "SJ", ric: "J"},
+	"SA":  {bloomberg: "BZ", ric: "SA"},
+	"MX":  {bloomberg: "MM", ric: "MX"},
+	"NZ":  {bloomberg: "NZ", ric: "NZ"},
+	"KL":  {bloomberg: "MK", ric: "KL"},
+	"BK":  {bloomberg: "TB", ric: "BK"},
+	"JK":  {bloomberg: "IJ", ric: "JK"},
+	"TA":  {bloomberg: "IT", ric: "TA"},
+	"WA":  {bloomberg: "PW", ric: "WA"},
+	"IS":  {bloomberg: "TI", ric: "IS"},
+	"SR":  {bloomberg: "AB", ric: "SE"},
+}
+
+// bloombergSuffixes maps Bloomberg exchange codes to FMP suffixes. The Chinese composite code CH
+// is resolved by the root instead.
+var bloombergSuffixes = func() map[string]string {
+	suffixes := map[string]string{
+		// Composite and alternative codes.
+		"JP": "T",
+		"CN": "TO",
+		"GR": "DE",
+	}
+	for suffix, codes := range exchangeSuffixes {
+		if codes.bloomberg != "" && codes.bloomberg != "CH" {
+			suffixes[codes.bloomberg] = suffix
+		}
+	}
+	return suffixes
+}()
+
+// ricSuffixes maps RIC exchange codes to FMP suffixes.
+var ricSuffixes = func() map[string]string {
+	suffixes := map[string]string{}
+	for suffix, codes := range exchangeSuffixes {
+		if codes.ric != "" {
+			suffixes[codes.ric] = suffix
+		}
+	}
+	return suffixes
+}()
+
+// usBloombergCodes are the Bloomberg codes of US listings, which have no suffix at FMP.
+var usBloombergCodes = map[string]bool{"US": true, "UN": true, "UW": true, "UQ": true, "UA": true, "UP": true, "UR": true, "UV": true}
+
+// usRICCodes are the RIC codes of US listings, which have no suffix at FMP.
+var usRICCodes = map[string]bool{"O": true, "OQ": true, "N": true, "A": true, "P": true, "K": true, "PK": true, "Z": true}
+
+// usRICExchanges maps the US exchanges of FMP to RIC codes.
+var usRICExchanges = map[string]string{
+	"NASDAQ":    "O",
+	"NYSE":      "N",
+	"AMEX":      "A",
+	"NYSE ARCA": "P",
+	"NYSEARCA":  "P",
+	"CBOE":      "Z",
+	"OTC":       "PK",
+}
+
+// MaxSymbolLength is the longest symbol accepted by ParseSymbol.
+const MaxSymbolLength = 20
+
+// Symbol is an FMP ticker split into its root and exchange suffix, e.g. "000001" and "SZ" of
+// "000001.SZ". US listings have no suffix. Share classes are part of the root, e.g. "BRK-B".
+type Symbol struct {
+	Root   string
+	Suffix string
+}
+
+func validSymbolChar(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.IndexByte(".-^=&", c) >= 0
+}
+
+// ParseSymbol parses and validates a symbol, uppercasing it. The last dot separated part is the
+// exchange suffix only if it is a known suffix, so "BRK.B" is a root.
+func ParseSymbol(s string) (Symbol, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	switch {
+	case s == "":
+		return Symbol{}, fmt.Errorf("empty symbol")
+	case len(s) > MaxSymbolLength:
+		return Symbol{}, fmt.Errorf("symbol %q longer than %d characters", s, MaxSymbolLength)
+	}
+	for i := 0; i < len(s); i++ {
+		if !validSymbolChar(s[i]) {
+			return Symbol{}, fmt.Errorf("invalid character %q in symbol %q", s[i], s)
+		}
+	}
+	if strings.HasPrefix(s, ".") || strings.HasSuffix(s, ".") || strings.Contains(s, "..") {
+		return Symbol{}, fmt.Errorf("invalid symbol %q", s)
+	}
+
+	sym := Symbol{Root: s}
+	if i := strings.LastIndexByte(s, '.'); i >= 0 {
+		if _, ok := exchangeSuffixes[s[i+1:]]; ok {
+			sym.Root, sym.Suffix = s[:i], s[i+1:]
+		}
+	}
+	return sym, nil
+}
+
+// MustParseSymbol is like ParseSymbol but panics on invalid symbols.
+func MustParseSymbol(s string) Symbol {
+	sym, err := ParseSymbol(s)
+	if err != nil {
+		panic(err)
+	}
+	return sym
+}
+
+// String returns the FMP symbol.
+func (s Symbol) String() string {
+	if s.Suffix == "" {
+		return s.Root
+	}
+	return s.Root + "." + s.Suffix
+}
+
+// IsZero reports whether the symbol is empty.
+func (s Symbol) IsZero() bool {
+	return s.Root == ""
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Symbol) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Symbol) UnmarshalText(text []byte) error {
+	sym, err := ParseSymbol(string(text))
+	if err != nil {
+		return err
+	}
+	*s = sym
+	return nil
+}
+
+// Bloomberg returns the Bloomberg ticker of the symbol, e.g. "AAPL US Equity", "BRK/B US Equity"
+// or "700 HK Equity".
+func (s Symbol) Bloomberg() (string, error) {
+	root := strings.ReplaceAll(s.Root, "-", "/")
+	code := "US"
+	if s.Suffix != "" {
+		code = exchangeSuffixes[s.Suffix].bloomberg
+		if code == "" {
+			return "", fmt.Errorf("no Bloomberg exchange code for symbol %s", s)
+		}
+	}
+	if s.Suffix == "HK" {
+		if trimmed := strings.TrimLeft(root, "0"); trimmed != "" {
+			root = trimmed
+		}
+	}
+	return root + " " + code + " Equity", nil
+}
+
+// ParseBloomberg parses a Bloomberg ticker such as "VOD LN Equity" or "AAPL UW". US codes map to
+// symbols without a suffix, and Chinese A shares of code CH to Shanghai for roots starting with 6
+// or 9 and to Shenzhen otherwise.
+func ParseBloomberg(s string) (Symbol, error) {
+	fields := strings.Fields(strings.ToUpper(s))
+	if len(fields) == 3 && fields[2] == "EQUITY" {
+		fields = fields[:2]
+	}
+	if len(fields) != 2 {
+		return Symbol{}, fmt.Errorf("invalid Bloomberg ticker %q", s)
+	}
+	root, code := strings.ReplaceAll(fields[0], "/", "-"), fields[1]
+
+	var suffix string
+	switch {
+	case usBloombergCodes[code]:
+	case code == "CH":
+		suffix = "SZ"
+		if strings.HasPrefix(root, "6") || strings.HasPrefix(root, "9") {
+			suffix = "SS"
+		}
+	default:
+		var ok bool
+		if suffix, ok = bloombergSuffixes[code]; !ok {
+			return Symbol{}, fmt.Errorf("unknown Bloomberg exchange code %q", code)
+		}
+	}
+	if suffix == "HK" && len(root) < 4 {
+		root = strings.Repeat("0", 4-len(root)) + root
+	}
+	if suffix != "" {
+		root += "." + suffix
+	}
+	return ParseSymbol(root)
+}
+
+// RIC returns the Reuters instrument code of the symbol, e.g. "VOD.L" or "BRKb.N". US listings
+// need their FMP exchange, e.g. "NASDAQ" or "NYSE", which is ignored otherwise.
+func (s Symbol) RIC(exchange string) (string, error) {
+	root := s.Root
+	if i := strings.IndexByte(root, '-'); i >= 0 {
+		root = root[:i] + strings.ToLower(root[i+1:])
+	}
+	if s.Suffix == "" {
+		code, ok := usRICExchanges[strings.ToUpper(strings.TrimSpace(exchange))]
+		if !ok {
+			return "", fmt.Errorf("no RIC exchange code for symbol %s on exchange %q", s, exchange)
+		}
+		return root + "." + code, nil
+	}
+	code := exchangeSuffixes[s.Suffix].ric
+	if code == "" {
+		return "", fmt.Errorf("no RIC exchange code for symbol %s", s)
+	}
+	return root + "." + code, nil
+}
+
+// ParseRIC parses a Reuters instrument code such as "VOD.L" or "BRKb.N". Lowercase letters ending
+// the root are a share class. US codes map to symbols without a suffix.
+func ParseRIC(s string) (Symbol, error) {
+	s = strings.TrimSpace(s)
+	root, code := s, ""
+	if i := strings.LastIndexByte(s, '.'); i >= 0 {
+		root, code = s[:i], strings.ToUpper(s[i+1:])
+	}
+	if i := strings.IndexFunc(root, func(r rune) bool { return r >= 'a' && r <= 'z' }); i > 0 {
+		root = root[:i] + "-" + root[i:]
+	}
+	root = strings.ToUpper(root)
+
+	switch {
+	case code == "" || usRICCodes[code]:
+		return ParseSymbol(root)
+	default:
+		suffix, ok := ricSuffixes[code]
+		if !ok {
+			return Symbol{}, fmt.Errorf("unknown RIC exchange code %q", code)
+		}
+		return ParseSymbol(root + "." + suffix)
+	}
+}
+
+// ExchangeDirectory maps symbols to their exchanges of AvailableExchangesGet by suffix.
+type ExchangeDirectory struct {
+	bySuffix map[string][]Exchange
+}
+
+// NewExchangeDirectory indexes exchanges by their symbol suffix. Exchanges with the suffix "N/A"
+// list symbols without a suffix.
+func NewExchangeDirectory(exchanges []Exchange) *ExchangeDirectory {
+	d := &ExchangeDirectory{bySuffix: map[string][]Exchange{}}
+	for _, e := range exchanges {
+		suffix := strings.ToUpper(strings.TrimPrefix(strings.TrimSpace(e.SymbolSuffix), "."))
+		if suffix == "N/A" {
+			suffix = ""
+		}
+		d.bySuffix[suffix] = append(d.bySuffix[suffix], e)
+	}
+	return d
+}
+
+// Exchanges returns the exchanges that may list the symbol, in the order given.
+func (d *ExchangeDirectory) Exchanges(s Symbol) []Exchange {
+	return d.bySuffix[s.Suffix]
+}
+
+// GetExchangeDirectory fetches the exchanges with AvailableExchangesGet and indexes them.
+func GetExchangeDirectory(ctx context.Context, c *ClientWithResponses) (*ExchangeDirectory, error) {
+	resp, err := c.AvailableExchangesGetWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected status of available exchanges: %s", resp.Status())
+	}
+	return NewExchangeDirectory(*resp.JSON200), nil
+}
+
+// ValidateSymbols validates a comma separated list of symbols.
+func ValidateSymbols(symbols string) error {
+	for _, s := range strings.Split(symbols, ",") {
+		if _, err := ParseSymbol(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateSymbolParams is a RequestEditorFn that fails requests with an invalid "symbol" or
+// "symbols" query parameter before they are sent, so they do not spend quota. It is installed by
+// MustClient when ClientConfig.ValidateSymbols is set.
+func ValidateSymbolParams(ctx context.Context, req *http.Request) error {
+	query := req.URL.Query()
+	for _, name := range []string{"symbol", "symbols"} {
+		for _, v := range query[name] {
+			if err := ValidateSymbols(v); err != nil {
+				return fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type symbolSuite struct {
+	suite.Suite
+}
+
+func (r *symbolSuite) TestParseSymbol() {
+	for _, tc := range []struct {
+		s      string
+		root   string
+		suffix string
+	}{
+		{"AAPL", "AAPL", ""},
+		{" eqv ", "EQV", ""},
+		{"000001.SZ", "000001", "SZ"},
+		{"SAI.MC", "SAI", "MC"},
+		{"VOD.L", "VOD", "L"},
+		{"BRK.B", "BRK.B", ""},
+		{"BRK-B", "BRK-B", ""},
+		{"^GSPC", "^GSPC", ""},
+		{"EURUSD=X", "EURUSD=X", ""},
+		{"RDS.A.L", "RDS.A", "L"},
+	} {
+		sym, err := ParseSymbol(tc.s)
+		r.Require().NoError(err, tc.s)
+		r.Equal(Symbol{Root: tc.root, Suffix: tc.suffix}, sym, tc.s)
+	}
+	r.Equal("000001.SZ", MustParseSymbol("000001.sz").String())
+
+	for _, s := range []string{"", " ", "AAPL US", "A,B", ".L", "VOD.", "A..B", "ABCDEFGHIJKLMNOPQRSTU"} {
+		_, err := ParseSymbol(s)
+		r.Error(err, s)
+	}
+}
+
+func (r *symbolSuite) TestText() {
+	var v struct {
+		Symbol Symbol `json:"symbol"`
+	}
+	r.Require().NoError(json.Unmarshal([]byte(`{"symbol":"0700.hk"}`), &v))
+	r.Equal(Symbol{Root: "0700", Suffix: "HK"}, v.Symbol)
+
+	b, err := json.Marshal(v)
+	r.Require().NoError(err)
+	r.JSONEq(`{"symbol":"0700.HK"}`, string(b))
+
+	r.Error(json.Unmarshal([]byte(`{"symbol":"A B"}`), &v))
+}
+
+func (r *symbolSuite) TestBloomberg() {
+	for _, tc := range []struct {
+		symbol    string
+		bloomberg string
+	}{
+		{"AAPL", "AAPL US Equity"},
+		{"BRK-B", "BRK/B US Equity"},
+		{"VOD.L", "VOD LN Equity"},
+		{"0700.HK", "700 HK Equity"},
+		{"7203.T", "7203 JT Equity"},
+		{"600519.SS", "600519 CH Equity"},
+		{"000001.SZ", "000001 CH Equity"},
+		{"SAI.MC", "SAI SM Equity"},
+	} {
+		got, err := MustParseSymbol(tc.symbol).Bloomberg()
+		r.Require().NoError(err, tc.symbol)
+		r.Equal(tc.bloomberg, got)
+
+		sym, err := ParseBloomberg(tc.bloomberg)
+		r.Require().NoError(err, tc.bloomberg)
+		r.Equal(tc.symbol, sym.String())
+	}
+
+	for s, want := range map[string]string{
+		"aapl uw":         "AAPL",
+		"SIE GR Equity":   "SIE.DE",
+		"5   HK Equity":   "0005.HK",
+		"RY CN Equity":    "RY.TO",
+		"ERICB SS Equity": "ERICB.ST",
+	} {
+		sym, err := ParseBloomberg(s)
+		r.Require().NoError(err, s)
+		r.Equal(want, sym.String())
+	}
+
+	for _, s := range []string{"AAPL", "AAPL XX Equity", "AAPL US Index Equity"} {
+		_, err := ParseBloomberg(s)
+		r.Error(err, s)
+	}
+	_, err := MustParseSymbol("ABC.NE").Bloomberg()
+	r.Error(err)
+}
+
+func (r *symbolSuite) TestRIC() {
+	for _, tc := range []struct {
+		symbol   string
+		exchange string
+		ric      string
+	}{
+		{"AAPL", "NASDAQ", "AAPL.O"},
+		{"BRK-B", "NYSE", "BRKb.N"},
+		{"VOD.L", "", "VOD.L"},
+		{"NESN.SW", "", "NESN.S"},
+		{"NPN.JO", "", "NPN.J"},
+		{"2222.SR", "", "2222.SE"},
+		{"0700.HK", "", "0700.HK"},
+	} {
+		got, err := MustParseSymbol(tc.symbol).RIC(tc.exchange)
+		r.Require().NoError(err, tc.symbol)
+		r.Equal(tc.ric, got)
+
+		sym, err := ParseRIC(tc.ric)
+		r.Require().NoError(err, tc.ric)
+		r.Equal(tc.symbol, sym.String())
+	}
+
+	sym, err := ParseRIC("MSFT.OQ")
+	r.Require().NoError(err)
+	r.Equal("MSFT", sym.String())
+
+	_, err = ParseRIC("ABC.XX")
+	r.Error(err)
+	_, err = MustParseSymbol("AAPL").RIC("")
+	r.Error(err)
+}
+
+func (r *symbolSuite) TestExchangeDirectory() {
+	d := NewExchangeDirectory([]Exchange{
+		{Exchange: "NASDAQ", SymbolSuffix: "N/A"},
+		{Exchange: "NYSE", SymbolSuffix: "N/A"},
+		{Exchange: "LSE", SymbolSuffix: ".L"},
+		{Exchange: "SHZ", SymbolSuffix: ".SZ"},
+	})
+	r.Len(d.Exchanges(MustParseSymbol("AAPL")), 2)
+	r.Equal("LSE", d.Exchanges(MustParseSymbol("VOD.L"))[0].Exchange)
+	r.Equal("SHZ", d.Exchanges(MustParseSymbol("000001.SZ"))[0].Exchange)
+	r.Empty(d.Exchanges(MustParseSymbol("7203.T")))
+}
+
+func (r *symbolSuite) TestValidateSymbolParams() {
+	for _, tc := range []struct {
+		url string
+		ok  bool
+	}{
+		{"https://example.com/stable/quote?symbol=AAPL", true},
+		{"https://example.com/stable/batch-quote?symbols=AAPL,000001.SZ,SAI.MC", true},
+		{"https://example.com/stable/quote?symbol=AA%20PL", false},
+		{"https://example.com/stable/batch-quote?symbols=AAPL,,MSFT", false},
+		{"https://example.com/stable/available-exchanges", true},
+	} {
+		req, err := http.NewRequest(http.MethodGet, tc.url, nil)
+		r.Require().NoError(err)
+		err = ValidateSymbolParams(context.Background(), req)
+		if tc.ok {
+			r.NoError(err, tc.url)
+		} else {
+			r.Error(err, tc.url)
+		}
+	}
+}
+
+func TestSymbolSuite(t *testing.T) {
+	suite.Run(t, new(symbolSuite))
+}
@@ -0,0 +1,614 @@
+package financialmodelingprep
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// IdentifierKind is the kind of a security identifier.
+type IdentifierKind string
+
+const (
+	IdentifierTicker IdentifierKind = "ticker"
+	IdentifierCIK    IdentifierKind = "cik"
+	IdentifierCUSIP  IdentifierKind = "cusip"
+	IdentifierISIN   IdentifierKind = "isin"
+)
+
+// identifierValue returns the value of an alphanumeric character in CUSIPs and ISINs, digits
+// being worth themselves and letters 10 to 35.
+func identifierValue(c byte) (int, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0'), true
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10, true
+	}
+	return 0, false
+}
+
+// ValidCUSIP reports whether s is a CUSIP with a valid check digit.
+func ValidCUSIP(s string) bool {
+	if len(s) != 9 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 8; i++ {
+		v, ok := identifierValue(s[i])
+		if !ok {
+			switch s[i] {
+			case '*':
+				v = 36
+			case '@':
+				v = 37
+			case '#':
+				v = 38
+			default:
+				return false
+			}
+		}
+		if i%2 == 1 {
+			v *= 2
+		}
+		sum += v/10 + v%10
+	}
+	return s[8] >= '0' && s[8] <= '9' && int(s[8]-'0') == (10-sum%10)%10
+}
+
+// ValidISIN reports whether s is an ISIN with a valid check digit.
+func ValidISIN(s string) bool {
+	if len(s) != 12 || s[0] < 'A' || s[0] > 'Z' || s[1] < 'A' || s[1] > 'Z' || s[11] < '0' || s[11] > '9' {
+		return false
+	}
+	// Expand letters into two digits, then apply the Luhn algorithm.
+	var digits []int
+	for i := 0; i < len(s); i++ {
+		v, ok := identifierValue(s[i])
+		if !ok {
+			return false
+		}
+		if v >= 10 {
+			digits = append(digits, v/10)
+		}
+		digits = append(digits, v%10)
+	}
+	sum := 0
+	for i := range digits {
+		d := digits[len(digits)-1-i]
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// NormalizeCIK strips the leading zeros of a CIK.
+func NormalizeCIK(cik string) string {
+	return strings.TrimLeft(strings.TrimSpace(cik), "0")
+}
+
+// ParseIdentifier classifies an identifier and normalizes it: CUSIPs and ISINs must have a valid
+// check digit, CIKs are all digits, and anything else is a ticker. Nine digits with a valid check
+// digit are taken for a CUSIP, as CIKs are written with up to seven digits or padded to ten.
+func ParseIdentifier(id string) (IdentifierKind, string) {
+	id = strings.ToUpper(strings.TrimSpace(id))
+	switch {
+	case ValidISIN(id):
+		return IdentifierISIN, id
+	case ValidCUSIP(id):
+		return IdentifierCUSIP, id
+	case id != "" && len(id) <= 10 && strings.Trim(id, "0123456789") == "":
+		return IdentifierCIK, NormalizeCIK(id)
+	default:
+		return IdentifierTicker, id
+	}
+}
+
+// SymbolEntry is a security of the symbol master.
+type SymbolEntry struct {
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name,omitempty"`
+	Exchange string `json:"exchange,omitempty"`
+
+	CIK   string `json:"cik,omitempty"`
+	CUSIP string `json:"cusip,omitempty"`
+	ISIN  string `json:"isin,omitempty"`
+
+	IsEtf bool `json:"isEtf,omitempty"`
+
+	// Delisted is the date the symbol was delisted, zero while listed.
+	Delisted time.Time `json:"delisted,omitzero"`
+}
+
+// merge fills the fields of e that are empty with those of o.
+func (e *SymbolEntry) merge(o *SymbolEntry) {
+	fill := func(dst *string, src string) {
+		if *dst == "" {
+			*dst = src
+		}
+	}
+	fill(&e.Name, o.Name)
+	fill(&e.Exchange, o.Exchange)
+	fill(&e.CIK, o.CIK)
+	fill(&e.CUSIP, o.CUSIP)
+	fill(&e.ISIN, o.ISIN)
+	e.IsEtf = e.IsEtf || o.IsEtf
+	if e.Delisted.IsZero() {
+		e.Delisted = o.Delisted
+	}
+}
+
+// SymbolRename is a change of ticker.
+type SymbolRename struct {
+	Date time.Time `json:"date"`
+	From string    `json:"from"`
+	To   string    `json:"to"`
+	Name string    `json:"name,omitempty"`
+}
+
+// renameKey identifies a rename, with the date in UTC so equal instants match.
+type renameKey struct {
+	from, to string
+	date     time.Time
+}
+
+func (r *SymbolRename) key() renameKey {
+	return renameKey{r.From, r.To, r.Date.UTC()}
+}
+
+// SymbolMaster indexes securities by ticker, CIK, CUSIP and ISIN and tracks the renames of
+// tickers, so any identifier can be resolved to the others. It is built from the directory and
+// search endpoints, and persisted with Save and Load.
+type SymbolMaster struct {
+	mu      sync.RWMutex
+	entries map[string]*SymbolEntry
+	byCIK   map[string][]string
+	byCUSIP map[string][]string
+	byISIN  map[string][]string
+
+	// renames are sorted by date.
+	renames []SymbolRename
+}
+
+// NewSymbolMaster returns an empty symbol master.
+func NewSymbolMaster() *SymbolMaster {
+	return &SymbolMaster{
+		entries: map[string]*SymbolEntry{},
+		byCIK:   map[string][]string{},
+		byCUSIP: map[string][]string{},
+		byISIN:  map[string][]string{},
+	}
+}
+
+func addIndex(index map[string][]string, key, symbol string) {
+	if key == "" {
+		return
+	}
+	for _, s := range index[key] {
+		if s == symbol {
+			return
+		}
+	}
+	index[key] = append(index[key], symbol)
+	sort.Strings(index[key])
+}
+
+// Add merges entries into the master. Identifiers already known for a symbol are kept, and
+// missing ones filled in.
+func (m *SymbolMaster) Add(entries ...SymbolEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, e := range entries {
+		e.Symbol = strings.ToUpper(strings.TrimSpace(e.Symbol))
+		if e.Symbol == "" {
+			continue
+		}
+		e.CIK = NormalizeCIK(e.CIK)
+		e.CUSIP = strings.ToUpper(strings.TrimSpace(e.CUSIP))
+		e.ISIN = strings.ToUpper(strings.TrimSpace(e.ISIN))
+
+		existing, ok := m.entries[e.Symbol]
+		if !ok {
+			existing = &SymbolEntry{Symbol: e.Symbol}
+			m.entries[e.Symbol] = existing
+		}
+		existing.merge(&e)
+		addIndex(m.byCIK, existing.CIK, existing.Symbol)
+		addIndex(m.byCUSIP, existing.CUSIP, existing.Symbol)
+		addIndex(m.byISIN, existing.ISIN, existing.Symbol)
+	}
+}
+
+// AddRenames records ticker renames, skipping those already known.
+func (m *SymbolMaster) AddRenames(renames ...SymbolRename) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	known := make(map[renameKey]bool, len(m.renames)+len(renames))
+	for _, r := range m.renames {
+		known[r.key()] = true
+	}
+	for _, r := range renames {
+		r.From = strings.ToUpper(strings.TrimSpace(r.From))
+		r.To = strings.ToUpper(strings.TrimSpace(r.To))
+		if r.From == "" || r.To == "" || r.From == r.To || known[r.key()] {
+			continue
+		}
+		known[r.key()] = true
+		m.renames = append(m.renames, r)
+	}
+	sort.SliceStable(m.renames, func(i, j int) bool {
+		return m.renames[i].Date.Before(m.renames[j].Date)
+	})
+}
+
+// AddStockList adds the companies of StockListGet.
+func (m *SymbolMaster) AddStockList(symbols []CompanySymbol) {
+	entries := make([]SymbolEntry, len(symbols))
+	for i, s := range symbols {
+		entries[i] = SymbolEntry{Symbol: s.Symbol, Name: s.CompanyName}
+	}
+	m.Add(entries...)
+}
+
+// AddETFList adds the funds of ETFListGet.
+func (m *SymbolMaster) AddETFList(symbols []ETFSymbol) {
+	var entries []SymbolEntry
+	for _, s := range symbols {
+		if s.Symbol == nil {
+			continue
+		}
+		e := SymbolEntry{Symbol: *s.Symbol, IsEtf: true}
+		if s.Name != nil {
+			e.Name = *s.Name
+		}
+		entries = append(entries, e)
+	}
+	m.Add(entries...)
+}
+
+// AddDelisted adds the companies of DelistedCompanies with their delisting dates.
+func (m *SymbolMaster) AddDelisted(companies []DelistedCompany) {
+	entries := make([]SymbolEntry, len(companies))
+	for i, c := range companies {
+		entries[i] = SymbolEntry{Symbol: c.Symbol, Name: c.CompanyName, Exchange: c.Exchange, Delisted: c.DelistedDate.Time}
+	}
+	m.Add(entries...)
+}
+
+// AddSearchResults adds the matches of SearchSymbolGet or SearchNameGet.
+func (m *SymbolMaster) AddSearchResults(results []SearchSymbol) {
+	entries := make([]SymbolEntry, len(results))
+	for i, r := range results {
+		entries[i] = SymbolEntry{Symbol: r.Symbol, Name: r.Name, Exchange: r.Exchange}
+	}
+	m.Add(entries...)
+}
+
+// AddProfiles adds the identifiers of company profiles, e.g. from ProfileGet or GetBulkProfiles.
+func (m *SymbolMaster) AddProfiles(profiles []CompanyProfile) {
+	entries := make([]SymbolEntry, len(profiles))
+	for i, p := range profiles {
+		entries[i] = SymbolEntry{
+			Symbol:   p.Symbol,
+			Name:     p.CompanyName,
+			Exchange: p.Exchange,
+			CIK:      p.Cik,
+			CUSIP:    p.Cusip,
+			ISIN:     p.Isin,
+			IsEtf:    p.IsEtf,
+		}
+	}
+	m.Add(entries...)
+}
+
+// AddSymbolChanges adds the renames of SymbolChangeGet.
+func (m *SymbolMaster) AddSymbolChanges(changes []SymbolChange) {
+	renames := make([]SymbolRename, len(changes))
+	for i, c := range changes {
+		renames[i] = SymbolRename{Date: c.Date.Time, From: c.OldSymbol, To: c.NewSymbol, Name: c.CompanyName}
+	}
+	m.AddRenames(renames...)
+}
+
+// Len returns the number of symbols of the master.
+func (m *SymbolMaster) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.entries)
+}
+
+// Lookup returns the entry of a ticker as recorded, without following renames.
+func (m *SymbolMaster) Lookup(symbol string) (SymbolEntry, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	e, ok := m.entries[strings.ToUpper(strings.TrimSpace(symbol))]
+	if !ok {
+		return SymbolEntry{}, false
+	}
+	return *e, true
+}
+
+// currentSymbol follows the renames of a ticker after t to its latest ticker.
+func (m *SymbolMaster) currentSymbol(symbol string, t time.Time) string {
+	for _, r := range m.renames {
+		if r.From == symbol && !r.Date.Before(t) {
+			symbol, t = r.To, r.Date
+		}
+	}
+	return symbol
+}
+
+// CurrentSymbol returns the latest ticker of a symbol, following its renames.
+func (m *SymbolMaster) CurrentSymbol(symbol string) string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.currentSymbol(strings.ToUpper(strings.TrimSpace(symbol)), time.Time{})
+}
+
+// SymbolAsOf returns the ticker that the security known as symbol today traded under at t, by
+// undoing the renames after t.
+func (m *SymbolMaster) SymbolAsOf(symbol string, t time.Time) string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	for i := len(m.renames) - 1; i >= 0; i-- {
+		if r := m.renames[i]; r.To == symbol && r.Date.After(t) {
+			symbol = r.From
+		}
+	}
+	return symbol
+}
+
+// Renames returns the renames from or to a ticker and its earlier and later tickers, oldest
+// first.
+func (m *SymbolMaster) Renames(symbol string) []SymbolRename {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	symbols := map[string]bool{strings.ToUpper(strings.TrimSpace(symbol)): true}
+	for grown := true; grown; {
+		grown = false
+		for _, r := range m.renames {
+			if symbols[r.From] != symbols[r.To] {
+				symbols[r.From], symbols[r.To] = true, true
+				grown = true
+			}
+		}
+	}
+	var renames []SymbolRename
+	for _, r := range m.renames {
+		if symbols[r.From] {
+			renames = append(renames, r)
+		}
+	}
+	return renames
+}
+
+// Resolve returns the entries matching an identifier, classified by ParseIdentifier, sorted by
+// symbol. Tickers follow their renames to the latest ticker when it is known.
+func (m *SymbolMaster) Resolve(id string) []SymbolEntry {
+	kind, id := ParseIdentifier(id)
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var symbols []string
+	switch kind {
+	case IdentifierCIK:
+		symbols = m.byCIK[id]
+	case IdentifierCUSIP:
+		symbols = m.byCUSIP[id]
+	case IdentifierISIN:
+		symbols = m.byISIN[id]
+	default:
+		if current := m.currentSymbol(id, time.Time{}); m.entries[current] != nil {
+			symbols = []string{current}
+		} else if m.entries[id] != nil {
+			symbols = []string{id}
+		}
+	}
+	entries := make([]SymbolEntry, 0, len(symbols))
+	for _, s := range symbols {
+		entries = append(entries, *m.entries[s])
+	}
+	return entries
+}
+
+// ResolveRemote resolves an identifier locally, falling back to SearchCIKGet, SearchCUSIPGet,
+// SearchISINGet or SearchSymbolGet when it is unknown. Remote matches are added to the master.
+func (m *SymbolMaster) ResolveRemote(ctx context.Context, c *ClientWithResponses, id string) ([]SymbolEntry, error) {
+	if entries := m.Resolve(id); len(entries) > 0 {
+		return entries, nil
+	}
+
+	var entries []SymbolEntry
+	switch kind, id := ParseIdentifier(id); kind {
+	case IdentifierCIK:
+		resp, err := c.SearchCIKGetWithResponse(ctx, &SearchCIKGetParams{Cik: id})
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected status of cik search: %s", resp.Status())
+		}
+		for _, r := range *resp.JSON200 {
+			entries = append(entries, SymbolEntry{Symbol: r.Symbol, Name: r.CompanyName, Exchange: r.Exchange, CIK: r.Cik})
+		}
+	case IdentifierCUSIP:
+		resp, err := c.SearchCUSIPGetWithResponse(ctx, &SearchCUSIPGetParams{Cusip: id})
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected status of cusip search: %s", resp.Status())
+		}
+		for _, r := range *resp.JSON200 {
+			entries = append(entries, SymbolEntry{Symbol: r.Symbol, Name: r.CompanyName, CUSIP: r.Cusip})
+		}
+	case IdentifierISIN:
+		resp, err := c.SearchISINGetWithResponse(ctx, &SearchISINGetParams{Isin: id})
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected status of isin search: %s", resp.Status())
+		}
+		for _, r := range *resp.JSON200 {
+			entries = append(entries, SymbolEntry{Symbol: r.Symbol, Name: r.Name, ISIN: r.Isin})
+		}
+	default:
+		resp, err := c.SearchSymbolGetWithResponse(ctx, &SearchSymbolGetParams{Query: id})
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected status of symbol search: %s", resp.Status())
+		}
+		for _, r := range *resp.JSON200 {
+			// The search matches prefixes, so keep the exact ticker only.
+			if strings.EqualFold(r.Symbol, id) {
+				entries = append(entries, SymbolEntry{Symbol: r.Symbol, Name: r.Name, Exchange: r.Exchange})
+			}
+		}
+	}
+	m.Add(entries...)
+	return m.Resolve(id), nil
+}
+
+// SymbolMasterParams defines parameters for SymbolMaster.Refresh.
+type SymbolMasterParams struct {
+	// DelistedPages is the maximum number of pages of DelistedCompanies fetched.
+	DelistedPages int
+
+	// ProfileParts is the maximum number of parts of ProfileBulkGet fetched for the CIKs, CUSIPs and
+	// ISINs. Zero skips the profiles, leaving identifiers to ResolveRemote.
+	ProfileParts int
+}
+
+// Refresh adds the securities of StockListGet, ETFListGet and DelistedCompanies, the renames of
+// SymbolChangeGet and, optionally, the identifiers of the bulk profiles. The params may be nil.
+func (m *SymbolMaster) Refresh(ctx context.Context, c *ClientWithResponses, params *SymbolMasterParams) error {
+	var p SymbolMasterParams
+	if params != nil {
+		p = *params
+	}
+
+	stocks, err := c.StockListGetWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if stocks.JSON200 == nil {
+		return fmt.Errorf("unexpected status of stock list: %s", stocks.Status())
+	}
+	m.AddStockList(*stocks.JSON200)
+
+	etfs, err := c.ETFListGetWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	if etfs.JSON200 == nil {
+		return fmt.Errorf("unexpected status of ETF list: %s", etfs.Status())
+	}
+	m.AddETFList(*etfs.JSON200)
+
+	for page := 0; page < p.DelistedPages; page++ {
+		current := page
+		resp, err := c.DelistedCompaniesWithResponse(ctx, &DelistedCompaniesParams{Page: &current})
+		if err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return fmt.Errorf("unexpected status of delisted companies: %s", resp.Status())
+		}
+		if len(*resp.JSON200) == 0 {
+			break
+		}
+		m.AddDelisted(*resp.JSON200)
+	}
+
+	changes, err := c.SymbolChangeGetWithResponse(ctx, &SymbolChangeGetParams{})
+	if err != nil {
+		return err
+	}
+	if changes.JSON200 == nil {
+		return fmt.Errorf("unexpected status of symbol change: %s", changes.Status())
+	}
+	m.AddSymbolChanges(*changes.JSON200)
+
+	if p.ProfileParts > 0 {
+		profiles, err := GetBulkProfiles(ctx, c, p.ProfileParts)
+		if err != nil {
+			return err
+		}
+		m.AddProfiles(profiles)
+	}
+	return nil
+}
+
+// symbolMasterRecord is a line written by Save, holding either an entry or a rename.
+type symbolMasterRecord struct {
+	Entry  *SymbolEntry  `json:"entry,omitempty"`
+	Rename *SymbolRename `json:"rename,omitempty"`
+}
+
+// Save writes the entries, sorted by symbol, and the renames, oldest first, as JSON lines.
+func (m *SymbolMaster) Save(w io.Writer) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	symbols := make([]string, 0, len(m.entries))
+	for symbol := range m.entries {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	enc := json.NewEncoder(w)
+	for _, symbol := range symbols {
+		if err := enc.Encode(symbolMasterRecord{Entry: m.entries[symbol]}); err != nil {
+			return err
+		}
+	}
+	for i := range m.renames {
+		if err := enc.Encode(symbolMasterRecord{Rename: &m.renames[i]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load reads entries and renames written by Save into the master.
+func (m *SymbolMaster) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	var (
+		entries []SymbolEntry
+		renames []SymbolRename
+	)
+	for scanner.Scan() {
+		var record symbolMasterRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return err
+		}
+		if record.Entry != nil {
+			entries = append(entries, *record.Entry)
+		}
+		if record.Rename != nil {
+			renames = append(renames, *record.Rename)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	m.Add(entries...)
+	m.AddRenames(renames...)
+	return nil
+}
@@ -0,0 +1,144 @@
+package financialmodelingprep
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type symbolMasterSuite struct {
+	suite.Suite
+}
+
+func (r *symbolMasterSuite) TestParseIdentifier() {
+	for _, tc := range []struct {
+		id   string
+		kind IdentifierKind
+		norm string
+	}{
+		{"AAPL", IdentifierTicker, "AAPL"},
+		{" brk.b ", IdentifierTicker, "BRK.B"},
+		{"0000320193", IdentifierCIK, "320193"},
+		{"320193", IdentifierCIK, "320193"},
+		{"037833100", IdentifierCUSIP, "037833100"},
+		{"594918104", IdentifierCUSIP, "594918104"},
+		{"594918105", IdentifierCIK, "594918105"},
+		{"38259P508", IdentifierCUSIP, "38259P508"},
+		{"US0378331005", IdentifierISIN, "US0378331005"},
+		{"us5949181045", IdentifierISIN, "US5949181045"},
+		{"GB0002634946", IdentifierISIN, "GB0002634946"},
+		// Wrong check digits.
+		{"US0378331006", IdentifierTicker, "US0378331006"},
+		{"38259P509", IdentifierTicker, "38259P509"},
+	} {
+		kind, norm := ParseIdentifier(tc.id)
+		r.Equal(tc.kind, kind, tc.id)
+		r.Equal(tc.norm, norm, tc.id)
+	}
+	r.True(ValidCUSIP("594918104"))
+	r.False(ValidCUSIP("59491810"))
+	r.False(ValidISIN("U50378331005"))
+}
+
+func (r *symbolMasterSuite) master() *SymbolMaster {
+	m := NewSymbolMaster()
+	m.AddStockList([]CompanySymbol{{Symbol: "AAPL", CompanyName: "Apple Inc."}, {Symbol: "META", CompanyName: "Meta Platforms"}})
+	m.AddETFList([]ETFSymbol{{Symbol: stringPtr("SPY"), Name: stringPtr("SPDR S&P 500 ETF Trust")}, {Name: stringPtr("No symbol")}})
+	m.AddProfiles([]CompanyProfile{
+		{Symbol: "AAPL", CompanyName: "Apple Inc. (profile)", Exchange: "NASDAQ", Cik: "0000320193", Cusip: "037833100", Isin: "US0378331005"},
+		{Symbol: "GOOGL", CompanyName: "Alphabet Inc.", Exchange: "NASDAQ", Cik: "0001652044", Cusip: "02079K305", Isin: "US02079K3059"},
+		{Symbol: "GOOG", CompanyName: "Alphabet Inc.", Exchange: "NASDAQ", Cik: "0001652044", Cusip: "02079K107", Isin: "US02079K1079"},
+		{Symbol: "META", Cik: "0001326801"},
+	})
+	m.AddDelisted([]DelistedCompany{{Symbol: "TWTR", CompanyName: "Twitter", Exchange: "NYSE", DelistedDate: fixtureDate(2022, time.November, 8)}})
+	m.AddSymbolChanges([]SymbolChange{
+		{Date: fixtureDate(2022, time.June, 9), OldSymbol: "FB", NewSymbol: "META", CompanyName: "Meta Platforms"},
+		{Date: fixtureDate(2011, time.January, 1), OldSymbol: "THEFACEBOOK", NewSymbol: "FB"},
+	})
+	return m
+}
+
+func (r *symbolMasterSuite) TestResolve() {
+	m := r.master()
+	r.Equal(6, m.Len())
+
+	e, ok := m.Lookup("aapl")
+	r.Require().True(ok)
+	r.Equal("Apple Inc.", e.Name)
+	r.Equal("320193", e.CIK)
+	r.Equal("NASDAQ", e.Exchange)
+
+	for _, id := range []string{"AAPL", "320193", "0000320193", "037833100", "US0378331005"} {
+		entries := m.Resolve(id)
+		r.Require().Len(entries, 1, id)
+		r.Equal("AAPL", entries[0].Symbol, id)
+	}
+
+	entries := m.Resolve("1652044")
+	r.Require().Len(entries, 2)
+	r.Equal("GOOG", entries[0].Symbol)
+	r.Equal("GOOGL", entries[1].Symbol)
+
+	spy := m.Resolve("SPY")
+	r.Require().Len(spy, 1)
+	r.True(spy[0].IsEtf)
+
+	twtr := m.Resolve("TWTR")
+	r.Require().Len(twtr, 1)
+	r.Equal(time.Date(2022, time.November, 8, 0, 0, 0, 0, time.UTC), twtr[0].Delisted)
+
+	r.Empty(m.Resolve("MSFT"))
+	r.Empty(m.Resolve("US5949181045"))
+}
+
+func (r *symbolMasterSuite) TestRenames() {
+	m := r.master()
+	r.Equal("META", m.CurrentSymbol("thefacebook"))
+	r.Equal("META", m.CurrentSymbol("FB"))
+	r.Equal("AAPL", m.CurrentSymbol("AAPL"))
+
+	fb := m.Resolve("FB")
+	r.Require().Len(fb, 1)
+	r.Equal("META", fb[0].Symbol)
+
+	r.Equal("META", m.SymbolAsOf("META", time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	r.Equal("FB", m.SymbolAsOf("META", time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	r.Equal("THEFACEBOOK", m.SymbolAsOf("META", time.Date(2005, time.January, 1, 0, 0, 0, 0, time.UTC)))
+
+	renames := m.Renames("FB")
+	r.Require().Len(renames, 2)
+	r.Equal("THEFACEBOOK", renames[0].From)
+	r.Equal("META", renames[1].To)
+	r.Empty(m.Renames("AAPL"))
+
+	// Known renames are not added twice.
+	m.AddSymbolChanges([]SymbolChange{{Date: fixtureDate(2022, time.June, 9), OldSymbol: "FB", NewSymbol: "META"}})
+	r.Len(m.Renames("META"), 2)
+
+	// Nor twice within a batch, nor when the same instant is given in another zone.
+	day := time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)
+	m.AddRenames(
+		SymbolRename{Date: day, From: "OLD", To: "NEW"},
+		SymbolRename{Date: day.In(time.FixedZone("EST", -5*3600)), From: " old ", To: "new"},
+	)
+	r.Len(m.Renames("NEW"), 1)
+}
+
+func (r *symbolMasterSuite) TestSaveLoad() {
+	m := r.master()
+	var buf bytes.Buffer
+	r.Require().NoError(m.Save(&buf))
+
+	loaded := NewSymbolMaster()
+	r.Require().NoError(loaded.Load(&buf))
+	r.Equal(m.Len(), loaded.Len())
+	r.Equal(m.Resolve("1652044"), loaded.Resolve("1652044"))
+	r.Equal(m.Resolve("TWTR"), loaded.Resolve("TWTR"))
+	r.Equal(m.Renames("META"), loaded.Renames("META"))
+}
+
+func TestSymbolMasterSuite(t *testing.T) {
+	suite.Run(t, new(symbolMasterSuite))
+}
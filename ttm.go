@@ -0,0 +1,181 @@
+package financialmodelingprep
+
+import (
+	"reflect"
+	"sort"
+	"time"
+)
+
+const (
+	// minQuarterDays and maxQuarterDays bound the distance between the end dates of two consecutive
+	// quarters. The range tolerates 52/53-week fiscal years and fiscal-year shifts, but not a
+	// missing quarter.
+	minQuarterDays = 60
+	maxQuarterDays = 120
+)
+
+// Fields that hold a balance at a point in time rather than a flow over the period. They are taken
+// from the latest (or, for opening balances, the earliest) quarter instead of being summed.
+var (
+	ttmLatestFields = map[string]bool{
+		"CashAtEndOfPeriod":        true,
+		"WeightedAverageShsOut":    true,
+		"WeightedAverageShsOutDil": true,
+	}
+	ttmEarliestFields = map[string]bool{
+		"CashAtBeginningOfPeriod": true,
+	}
+)
+
+// IncomeStatementsTTM derives trailing-twelve-month income statements from quarterly statements.
+// A TTM statement is produced for every quarter that closes a run of four consecutive quarters, so
+// windows spanning a missing quarter are skipped. Annual statements in the input are ignored. The
+// result is sorted by date, newest first, like the statements returned by the API.
+func IncomeStatementsTTM(quarters []IncomeStatement) []IncomeStatementTTM {
+	windows := trailingWindows(quarters, func(s *IncomeStatement) (time.Time, string) {
+		return s.Date.Time, s.Period
+	})
+	ttms := make([]IncomeStatementTTM, len(windows))
+	for i, w := range windows {
+		aggregateTTM(&ttms[i], w, false)
+	}
+	return ttms
+}
+
+// CashFlowStatementsTTM derives trailing-twelve-month cash flow statements from quarterly
+// statements, see IncomeStatementsTTM.
+func CashFlowStatementsTTM(quarters []CashFlowStatement) []CashFlowStatementTTM {
+	windows := trailingWindows(quarters, func(s *CashFlowStatement) (time.Time, string) {
+		return s.Date.Time, s.Period
+	})
+	ttms := make([]CashFlowStatementTTM, len(windows))
+	for i, w := range windows {
+		aggregateTTM(&ttms[i], w, false)
+	}
+	return ttms
+}
+
+// BalanceSheetStatementsTTM returns the point-in-time balance sheets matching the windows of
+// IncomeStatementsTTM, i.e. the balance sheet of the latest quarter of each window.
+func BalanceSheetStatementsTTM(quarters []BalanceSheetStatement) []BalanceSheetStatementTTM {
+	windows := trailingWindows(quarters, func(s *BalanceSheetStatement) (time.Time, string) {
+		return s.Date.Time, s.Period
+	})
+	ttms := make([]BalanceSheetStatementTTM, len(windows))
+	for i, w := range windows {
+		aggregateTTM(&ttms[i], w, true)
+	}
+	return ttms
+}
+
+// IncomeStatementTTMAsOf returns the trailing-twelve-month income statement of the latest window
+// public at the given time, i.e. whose latest quarter was accepted, or filed when the accepted date
+// is missing, on or before it.
+func IncomeStatementTTMAsOf(quarters []IncomeStatement, asOf time.Time) (*IncomeStatementTTM, bool) {
+	for _, ttm := range IncomeStatementsTTM(quarters) {
+		if !statementKnownAt(ttm.AcceptedDate, ttm.FilingDate.Time).After(asOf) {
+			return &ttm, true
+		}
+	}
+	return nil, false
+}
+
+// CashFlowStatementTTMAsOf returns the trailing-twelve-month cash flow statement of the latest
+// window public at the given time, see IncomeStatementTTMAsOf.
+func CashFlowStatementTTMAsOf(quarters []CashFlowStatement, asOf time.Time) (*CashFlowStatementTTM, bool) {
+	for _, ttm := range CashFlowStatementsTTM(quarters) {
+		if !statementKnownAt(ttm.AcceptedDate, ttm.FilingDate.Time).After(asOf) {
+			return &ttm, true
+		}
+	}
+	return nil, false
+}
+
+// BalanceSheetStatementTTMAsOf returns the balance sheet of the latest quarter public at the given
+// time that closes a run of four consecutive quarters, see IncomeStatementTTMAsOf.
+func BalanceSheetStatementTTMAsOf(quarters []BalanceSheetStatement, asOf time.Time) (*BalanceSheetStatementTTM, bool) {
+	for _, ttm := range BalanceSheetStatementsTTM(quarters) {
+		if !statementKnownAt(ttm.AcceptedDate, ttm.FilingDate.Time).After(asOf) {
+			return &ttm, true
+		}
+	}
+	return nil, false
+}
+
+// trailingWindows returns the runs of four consecutive quarters, newest window first. Each window is
+// sorted oldest first. Consecutive quarters are detected by their end dates rather than their
+// labels, since the labels move when a company shifts its fiscal year.
+func trailingWindows[T any](statements []T, key func(*T) (time.Time, string)) [][]*T {
+	var quarters []*T
+	seen := map[time.Time]bool{}
+	for i := range statements {
+		s := &statements[i]
+		date, period := key(s)
+		if !(FinancialPeriod{Period: period}).IsQuarter() || seen[date] {
+			continue
+		}
+		seen[date] = true
+		quarters = append(quarters, s)
+	}
+	sort.Slice(quarters, func(i, j int) bool {
+		di, _ := key(quarters[i])
+		dj, _ := key(quarters[j])
+		return di.Before(dj)
+	})
+
+	var windows [][]*T
+	run := 0
+	for i := range quarters {
+		run++
+		if i > 0 {
+			prev, _ := key(quarters[i-1])
+			cur, _ := key(quarters[i])
+			if days := cur.Sub(prev).Hours() / 24; days < minQuarterDays || days > maxQuarterDays {
+				run = 1
+			}
+		}
+		if run >= 4 {
+			windows = append(windows, quarters[i-3:i+1])
+		}
+	}
+	for i, j := 0, len(windows)-1; i < j; i, j = i+1, j-1 {
+		windows[i], windows[j] = windows[j], windows[i]
+	}
+	return windows
+}
+
+// aggregateTTM fills dst from the quarters of a window by field name. Descriptive fields and dates
+// come from the latest quarter. Numeric fields are summed, unless pointInTime is set or the field
+// holds a balance, in which case they come from the latest or earliest quarter.
+func aggregateTTM[T any](dst any, window []*T, pointInTime bool) {
+	out := reflect.ValueOf(dst).Elem()
+	latest := reflect.ValueOf(window[len(window)-1]).Elem()
+	earliest := reflect.ValueOf(window[0]).Elem()
+	for i := 0; i < out.NumField(); i++ {
+		name := out.Type().Field(i).Name
+		field := out.Field(i)
+		src := latest.FieldByName(name)
+		if !src.IsValid() {
+			continue
+		}
+		switch field.Kind() {
+		case reflect.Float32, reflect.Float64:
+			switch {
+			case pointInTime || ttmLatestFields[name]:
+				field.SetFloat(src.Float())
+			case ttmEarliestFields[name]:
+				field.SetFloat(earliest.FieldByName(name).Float())
+			default:
+				var sum float64
+				for _, q := range window {
+					sum += reflect.ValueOf(q).Elem().FieldByName(name).Float()
+				}
+				field.SetFloat(sum)
+			}
+		default:
+			if src.Type().AssignableTo(field.Type()) {
+				field.Set(src)
+			}
+		}
+	}
+}
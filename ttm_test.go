@@ -0,0 +1,185 @@
+package financialmodelingprep
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"math"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ttmSuite struct {
+	suite.Suite
+}
+
+// ttmFixtureDir holds the responses recorded by clientSuite.TestRecordTTMFixtures.
+const ttmFixtureDir = "testdata/ttm-aapl"
+
+func ttmFixture(name string) string {
+	return ttmFixtureDir + "/" + name + ".json"
+}
+
+func (r *ttmSuite) loadJSON(name string, v interface{}) {
+	data, err := os.ReadFile(name)
+	r.Require().NoError(err)
+	r.Require().NoError(json.Unmarshal(data, v))
+}
+
+// loadRecorded loads a recorded response, skipping the test when it was not recorded yet.
+func (r *ttmSuite) loadRecorded(name string, v interface{}) {
+	if _, err := os.Stat(ttmFixture(name)); errors.Is(err, fs.ErrNotExist) {
+		r.T().Skipf("%s is not recorded, run TestClientSuite/TestRecordTTMFixtures with FMP_API_KEY and FMP_RECORD_FIXTURES set", ttmFixture(name))
+	}
+	r.loadJSON(ttmFixture(name), v)
+}
+
+// compareTTM compares every numeric field of the computed TTM statements with the responses of a
+// TTM endpoint, matched by date. The latest response must have a computed counterpart.
+func (r *ttmSuite) compareTTM(expected, actual interface{}) {
+	ev, av := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	r.Require().NotZero(ev.Len())
+	for i := 0; i < ev.Len(); i++ {
+		e := ev.Index(i)
+		date := e.FieldByName("Date").Interface()
+		var a reflect.Value
+		for j := 0; j < av.Len(); j++ {
+			if av.Index(j).FieldByName("Date").Interface() == date {
+				a = av.Index(j)
+				break
+			}
+		}
+		if !a.IsValid() {
+			r.NotZero(i, "no TTM computed for %v", date)
+			continue
+		}
+		r.Equal(e.FieldByName("Symbol").String(), a.FieldByName("Symbol").String())
+		for k := 0; k < e.NumField(); k++ {
+			if kind := e.Field(k).Kind(); kind != reflect.Float32 && kind != reflect.Float64 {
+				continue
+			}
+			want := e.Field(k).Float()
+			r.InDelta(want, a.Field(k).Float(), math.Max(0.01, math.Abs(want)*1e-4), "%v %s", date, e.Type().Field(k).Name)
+		}
+	}
+}
+
+func (r *ttmSuite) TestIncomeStatementsTTM() {
+	var quarters []IncomeStatement
+	r.loadJSON("testdata/income-statement-aapl-quarter.json", &quarters)
+
+	ttms := IncomeStatementsTTM(quarters)
+	r.Len(ttms, 2)
+	r.Equal("Q3", ttms[0].Period)
+	r.Equal("2025-08-01 06:01:09", ttms[0].AcceptedDate)
+
+	// The window spans the fiscal year boundary.
+	r.Equal("Q2", ttms[1].Period)
+	r.InDelta(400366000000, ttms[1].Revenue, 1)
+
+	// The Q2 window ends on 2025-03-29 but is only public with the 10-Q accepted on 2025-05-02
+	// 06:01:10 US Eastern time.
+	_, ok := IncomeStatementTTMAsOf(quarters, time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC))
+	r.False(ok)
+	_, ok = IncomeStatementTTMAsOf(quarters, time.Date(2025, time.May, 2, 10, 0, 0, 0, time.UTC))
+	r.False(ok)
+	ttm, ok := IncomeStatementTTMAsOf(quarters, time.Date(2025, time.May, 2, 10, 2, 0, 0, time.UTC))
+	r.True(ok)
+	r.Equal("Q2", ttm.Period)
+
+	// The Q3 window ended in June, but its filing was not public before August.
+	ttm, ok = IncomeStatementTTMAsOf(quarters, time.Date(2025, time.July, 31, 0, 0, 0, 0, time.UTC))
+	r.True(ok)
+	r.Equal("Q2", ttm.Period)
+
+	_, ok = IncomeStatementTTMAsOf(quarters, time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC))
+	r.False(ok)
+}
+
+func (r *ttmSuite) TestIncomeStatementsTTMReference() {
+	var quarters []IncomeStatement
+	r.loadJSON("testdata/income-statement-aapl-quarter.json", &quarters)
+
+	// The TTM to June 2025 as Apple reported it in its 10-Qs and 10-K, until the responses of
+	// the TTM endpoints are recorded below.
+	var expected []IncomeStatementTTM
+	r.loadJSON("testdata/income-statement-ttm-aapl.json", &expected)
+	r.compareTTM(expected, IncomeStatementsTTM(quarters))
+}
+
+func (r *ttmSuite) TestIncomeStatementsTTMMissingQuarter() {
+	var quarters []IncomeStatement
+	r.loadJSON("testdata/income-statement-aapl-quarter.json", &quarters)
+
+	// Drop Q1 2025, which leaves no run of four consecutive quarters.
+	var withGap []IncomeStatement
+	for _, q := range quarters {
+		if q.FiscalYear == "2025" && q.Period == "Q1" {
+			continue
+		}
+		withGap = append(withGap, q)
+	}
+	r.Empty(IncomeStatementsTTM(withGap))
+}
+
+func (r *ttmSuite) TestCashFlowStatementsTTM() {
+	quarters := []CashFlowStatement{
+		{Date: fixtureDate(2024, time.September, 28), Period: "Q4", CashAtBeginningOfPeriod: 26635, CashAtEndOfPeriod: 29943, FreeCashFlow: 23903},
+		{Date: fixtureDate(2024, time.December, 28), Period: "Q1", CashAtBeginningOfPeriod: 29943, CashAtEndOfPeriod: 30299, FreeCashFlow: 26995},
+		{Date: fixtureDate(2025, time.March, 29), Period: "Q2", CashAtBeginningOfPeriod: 30299, CashAtEndOfPeriod: 28162, FreeCashFlow: 20881},
+		{Date: fixtureDate(2025, time.June, 28), Period: "Q3", CashAtBeginningOfPeriod: 28162, CashAtEndOfPeriod: 36269, FreeCashFlow: 24405},
+	}
+	ttms := CashFlowStatementsTTM(quarters)
+	r.Len(ttms, 1)
+	r.InDelta(96184, ttms[0].FreeCashFlow, 1e-9)
+	r.InDelta(26635, ttms[0].CashAtBeginningOfPeriod, 1e-9)
+	r.InDelta(36269, ttms[0].CashAtEndOfPeriod, 1e-9)
+}
+
+func (r *ttmSuite) TestBalanceSheetStatementsTTM() {
+	quarters := []BalanceSheetStatement{
+		{Date: fixtureDate(2024, time.September, 28), Period: "Q4", TotalAssets: 364980},
+		{Date: fixtureDate(2024, time.December, 28), Period: "Q1", TotalAssets: 344085},
+		{Date: fixtureDate(2025, time.March, 29), Period: "Q2", TotalAssets: 331233},
+		{Date: fixtureDate(2025, time.June, 28), Period: "Q3", TotalAssets: 331495},
+	}
+	ttms := BalanceSheetStatementsTTM(quarters)
+	r.Len(ttms, 1)
+	r.InDelta(331495, ttms[0].TotalAssets, 1e-3)
+
+	ttm, ok := BalanceSheetStatementTTMAsOf(quarters, time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC))
+	r.True(ok)
+	r.Equal(quarters[3].Date, ttm.Date)
+}
+
+func (r *ttmSuite) TestIncomeStatementTTMEndpoint() {
+	var quarters []IncomeStatement
+	r.loadRecorded(incomeStatementName+"-quarter", &quarters)
+	var expected []IncomeStatementTTM
+	r.loadRecorded(incomeStatementName+"-ttm", &expected)
+	r.compareTTM(expected, IncomeStatementsTTM(quarters))
+}
+
+func (r *ttmSuite) TestCashFlowStatementTTMEndpoint() {
+	var quarters []CashFlowStatement
+	r.loadRecorded(cashFlowStatementName+"-quarter", &quarters)
+	var expected []CashFlowStatementTTM
+	r.loadRecorded(cashFlowStatementName+"-ttm", &expected)
+	r.compareTTM(expected, CashFlowStatementsTTM(quarters))
+}
+
+func (r *ttmSuite) TestBalanceSheetStatementTTMEndpoint() {
+	var quarters []BalanceSheetStatement
+	r.loadRecorded(balanceSheetStatementName+"-quarter", &quarters)
+	var expected []BalanceSheetStatementTTM
+	r.loadRecorded(balanceSheetStatementName+"-ttm", &expected)
+	r.compareTTM(expected, BalanceSheetStatementsTTM(quarters))
+}
+
+func TestTTMSuite(t *testing.T) {
+	suite.Run(t, new(ttmSuite))
+}
@@ -0,0 +1,519 @@
+package financialmodelingprep
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Tenor is the maturity of a treasury rate in months.
+type Tenor int
+
+const (
+	Tenor1M  Tenor = 1
+	Tenor2M  Tenor = 2
+	Tenor3M  Tenor = 3
+	Tenor6M  Tenor = 6
+	Tenor1Y  Tenor = 12
+	Tenor2Y  Tenor = 24
+	Tenor3Y  Tenor = 36
+	Tenor5Y  Tenor = 60
+	Tenor7Y  Tenor = 84
+	Tenor10Y Tenor = 120
+	Tenor20Y Tenor = 240
+	Tenor30Y Tenor = 360
+)
+
+// Tenors lists the tenors of TreasuryRates, shortest first.
+var Tenors = []Tenor{Tenor1M, Tenor2M, Tenor3M, Tenor6M, Tenor1Y, Tenor2Y, Tenor3Y, Tenor5Y, Tenor7Y, Tenor10Y, Tenor20Y, Tenor30Y}
+
+// Years returns the maturity in years.
+func (t Tenor) Years() float64 {
+	return float64(t) / 12
+}
+
+func (t Tenor) String() string {
+	if t%12 == 0 {
+		return fmt.Sprintf("%dY", t/12)
+	}
+	return fmt.Sprintf("%dM", t)
+}
+
+// Rate returns the rate of a tenor as quoted, in percent.
+func (r *TreasuryRates) Rate(t Tenor) (float64, bool) {
+	switch t {
+	case Tenor1M:
+		return r.Month1, true
+	case Tenor2M:
+		return r.Month2, true
+	case Tenor3M:
+		return r.Month3, true
+	case Tenor6M:
+		return r.Month6, true
+	case Tenor1Y:
+		return r.Year1, true
+	case Tenor2Y:
+		return r.Year2, true
+	case Tenor3Y:
+		return r.Year3, true
+	case Tenor5Y:
+		return r.Year5, true
+	case Tenor7Y:
+		return r.Year7, true
+	case Tenor10Y:
+		return r.Year10, true
+	case Tenor20Y:
+		return r.Year20, true
+	case Tenor30Y:
+		return r.Year30, true
+	}
+	return 0, false
+}
+
+// Interpolation selects how rates between the quoted tenors are estimated.
+type Interpolation int
+
+const (
+	// LinearInterpolation interpolates linearly between the neighbouring tenors.
+	LinearInterpolation Interpolation = iota
+
+	// CubicSplineInterpolation interpolates with a natural cubic spline through all tenors.
+	CubicSplineInterpolation
+
+	// NelsonSiegelInterpolation evaluates a Nelson-Siegel model fitted to all tenors.
+	NelsonSiegelInterpolation
+)
+
+// YieldCurve is the treasury curve of a single date. Rates are fractions, e.g. 0.0425 for 4.25%,
+// and are treated as annually compounded. Maturities outside the quoted tenors are extrapolated
+// flat, except with NelsonSiegelInterpolation.
+type YieldCurve struct {
+	Date time.Time
+
+	// Tenors and Rates hold the quoted points, shortest tenor first. Tenors quoted as zero, which
+	// the API uses for missing values, are left out.
+	Tenors []Tenor
+	Rates  []float64
+}
+
+// NewYieldCurve builds the curve of a TreasuryRates record.
+func NewYieldCurve(r *TreasuryRates) *YieldCurve {
+	c := &YieldCurve{Date: r.Date.Time}
+	for _, t := range Tenors {
+		if rate, _ := r.Rate(t); rate != 0 {
+			c.Tenors = append(c.Tenors, t)
+			c.Rates = append(c.Rates, rate/100)
+		}
+	}
+	return c
+}
+
+// Rate returns the quoted rate of a tenor.
+func (c *YieldCurve) Rate(t Tenor) (float64, bool) {
+	for i, tenor := range c.Tenors {
+		if tenor == t {
+			return c.Rates[i], true
+		}
+	}
+	return 0, false
+}
+
+func (c *YieldCurve) years() []float64 {
+	xs := make([]float64, len(c.Tenors))
+	for i, t := range c.Tenors {
+		xs[i] = t.Years()
+	}
+	return xs
+}
+
+// Interpolate returns the rate at a maturity in years.
+func (c *YieldCurve) Interpolate(years float64, method Interpolation) (float64, error) {
+	if len(c.Tenors) == 0 {
+		return 0, fmt.Errorf("empty yield curve of %s", c.Date.Format(time.DateOnly))
+	}
+	switch method {
+	case LinearInterpolation:
+		return interpolateLinear(c.years(), c.Rates, years), nil
+	case CubicSplineInterpolation:
+		return interpolateCubicSpline(c.years(), c.Rates, years), nil
+	case NelsonSiegelInterpolation:
+		ns, err := c.NelsonSiegel()
+		if err != nil {
+			return 0, err
+		}
+		return ns.Rate(years), nil
+	}
+	return 0, fmt.Errorf("not supported interpolation: %d", method)
+}
+
+// DiscountFactor returns the present value of 1 paid at a maturity in years.
+func (c *YieldCurve) DiscountFactor(years float64, method Interpolation) (float64, error) {
+	r, err := c.Interpolate(years, method)
+	if err != nil {
+		return 0, err
+	}
+	return math.Pow(1+r, -years), nil
+}
+
+// Forward returns the annually compounded forward rate between two maturities in years.
+func (c *YieldCurve) Forward(from, to float64, method Interpolation) (float64, error) {
+	if to <= from {
+		return 0, fmt.Errorf("invalid forward period from %g to %g years", from, to)
+	}
+	d1, err := c.DiscountFactor(from, method)
+	if err != nil {
+		return 0, err
+	}
+	d2, err := c.DiscountFactor(to, method)
+	if err != nil {
+		return 0, err
+	}
+	return math.Pow(d1/d2, 1/(to-from)) - 1, nil
+}
+
+// Spread returns the rate of the long tenor minus the rate of the short tenor.
+func (c *YieldCurve) Spread(short, long Tenor) (float64, bool) {
+	s, ok := c.Rate(short)
+	if !ok {
+		return 0, false
+	}
+	l, ok := c.Rate(long)
+	if !ok {
+		return 0, false
+	}
+	return l - s, true
+}
+
+// Spread2s10s returns the 10-year minus the 2-year rate.
+func (c *YieldCurve) Spread2s10s() (float64, bool) {
+	return c.Spread(Tenor2Y, Tenor10Y)
+}
+
+// IsInverted reports whether the 2s10s spread is negative.
+func (c *YieldCurve) IsInverted() bool {
+	s, ok := c.Spread2s10s()
+	return ok && s < 0
+}
+
+// Inversion is a segment of the curve where the longer tenor yields less than the shorter one.
+type Inversion struct {
+	Short, Long Tenor
+	Spread      float64
+}
+
+// Inversions returns the inverted segments between neighbouring tenors, shortest first.
+func (c *YieldCurve) Inversions() []Inversion {
+	var inversions []Inversion
+	for i := 1; i < len(c.Tenors); i++ {
+		if spread := c.Rates[i] - c.Rates[i-1]; spread < 0 {
+			inversions = append(inversions, Inversion{
+				Short:  c.Tenors[i-1],
+				Long:   c.Tenors[i],
+				Spread: spread,
+			})
+		}
+	}
+	return inversions
+}
+
+// NelsonSiegel is the Nelson-Siegel model
+//
+//	r(t) = Beta0 + Beta1 (1 - e^(-t/Lambda)) / (t/Lambda) + Beta2 ((1 - e^(-t/Lambda)) / (t/Lambda) - e^(-t/Lambda))
+//
+// where Beta0 is the long-term level, Beta1 the slope and Beta2 the curvature.
+type NelsonSiegel struct {
+	Beta0, Beta1, Beta2 float64
+	Lambda              float64
+}
+
+// Rate returns the modelled rate at a maturity in years.
+func (ns *NelsonSiegel) Rate(years float64) float64 {
+	if years <= 0 {
+		return ns.Beta0 + ns.Beta1
+	}
+	slope, curvature := nelsonSiegelLoadings(years, ns.Lambda)
+	return ns.Beta0 + ns.Beta1*slope + ns.Beta2*curvature
+}
+
+func nelsonSiegelLoadings(years, lambda float64) (float64, float64) {
+	x := years / lambda
+	e := math.Exp(-x)
+	slope := (1 - e) / x
+	return slope, slope - e
+}
+
+// NelsonSiegel fits a Nelson-Siegel model to the quoted rates. The betas are solved by least
+// squares for each Lambda of a grid between 0.1 and 10 years, keeping the best fit.
+func (c *YieldCurve) NelsonSiegel() (*NelsonSiegel, error) {
+	if len(c.Tenors) < 3 {
+		return nil, fmt.Errorf("not enough tenors to fit Nelson-Siegel: %d", len(c.Tenors))
+	}
+	xs := c.years()
+
+	var (
+		best    *NelsonSiegel
+		bestSSE = math.Inf(1)
+	)
+	for lambda := 0.1; lambda <= 10; lambda += 0.05 {
+		// Normal equations of the linear regression on [1, slope, curvature].
+		var a [3][3]float64
+		var b [3]float64
+		for i, x := range xs {
+			slope, curvature := nelsonSiegelLoadings(x, lambda)
+			row := [3]float64{1, slope, curvature}
+			for j := 0; j < 3; j++ {
+				for k := 0; k < 3; k++ {
+					a[j][k] += row[j] * row[k]
+				}
+				b[j] += row[j] * c.Rates[i]
+			}
+		}
+		beta, ok := solve3(a, b)
+		if !ok {
+			continue
+		}
+		ns := &NelsonSiegel{Beta0: beta[0], Beta1: beta[1], Beta2: beta[2], Lambda: lambda}
+		var sse float64
+		for i, x := range xs {
+			d := ns.Rate(x) - c.Rates[i]
+			sse += d * d
+		}
+		if sse < bestSSE {
+			best, bestSSE = ns, sse
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("failed to fit Nelson-Siegel to the curve of %s", c.Date.Format(time.DateOnly))
+	}
+	return best, nil
+}
+
+// solve3 solves a 3x3 linear system by Gaussian elimination with partial pivoting.
+func solve3(a [3][3]float64, b [3]float64) ([3]float64, bool) {
+	for col := 0; col < 3; col++ {
+		pivot := col
+		for row := col + 1; row < 3; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(a[pivot][col]) < 1e-12 {
+			return b, false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+		b[col], b[pivot] = b[pivot], b[col]
+		for row := col + 1; row < 3; row++ {
+			f := a[row][col] / a[col][col]
+			for k := col; k < 3; k++ {
+				a[row][k] -= f * a[col][k]
+			}
+			b[row] -= f * b[col]
+		}
+	}
+	var x [3]float64
+	for row := 2; row >= 0; row-- {
+		x[row] = b[row]
+		for k := row + 1; k < 3; k++ {
+			x[row] -= a[row][k] * x[k]
+		}
+		x[row] /= a[row][row]
+	}
+	return x, true
+}
+
+func interpolateLinear(xs, ys []float64, x float64) float64 {
+	if x <= xs[0] {
+		return ys[0]
+	}
+	n := len(xs)
+	if x >= xs[n-1] {
+		return ys[n-1]
+	}
+	i := sort.SearchFloat64s(xs, x)
+	w := (x - xs[i-1]) / (xs[i] - xs[i-1])
+	return ys[i-1] + w*(ys[i]-ys[i-1])
+}
+
+// interpolateCubicSpline evaluates the natural cubic spline through the points.
+func interpolateCubicSpline(xs, ys []float64, x float64) float64 {
+	n := len(xs)
+	if n < 3 {
+		return interpolateLinear(xs, ys, x)
+	}
+	if x <= xs[0] {
+		return ys[0]
+	}
+	if x >= xs[n-1] {
+		return ys[n-1]
+	}
+
+	// Solve the tridiagonal system for the second derivatives, which are zero at both ends.
+	m := make([]float64, n)
+	c := make([]float64, n)
+	d := make([]float64, n)
+	for i := 1; i < n-1; i++ {
+		h0, h1 := xs[i]-xs[i-1], xs[i+1]-xs[i]
+		diag := 2 * (h0 + h1)
+		rhs := 6 * ((ys[i+1]-ys[i])/h1 - (ys[i]-ys[i-1])/h0)
+		if i > 1 {
+			diag -= h0 * c[i-1]
+			rhs -= h0 * d[i-1]
+		}
+		c[i] = h1 / diag
+		d[i] = rhs / diag
+	}
+	for i := n - 2; i >= 1; i-- {
+		m[i] = d[i] - c[i]*m[i+1]
+	}
+
+	i := sort.SearchFloat64s(xs, x)
+	h := xs[i] - xs[i-1]
+	a := (xs[i] - x) / h
+	b := (x - xs[i-1]) / h
+	return a*ys[i-1] + b*ys[i] + ((a*a*a-a)*m[i-1]+(b*b*b-b)*m[i])*h*h/6
+}
+
+// YieldCurveHistory is a series of yield curves, oldest first.
+type YieldCurveHistory []*YieldCurve
+
+// NewYieldCurveHistory builds the curves of TreasuryRates records in any order.
+func NewYieldCurveHistory(rates []TreasuryRates) YieldCurveHistory {
+	h := make(YieldCurveHistory, 0, len(rates))
+	for i := range rates {
+		h = append(h, NewYieldCurve(&rates[i]))
+	}
+	sort.SliceStable(h, func(i, j int) bool {
+		return h[i].Date.Before(h[j].Date)
+	})
+	return h
+}
+
+// Between returns the curves dated within [from, to].
+func (h YieldCurveHistory) Between(from, to time.Time) YieldCurveHistory {
+	i := sort.Search(len(h), func(i int) bool {
+		return !h[i].Date.Before(from)
+	})
+	j := sort.Search(len(h), func(j int) bool {
+		return h[j].Date.After(to)
+	})
+	if i >= j {
+		return nil
+	}
+	return h[i:j]
+}
+
+// At returns the latest curve dated on or before t.
+func (h YieldCurveHistory) At(t time.Time) (*YieldCurve, bool) {
+	i := sort.Search(len(h), func(i int) bool {
+		return h[i].Date.After(t)
+	})
+	if i == 0 {
+		return nil, false
+	}
+	return h[i-1], true
+}
+
+// RatePoint is a single observation of a rate series.
+type RatePoint struct {
+	Date  time.Time
+	Value float64
+}
+
+// Series returns the rates of a tenor, skipping dates where it is not quoted.
+func (h YieldCurveHistory) Series(t Tenor) []RatePoint {
+	var points []RatePoint
+	for _, c := range h {
+		if r, ok := c.Rate(t); ok {
+			points = append(points, RatePoint{Date: c.Date, Value: r})
+		}
+	}
+	return points
+}
+
+// SpreadSeries returns the spreads between two tenors, skipping dates where either is not quoted.
+func (h YieldCurveHistory) SpreadSeries(short, long Tenor) []RatePoint {
+	var points []RatePoint
+	for _, c := range h {
+		if s, ok := c.Spread(short, long); ok {
+			points = append(points, RatePoint{Date: c.Date, Value: s})
+		}
+	}
+	return points
+}
+
+// InvertedPeriod is a run of consecutive curves with a negative spread.
+type InvertedPeriod struct {
+	From, To time.Time
+
+	// Days is the number of curves in the run and Deepest the most negative spread.
+	Days    int
+	Deepest float64
+}
+
+// InvertedPeriods returns the runs of curves where the long tenor yields less than the short one.
+func (h YieldCurveHistory) InvertedPeriods(short, long Tenor) []InvertedPeriod {
+	var (
+		periods []InvertedPeriod
+		current *InvertedPeriod
+	)
+	for _, p := range h.SpreadSeries(short, long) {
+		if p.Value >= 0 {
+			current = nil
+			continue
+		}
+		if current == nil {
+			periods = append(periods, InvertedPeriod{From: p.Date, Deepest: p.Value})
+			current = &periods[len(periods)-1]
+		}
+		current.To = p.Date
+		current.Days++
+		current.Deepest = math.Min(current.Deepest, p.Value)
+	}
+	return periods
+}
+
+// treasuryRatesWindow is the longest range of days served by a single TreasuryRatesGet call.
+const treasuryRatesWindow = 90
+
+// GetYieldCurveHistory fetches the treasury rates within [from, to], splitting the range into
+// windows the API accepts.
+func GetYieldCurveHistory(ctx context.Context, c *ClientWithResponses, from, to time.Time) (YieldCurveHistory, error) {
+	var rates []TreasuryRates
+	seen := map[time.Time]bool{}
+	for _, w := range dateWindows(from, to, treasuryRatesWindow) {
+		params := &TreasuryRatesGetParams{
+			From: &w.From,
+			To:   &w.To,
+		}
+		resp, err := c.TreasuryRatesGetWithResponse(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected status of treasury rates: %s", resp.Status())
+		}
+		for _, r := range *resp.JSON200 {
+			if !seen[r.Date.Time] {
+				seen[r.Date.Time] = true
+				rates = append(rates, r)
+			}
+		}
+	}
+	return NewYieldCurveHistory(rates), nil
+}
+
+// GetYieldCurve fetches the latest yield curve dated on or before t.
+func GetYieldCurve(ctx context.Context, c *ClientWithResponses, t time.Time) (*YieldCurve, error) {
+	// Two weeks cover weekends and holidays.
+	h, err := GetYieldCurveHistory(ctx, c, t.AddDate(0, 0, -14), t)
+	if err != nil {
+		return nil, err
+	}
+	curve, ok := h.At(t)
+	if !ok {
+		return nil, fmt.Errorf("no treasury rates on or before %s", t.Format(time.DateOnly))
+	}
+	return curve, nil
+}
@@ -0,0 +1,143 @@
+package financialmodelingprep
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type yieldCurveSuite struct {
+	suite.Suite
+	rates []TreasuryRates
+}
+
+func (r *yieldCurveSuite) SetupTest() {
+	r.rates = []TreasuryRates{
+		{Date: fixtureDate(2024, time.July, 2), Month1: 5.49, Month2: 5.49, Month3: 5.48, Month6: 5.35, Year1: 5.11, Year2: 4.75, Year3: 4.56, Year5: 4.38, Year7: 4.39, Year10: 4.43, Year20: 4.69, Year30: 4.60},
+		{Date: fixtureDate(2024, time.July, 1), Month1: 5.51, Month2: 5.50, Month3: 5.48, Month6: 5.36, Year1: 5.12, Year2: 4.77, Year3: 4.58, Year5: 4.40, Year7: 4.41, Year10: 4.47, Year20: 4.71, Year30: 4.64},
+		{Date: fixtureDate(2025, time.July, 1), Month1: 4.34, Month2: 4.38, Month3: 4.34, Month6: 4.27, Year1: 4.02, Year2: 3.78, Year3: 3.72, Year5: 3.83, Year7: 4.01, Year10: 4.26, Year20: 4.82, Year30: 4.83},
+	}
+}
+
+func (r *yieldCurveSuite) TestYieldCurve() {
+	c := NewYieldCurve(&r.rates[0])
+	r.Len(c.Tenors, 12)
+	r.Equal("10Y", Tenor10Y.String())
+	r.Equal("6M", Tenor6M.String())
+
+	rate, ok := c.Rate(Tenor10Y)
+	r.True(ok)
+	r.InDelta(0.0443, rate, 1e-12)
+
+	s, ok := c.Spread2s10s()
+	r.True(ok)
+	r.InDelta(-0.0032, s, 1e-12)
+	r.True(c.IsInverted())
+
+	inversions := c.Inversions()
+	r.NotEmpty(inversions)
+	r.Equal(Inversion{Short: Tenor2M, Long: Tenor3M, Spread: inversions[0].Spread}, inversions[0])
+
+	// Missing tenors are left out.
+	c = NewYieldCurve(&TreasuryRates{Year2: 4, Year10: 4.5})
+	r.Equal([]Tenor{Tenor2Y, Tenor10Y}, c.Tenors)
+	r.False(c.IsInverted())
+}
+
+func (r *yieldCurveSuite) TestInterpolate() {
+	c := NewYieldCurve(&r.rates[0])
+
+	// Between 7Y and 10Y.
+	rate, err := c.Interpolate(8.5, LinearInterpolation)
+	r.NoError(err)
+	r.InDelta(0.0441, rate, 1e-12)
+
+	// Flat outside the quoted tenors.
+	rate, err = c.Interpolate(40, LinearInterpolation)
+	r.NoError(err)
+	r.InDelta(0.046, rate, 1e-12)
+
+	// The spline goes through every quoted tenor.
+	for i, t := range c.Tenors {
+		rate, err = c.Interpolate(t.Years(), CubicSplineInterpolation)
+		r.NoError(err)
+		r.InDelta(c.Rates[i], rate, 1e-12)
+	}
+	rate, err = c.Interpolate(8.5, CubicSplineInterpolation)
+	r.NoError(err)
+	r.InDelta(0.0441, rate, 5e-4)
+
+	rate, err = c.Interpolate(8.5, NelsonSiegelInterpolation)
+	r.NoError(err)
+	r.InDelta(0.0441, rate, 2e-3)
+
+	_, err = (&YieldCurve{}).Interpolate(1, LinearInterpolation)
+	r.Error(err)
+}
+
+func (r *yieldCurveSuite) TestNelsonSiegel() {
+	// Rebuild the parameters of a curve generated by the model.
+	model := NelsonSiegel{Beta0: 0.045, Beta1: 0.01, Beta2: -0.02, Lambda: 2}
+	c := &YieldCurve{}
+	for _, t := range Tenors {
+		c.Tenors = append(c.Tenors, t)
+		c.Rates = append(c.Rates, model.Rate(t.Years()))
+	}
+
+	ns, err := c.NelsonSiegel()
+	r.NoError(err)
+	r.InDelta(model.Lambda, ns.Lambda, 1e-6)
+	r.InDelta(model.Beta0, ns.Beta0, 1e-9)
+	r.InDelta(model.Beta1, ns.Beta1, 1e-9)
+	r.InDelta(model.Beta2, ns.Beta2, 1e-9)
+
+	_, err = (&YieldCurve{Tenors: []Tenor{Tenor1Y}, Rates: []float64{0.04}}).NelsonSiegel()
+	r.Error(err)
+}
+
+func (r *yieldCurveSuite) TestForward() {
+	c := &YieldCurve{Tenors: []Tenor{Tenor1Y, Tenor2Y}, Rates: []float64{0.04, 0.05}}
+
+	df, err := c.DiscountFactor(2, LinearInterpolation)
+	r.NoError(err)
+	r.InDelta(1/1.1025, df, 1e-12)
+
+	// (1.05^2 / 1.04) - 1
+	f, err := c.Forward(1, 2, LinearInterpolation)
+	r.NoError(err)
+	r.InDelta(1.1025/1.04-1, f, 1e-12)
+
+	_, err = c.Forward(2, 1, LinearInterpolation)
+	r.Error(err)
+}
+
+func (r *yieldCurveSuite) TestHistory() {
+	h := NewYieldCurveHistory(r.rates)
+	r.Len(h, 3)
+	r.Equal(time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC), h[0].Date)
+
+	between := h.Between(time.Date(2024, time.July, 2, 0, 0, 0, 0, time.UTC), time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC))
+	r.Len(between, 1)
+	r.Empty(h.Between(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC)))
+
+	c, ok := h.At(time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC))
+	r.True(ok)
+	r.Equal(time.Date(2024, time.July, 2, 0, 0, 0, 0, time.UTC), c.Date)
+	_, ok = h.At(time.Date(2024, time.June, 30, 0, 0, 0, 0, time.UTC))
+	r.False(ok)
+
+	series := h.Series(Tenor10Y)
+	r.Len(series, 3)
+	r.InDelta(0.0447, series[0].Value, 1e-12)
+
+	periods := h.InvertedPeriods(Tenor2Y, Tenor10Y)
+	r.Len(periods, 1)
+	r.Equal(2, periods[0].Days)
+	r.Equal(time.Date(2024, time.July, 2, 0, 0, 0, 0, time.UTC), periods[0].To)
+	r.InDelta(-0.0032, periods[0].Deepest, 1e-12)
+}
+
+func TestYieldCurveSuite(t *testing.T) {
+	suite.Run(t, new(yieldCurveSuite))
+}